@@ -1,21 +1,55 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
 	"github.com/topfreegames/mcp-server-time/internal/app"
+	"github.com/topfreegames/mcp-server-time/internal/config"
 )
 
 var (
 	// Version is set by build flags
 	Version   = "dev"
 	BuildTime = "unknown"
+	Commit    = "unknown"
 )
 
 func main() {
+	dryRun := flag.Bool("dry-run", false, "Validate configuration and exit without starting the server")
+	printConfig := flag.Bool("print-config", false, "Print the effective configuration as JSON and exit")
+	flag.Parse()
+
+	// Print the effective configuration only
+	if *printConfig {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+			os.Exit(1)
+		}
+
+		data, err := cfg.ExportJSON()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to export configuration: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Println(string(data))
+		os.Exit(0)
+	}
+
+	// Validate configuration only, before anything is opened or started
+	if *dryRun {
+		if err := app.DryRun(); err != nil {
+			fmt.Fprintf(os.Stderr, "Dry run failed: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Create and initialize the application
-	application, err := app.New(Version, BuildTime)
+	application, err := app.New(Version, BuildTime, app.WithCommit(Commit))
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize application: %v\n", err)
 		os.Exit(1)
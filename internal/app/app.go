@@ -6,13 +6,18 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.uber.org/zap"
 
+	"github.com/topfreegames/mcp-server-time/internal/audit"
 	"github.com/topfreegames/mcp-server-time/internal/config"
 	"github.com/topfreegames/mcp-server-time/internal/logger"
 	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	"github.com/topfreegames/mcp-server-time/internal/resources"
 	"github.com/topfreegames/mcp-server-time/internal/server"
 	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
 	"github.com/topfreegames/mcp-server-time/internal/tools"
@@ -20,13 +25,52 @@ import (
 
 // App represents the MCP Time Server application
 type App struct {
-	config     *config.Config
-	logger     *zap.Logger
-	httpServer *server.HTTPServer
+	config        *config.Config
+	logger        *zap.Logger
+	httpServer    *server.HTTPServer
+	meterProvider *sdkmetric.MeterProvider
+	auditLogger   *audit.Logger
+}
+
+// AppOption configures optional App settings
+type AppOption func(*appOptions)
+
+// appOptions holds the settings applied by AppOption values
+type appOptions struct {
+	metricsOptions []metrics.Option
+	commit         string
+	timePlugins    []timeservice.Plugin
+}
+
+// WithMetricsRegistry registers the server metrics with the given registerer instead of the
+// Prometheus default, for embedding the server in a larger application
+func WithMetricsRegistry(registerer prometheus.Registerer) AppOption {
+	return func(o *appOptions) {
+		o.metricsOptions = append(o.metricsOptions, metrics.WithRegistry(registerer))
+	}
+}
+
+// WithCommit sets the VCS commit the binary was built from, reported by the build_info metric
+func WithCommit(commit string) AppOption {
+	return func(o *appOptions) {
+		o.commit = commit
+	}
+}
+
+// WithTimePlugins registers plugins adding output formats to the time service
+func WithTimePlugins(plugins ...timeservice.Plugin) AppOption {
+	return func(o *appOptions) {
+		o.timePlugins = append(o.timePlugins, plugins...)
+	}
 }
 
 // New creates a new App instance
-func New(version, buildTime string) (*App, error) {
+func New(version, buildTime string, opts ...AppOption) (*App, error) {
+	var options appOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
 	// Load configuration
 	cfg, err := config.Load()
 	if err != nil {
@@ -34,7 +78,7 @@ func New(version, buildTime string) (*App, error) {
 	}
 
 	// Setup logger
-	appLogger, err := logger.New(cfg.Logging)
+	appLogger, logLevel, err := logger.New(cfg.Logging)
 	if err != nil {
 		return nil, fmt.Errorf("failed to setup logger: %w", err)
 	}
@@ -42,19 +86,91 @@ func New(version, buildTime string) (*App, error) {
 	appLogger.Info("Starting MCP Time Server",
 		zap.String("version", version),
 		zap.String("build_time", buildTime),
+		zap.String("commit", options.commit),
 		zap.String("server_name", cfg.Server.Name),
 		zap.String("host", cfg.Server.Host),
 		zap.Int("port", cfg.Server.Port),
 		zap.Bool("metrics_enabled", cfg.Metrics.Enabled))
 
 	// Initialize components
-	metricsCollector := metrics.New()
-	timeService := timeservice.NewTimeService(
-		cfg.Time.DefaultTimezone,
-		cfg.Time.DefaultFormat,
-		cfg.Time.SupportedFormats,
-		appLogger,
-	)
+	metricsOptions := append([]metrics.Option{metrics.WithBuildInfo(metrics.BuildInfo{
+		Version:   version,
+		BuildTime: buildTime,
+		Commit:    options.commit,
+	})}, options.metricsOptions...)
+	metricsCollector := metrics.New(metricsOptions...)
+
+	var meterProvider *sdkmetric.MeterProvider
+	if cfg.Metrics.OTEL.Enabled {
+		meterProvider, err = metrics.NewOTELMeterProvider(context.Background(), cfg.Metrics.OTEL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup OTEL metrics: %w", err)
+		}
+
+		if err := metricsCollector.EnableOTEL(meterProvider); err != nil {
+			return nil, fmt.Errorf("failed to setup OTEL metrics: %w", err)
+		}
+
+		appLogger.Info("OTEL metrics export enabled",
+			zap.String("exporter", cfg.Metrics.OTEL.Exporter),
+			zap.String("endpoint", cfg.MaskSensitiveFields().Metrics.OTEL.Endpoint))
+	}
+
+	timeServiceOptions := []timeservice.Option{
+		timeservice.WithWeekNumbering(cfg.Time.WeekNumbering),
+		timeservice.WithAllowMockNow(cfg.Testing.AllowMockNow),
+		timeservice.WithFallbackFormats(cfg.Time.FallbackFormats),
+		timeservice.WithNTPSyncServers(cfg.Time.NTP.Servers),
+	}
+
+	if cfg.Time.UseFallbackOnInvalidTimezone {
+		timeServiceOptions = append(timeServiceOptions, timeservice.WithTimezoneFallback(cfg.Time.FallbackTimezone))
+	}
+
+	if cfg.Time.Source == "ntp" {
+		timeServiceOptions = append(timeServiceOptions, timeservice.WithTimeSource(
+			timeservice.NewNTPTimeSource(cfg.Time.NTP.Server, cfg.Time.NTP.Timeout, appLogger)))
+
+		appLogger.Info("Using NTP time source",
+			zap.String("server", cfg.Time.NTP.Server),
+			zap.Duration("timeout", cfg.Time.NTP.Timeout))
+	}
+
+	var timeService timeservice.TimeService
+	if cfg.Server.MockMode {
+		timeService, err = timeservice.NewMockTimeService(
+			cfg.Time.DefaultTimezone,
+			cfg.Time.DefaultFormat,
+			cfg.Time.SupportedFormats,
+			appLogger,
+			timeServiceOptions...,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to enable mock mode: %w", err)
+		}
+
+		appLogger.Warn("MOCK MODE ENABLED: all time tools return canned responses; never use this in production",
+			zap.Bool("mock_mode", true))
+	} else {
+		timeService = timeservice.NewTimeService(
+			cfg.Time.DefaultTimezone,
+			cfg.Time.DefaultFormat,
+			cfg.Time.SupportedFormats,
+			appLogger,
+			timeServiceOptions...,
+		)
+	}
+
+	for _, plugin := range options.timePlugins {
+		if err := timeService.RegisterPlugin(plugin); err != nil {
+			return nil, fmt.Errorf("failed to register time plugin: %w", err)
+		}
+	}
+
+	if cfg.Testing.AllowMockNow {
+		appLogger.Warn("Mocking the current time is allowed; do not use this configuration in production",
+			zap.Bool("allow_mock_now", true))
+	}
 
 	// Create MCP server
 	mcpServer := mcp.NewServer(&mcp.Implementation{
@@ -62,19 +178,83 @@ func New(version, buildTime string) (*App, error) {
 		Version: cfg.Server.Version,
 	}, nil)
 
+	// Audit tool calls if enabled
+	var auditLogger *audit.Logger
+	if cfg.Audit.Enabled {
+		auditLogger, err = audit.New(cfg.Audit, appLogger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup audit log: %w", err)
+		}
+
+		mcpServer.AddReceivingMiddleware(auditLogger.Middleware())
+
+		appLogger.Info("Audit logging enabled",
+			zap.String("file_path", cfg.Audit.FilePath),
+			zap.Bool("include_request_body", cfg.Audit.IncludeRequestBody))
+	}
+
 	// Register time tools
 	tools.RegisterTimeTools(mcpServer, timeService, metricsCollector, appLogger)
+	resources.RegisterTimezones(mcpServer, timeService, appLogger)
+
+	if cfg.Schedules.Enabled {
+		scheduleStore, err := resources.NewScheduleStore(cfg.Schedules.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to setup schedules: %w", err)
+		}
+		resources.RegisterSchedules(mcpServer, scheduleStore, appLogger)
+
+		appLogger.Info("Schedules resource enabled",
+			zap.String("file_path", cfg.Schedules.FilePath),
+			zap.Int("schedule_count", len(scheduleStore.List())))
+	}
 
 	// Create HTTP server
-	httpServer := server.NewHTTPServer(cfg, mcpServer, metricsCollector, appLogger)
+	httpServer := server.NewHTTPServer(
+		server.WithConfig(cfg),
+		server.WithMCPServer(mcpServer),
+		server.WithMetrics(metricsCollector),
+		server.WithLogger(appLogger),
+		server.WithTimeService(timeService),
+	)
+	if cfg.Admin.Enabled {
+		httpServer.AdminServer = server.NewAdminServer(cfg, logLevel, appLogger)
+	}
 
 	return &App{
-		config:     cfg,
-		logger:     appLogger,
-		httpServer: httpServer,
+		config:        cfg,
+		logger:        appLogger,
+		httpServer:    httpServer,
+		meterProvider: meterProvider,
+		auditLogger:   auditLogger,
 	}, nil
 }
 
+// DryRun loads and validates the configuration without creating the App, so no audit file,
+// metrics exporter or server is set up
+func DryRun() error {
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	appLogger, _, err := logger.New(cfg.Logging)
+	if err != nil {
+		return fmt.Errorf("failed to setup logger: %w", err)
+	}
+	defer func() { _ = appLogger.Sync() }()
+
+	appLogger.Info("Dry run: loaded configuration",
+		zap.Any("config", cfg.MaskSensitiveFields()))
+
+	if _, err := time.LoadLocation(cfg.Time.DefaultTimezone); err != nil {
+		return fmt.Errorf("failed to load default timezone %s: %w", cfg.Time.DefaultTimezone, err)
+	}
+
+	appLogger.Info("Dry run: configuration is valid")
+	return nil
+}
+
 // Run starts the application and handles graceful shutdown
 func (a *App) Run() error {
 	// Start HTTP server in background
@@ -108,6 +288,23 @@ func (a *App) Run() error {
 
 // Close performs cleanup operations
 func (a *App) Close() error {
+	// Flush pending OTEL metrics before exiting
+	if a.meterProvider != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), a.config.Server.GracefulShutdownTimeout)
+		defer cancel()
+
+		if err := a.meterProvider.Shutdown(ctx); err != nil {
+			a.logger.Error("Failed to shutdown OTEL meter provider", zap.Error(err))
+		}
+	}
+
+	// Flush pending audit records before exiting
+	if a.auditLogger != nil {
+		if err := a.auditLogger.Close(); err != nil {
+			a.logger.Error("Failed to close audit log", zap.Error(err))
+		}
+	}
+
 	if a.logger != nil {
 		return a.logger.Sync()
 	}
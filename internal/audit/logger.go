@@ -0,0 +1,188 @@
+package audit
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// recordBufferSize is the number of records that can be queued before callers block
+const recordBufferSize = 1024
+
+// Record is a single audit log entry, written as one JSON line per tool call
+type Record struct {
+	Timestamp  time.Time       `json:"timestamp"`
+	RequestID  string          `json:"request_id"`
+	ToolName   string          `json:"tool_name"`
+	InputHash  string          `json:"input_hash"`
+	DurationMs float64         `json:"duration_ms"`
+	Success    bool            `json:"success"`
+	Input      json.RawMessage `json:"input,omitempty"`
+}
+
+// Logger writes tool call audit records to a dedicated file, independently of the application logger.
+// Records are queued on a buffered channel and written by a single goroutine; callers block rather
+// than drop records when the queue is full, and Close flushes everything still queued.
+type Logger struct {
+	writer             io.WriteCloser
+	includeRequestBody bool
+	logger             *zap.Logger
+
+	records chan Record
+	done    chan struct{}
+
+	mu      sync.RWMutex // guards closed
+	closed  bool
+	writeMu sync.Mutex // serializes writes to writer
+	encoder *json.Encoder
+}
+
+// New opens the audit log file in append mode and starts the writer
+func New(cfg config.AuditConfig, logger *zap.Logger) (*Logger, error) {
+	file, err := os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log %s: %w", cfg.FilePath, err)
+	}
+
+	return newLogger(file, cfg.IncludeRequestBody, logger), nil
+}
+
+// newLogger starts an audit logger writing to the given writer
+func newLogger(writer io.WriteCloser, includeRequestBody bool, logger *zap.Logger) *Logger {
+	l := &Logger{
+		writer:             writer,
+		includeRequestBody: includeRequestBody,
+		logger:             logger,
+		records:            make(chan Record, recordBufferSize),
+		done:               make(chan struct{}),
+		encoder:            json.NewEncoder(writer),
+	}
+
+	go l.run()
+
+	return l
+}
+
+// Log records a tool call
+func (l *Logger) Log(toolName string, input json.RawMessage, duration time.Duration, success bool) {
+	hash := sha256.Sum256(input)
+
+	record := Record{
+		Timestamp:  time.Now().UTC(),
+		RequestID:  newRequestID(),
+		ToolName:   toolName,
+		InputHash:  hex.EncodeToString(hash[:]),
+		DurationMs: float64(duration) / float64(time.Millisecond),
+		Success:    success,
+	}
+
+	if l.includeRequestBody {
+		record.Input = input
+	}
+
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+
+	// The file is already closed; surface the record through the application logger instead
+	if l.closed {
+		l.logger.Error("Audit record received after audit log was closed",
+			zap.String("request_id", record.RequestID),
+			zap.String("tool_name", record.ToolName),
+			zap.String("input_hash", record.InputHash),
+			zap.Bool("success", record.Success))
+		return
+	}
+
+	l.records <- record
+}
+
+// Middleware returns an MCP receiving middleware that audits every tools/call request
+func (l *Logger) Middleware() mcp.Middleware {
+	return func(next mcp.MethodHandler) mcp.MethodHandler {
+		return func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			callReq, ok := req.(*mcp.CallToolRequest)
+			if method != "tools/call" || !ok {
+				return next(ctx, method, req)
+			}
+
+			startTime := time.Now()
+			result, err := next(ctx, method, req)
+
+			success := err == nil
+			if toolResult, ok := result.(*mcp.CallToolResult); ok && toolResult.IsError {
+				success = false
+			}
+
+			l.Log(callReq.Params.Name, callReq.Params.Arguments, time.Since(startTime), success)
+
+			return result, err
+		}
+	}
+}
+
+// Close flushes all queued records, syncs and closes the audit log
+func (l *Logger) Close() error {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.records)
+	l.mu.Unlock()
+
+	<-l.done
+
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	if file, ok := l.writer.(*os.File); ok {
+		if err := file.Sync(); err != nil {
+			return fmt.Errorf("failed to sync audit log: %w", err)
+		}
+	}
+
+	return l.writer.Close()
+}
+
+// run writes queued records until the channel is closed
+func (l *Logger) run() {
+	defer close(l.done)
+
+	for record := range l.records {
+		l.write(record)
+	}
+}
+
+func (l *Logger) write(record Record) {
+	l.writeMu.Lock()
+	defer l.writeMu.Unlock()
+
+	if err := l.encoder.Encode(record); err != nil {
+		l.logger.Error("Failed to write audit record",
+			zap.String("request_id", record.RequestID),
+			zap.String("tool_name", record.ToolName),
+			zap.Error(err))
+	}
+}
+
+// newRequestID returns a random 16 byte hex identifier
+func newRequestID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
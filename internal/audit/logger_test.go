@@ -0,0 +1,159 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// nopCloser adapts a bytes.Buffer to io.WriteCloser
+type nopCloser struct {
+	*bytes.Buffer
+}
+
+func (nopCloser) Close() error { return nil }
+
+func readRecords(t *testing.T, data []byte) []Record {
+	t.Helper()
+
+	var records []Record
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.NoError(t, scanner.Err())
+
+	return records
+}
+
+func TestLogger_Log(t *testing.T) {
+	input := json.RawMessage(`{"timezone":"UTC"}`)
+	hash := sha256.Sum256(input)
+
+	tests := []struct {
+		name               string
+		includeRequestBody bool
+		expectedInput      json.RawMessage
+	}{
+		{name: "without request body"},
+		{name: "with request body", includeRequestBody: true, expectedInput: input},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			logger := newLogger(nopCloser{buf}, tt.includeRequestBody, zaptest.NewLogger(t))
+
+			logger.Log("get_time", input, 1500*time.Microsecond, true)
+			require.NoError(t, logger.Close())
+
+			records := readRecords(t, buf.Bytes())
+			require.Len(t, records, 1)
+
+			record := records[0]
+			assert.Equal(t, "get_time", record.ToolName)
+			assert.Equal(t, hex.EncodeToString(hash[:]), record.InputHash)
+			assert.Equal(t, 1.5, record.DurationMs)
+			assert.True(t, record.Success)
+			assert.Len(t, record.RequestID, 32)
+			assert.WithinDuration(t, time.Now(), record.Timestamp, time.Minute)
+			assert.Equal(t, string(tt.expectedInput), string(record.Input))
+		})
+	}
+}
+
+func TestLogger_CloseFlushesAllRecords(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newLogger(nopCloser{buf}, false, zaptest.NewLogger(t))
+
+	// More records than the buffer holds: callers block instead of dropping
+	total := recordBufferSize * 2
+	for i := 0; i < total; i++ {
+		logger.Log("get_time", json.RawMessage(`{}`), time.Millisecond, true)
+	}
+	require.NoError(t, logger.Close())
+	require.NoError(t, logger.Close())
+
+	assert.Len(t, readRecords(t, buf.Bytes()), total)
+}
+
+func TestLogger_Middleware(t *testing.T) {
+	buf := &bytes.Buffer{}
+	logger := newLogger(nopCloser{buf}, false, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name    string
+		method  string
+		result  mcp.Result
+		err     error
+		audited bool
+		success bool
+	}{
+		{name: "successful tool call", method: "tools/call", result: &mcp.CallToolResult{}, audited: true, success: true},
+		{name: "tool error result", method: "tools/call", result: &mcp.CallToolResult{IsError: true}, audited: true},
+		{name: "protocol error", method: "tools/call", err: errors.New("boom"), audited: true},
+		{name: "other method", method: "tools/list", result: &mcp.ListToolsResult{}},
+	}
+
+	audited := 0
+	for _, tt := range tests {
+		handler := logger.Middleware()(func(ctx context.Context, method string, req mcp.Request) (mcp.Result, error) {
+			return tt.result, tt.err
+		})
+
+		req := &mcp.CallToolRequest{Params: &mcp.CallToolParamsRaw{Name: "get_time", Arguments: json.RawMessage(`{}`)}}
+		result, err := handler(context.Background(), tt.method, req)
+		assert.Equal(t, tt.result, result, tt.name)
+		assert.Equal(t, tt.err, err, tt.name)
+
+		if tt.audited {
+			audited++
+		}
+	}
+
+	require.NoError(t, logger.Close())
+
+	records := readRecords(t, buf.Bytes())
+	require.Len(t, records, audited)
+	for i, record := range records {
+		assert.Equal(t, "get_time", record.ToolName)
+		assert.Equal(t, tests[i].success, record.Success, tests[i].name)
+	}
+}
+
+func TestNew(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	logger, err := New(config.AuditConfig{Enabled: true, FilePath: path}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	logger.Log("parse_time", json.RawMessage(`{"time_string":"now"}`), time.Millisecond, false)
+	require.NoError(t, logger.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	records := readRecords(t, data)
+	require.Len(t, records, 1)
+	assert.Equal(t, "parse_time", records[0].ToolName)
+	assert.False(t, records[0].Success)
+
+	_, err = New(config.AuditConfig{Enabled: true, FilePath: filepath.Join(t.TempDir(), "missing", "audit.log")}, zaptest.NewLogger(t))
+	assert.Error(t, err)
+}
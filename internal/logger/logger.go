@@ -2,6 +2,7 @@ package logger
 
 import (
 	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -9,24 +10,25 @@ import (
 	"github.com/topfreegames/mcp-server-time/internal/config"
 )
 
-// New creates a new zap logger based on the provided configuration
-func New(cfg config.LogConfig) (*zap.Logger, error) {
-	level := parseLogLevel(cfg.Level)
+// New creates a new zap logger based on the provided configuration. The returned AtomicLevel
+// controls the logger's level and can be changed at runtime.
+func New(cfg config.LogConfig) (*zap.Logger, zap.AtomicLevel, error) {
+	level := zap.NewAtomicLevelAt(parseLogLevel(cfg.Level))
 
 	var logger *zap.Logger
 	var err error
 
 	if cfg.Format == "json" {
-		logger, err = newProductionLogger(level)
+		logger, err = newProductionLogger(level, cfg.SamplingInitial, cfg.SamplingThereafter)
 	} else {
 		logger, err = newDevelopmentLogger(level)
 	}
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to build logger: %w", err)
+		return nil, level, fmt.Errorf("failed to build logger: %w", err)
 	}
 
-	return logger, nil
+	return logger, level, nil
 }
 
 // parseLogLevel converts string log level to zapcore.Level
@@ -47,16 +49,30 @@ func parseLogLevel(level string) zapcore.Level {
 	}
 }
 
-// newProductionLogger creates a production-ready logger with JSON output
-func newProductionLogger(level zapcore.Level) (*zap.Logger, error) {
+// newProductionLogger creates a production-ready logger with JSON output, sampled to keep
+// high-volume debug logging in check
+func newProductionLogger(level zap.AtomicLevel, samplingInitial, samplingThereafter int) (*zap.Logger, error) {
 	config := zap.NewProductionConfig()
-	config.Level = zap.NewAtomicLevelAt(level)
-	return config.Build()
+	config.Level = level
+	// Sampling is applied by wrapCoreWithSampler instead of zap's built-in defaults
+	config.Sampling = nil
+	return config.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return wrapCoreWithSampler(core, samplingInitial, samplingThereafter)
+	}))
+}
+
+// wrapCoreWithSampler limits core to initial entries per second for each message and level,
+// then one in every thereafter entries. Sampling is disabled when either value is 0.
+func wrapCoreWithSampler(core zapcore.Core, initial, thereafter int) zapcore.Core {
+	if initial <= 0 || thereafter <= 0 {
+		return core
+	}
+	return zapcore.NewSamplerWithOptions(core, time.Second, initial, thereafter)
 }
 
 // newDevelopmentLogger creates a development logger with console output
-func newDevelopmentLogger(level zapcore.Level) (*zap.Logger, error) {
+func newDevelopmentLogger(level zap.AtomicLevel) (*zap.Logger, error) {
 	config := zap.NewDevelopmentConfig()
-	config.Level = zap.NewAtomicLevelAt(level)
+	config.Level = level
 	return config.Build()
 }
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+func TestWrapCoreWithSampler(t *testing.T) {
+	tests := []struct {
+		name       string
+		initial    int
+		thereafter int
+		expected   int
+	}{
+		{"sampled", 100, 100, 100 + 900/100},
+		{"unsampled", 0, 100, 1000},
+		{"thereafter disabled", 100, 0, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.DebugLevel)
+			logger := zap.New(wrapCoreWithSampler(core, tt.initial, tt.thereafter))
+
+			// Synthetic load: the same debug message well within a single sampling tick
+			for i := 0; i < 1000; i++ {
+				logger.Debug("Tool request completed", zap.Int("i", i))
+			}
+
+			assert.Equal(t, tt.expected, logs.Len())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.LogConfig
+	}{
+		{"json with sampling", config.LogConfig{Level: "debug", Format: "json", SamplingInitial: 100, SamplingThereafter: 100}},
+		{"json without sampling", config.LogConfig{Level: "info", Format: "json"}},
+		{"console", config.LogConfig{Level: "warn", Format: "console", SamplingInitial: 100, SamplingThereafter: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger, level, err := New(tt.cfg)
+			require.NoError(t, err)
+			require.NotNil(t, logger)
+			assert.Equal(t, parseLogLevel(tt.cfg.Level), level.Level())
+		})
+	}
+}
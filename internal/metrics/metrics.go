@@ -1,8 +1,16 @@
 package metrics
 
 import (
+	"context"
+	"net/http"
+	"runtime"
+	"strconv"
+
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
 )
 
 // Metrics holds all the Prometheus metrics for the MCP Time Server
@@ -16,14 +24,73 @@ type Metrics struct {
 	// Transport metrics
 	TransportRequestsTotal prometheus.CounterVec
 
+	// HTTP endpoint metrics
+	HTTPRequestDuration prometheus.HistogramVec
+	HTTPRequestSize     prometheus.SummaryVec
+	HTTPResponseSize    prometheus.SummaryVec
+
 	// Error metrics
 	ErrorsTotal prometheus.CounterVec
+
+	// Build metadata, constant 1 labeled with the running build
+	BuildInfo prometheus.GaugeVec
+
+	// OpenTelemetry instruments, nil unless OTEL export is enabled
+	otel *otelInstruments
+
+	// handler serves the registered metrics in the Prometheus exposition format
+	handler http.Handler
+}
+
+// Option configures optional Metrics settings
+type Option func(*options)
+
+// options holds the settings applied by Option values
+type options struct {
+	registerer prometheus.Registerer
+	buildInfo  BuildInfo
+}
+
+// BuildInfo describes the running build, exposed by the build_info gauge
+type BuildInfo struct {
+	Version   string
+	BuildTime string
+	GoVersion string
+	Commit    string
+}
+
+// WithRegistry registers the metrics with the given registerer instead of the default one,
+// so the server can be embedded without polluting the host application's registry.
+// A nil registerer keeps the default.
+func WithRegistry(registerer prometheus.Registerer) Option {
+	return func(o *options) {
+		if registerer != nil {
+			o.registerer = registerer
+		}
+	}
+}
+
+// WithBuildInfo sets the labels of the build_info gauge. Empty fields are reported as "unknown",
+// except GoVersion which defaults to the running Go version.
+func WithBuildInfo(info BuildInfo) Option {
+	return func(o *options) {
+		o.buildInfo = info
+	}
 }
 
 // New creates a new Metrics instance with all metrics registered
-func New() *Metrics {
-	return &Metrics{
-		ToolRequestDuration: *promauto.NewHistogramVec(
+func New(opts ...Option) *Metrics {
+	o := options{registerer: prometheus.DefaultRegisterer}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	factory := promauto.With(o.registerer)
+
+	m := &Metrics{
+		handler: newHandler(o.registerer),
+
+		ToolRequestDuration: *factory.NewHistogramVec(
 			prometheus.HistogramOpts{
 				Name:    "mcp_time_tool_request_duration_seconds",
 				Help:    "Duration of MCP tool requests in seconds",
@@ -32,7 +99,7 @@ func New() *Metrics {
 			[]string{"tool", "status"},
 		),
 
-		TimeOperationDuration: *promauto.NewHistogramVec(
+		TimeOperationDuration: *factory.NewHistogramVec(
 			prometheus.HistogramOpts{
 				Name:    "mcp_time_operation_duration_seconds",
 				Help:    "Duration of time operations in seconds",
@@ -41,7 +108,7 @@ func New() *Metrics {
 			[]string{"operation", "status"},
 		),
 
-		TransportRequestsTotal: *promauto.NewCounterVec(
+		TransportRequestsTotal: *factory.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "mcp_time_transport_requests_total",
 				Help: "Total number of transport requests",
@@ -49,34 +116,151 @@ func New() *Metrics {
 			[]string{"transport", "method", "status"},
 		),
 
-		ErrorsTotal: *promauto.NewCounterVec(
+		HTTPRequestDuration: *factory.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:    "mcp_time_http_request_duration_seconds",
+				Help:    "Duration of HTTP requests in seconds by endpoint",
+				Buckets: prometheus.DefBuckets,
+			},
+			[]string{"endpoint", "method", "status_code"},
+		),
+
+		HTTPRequestSize: *factory.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name: "mcp_time_http_request_size_bytes",
+				Help: "Size of HTTP request bodies in bytes by endpoint",
+			},
+			[]string{"endpoint", "method"},
+		),
+
+		HTTPResponseSize: *factory.NewSummaryVec(
+			prometheus.SummaryOpts{
+				Name: "mcp_time_http_response_size_bytes",
+				Help: "Size of HTTP response bodies in bytes by endpoint",
+			},
+			[]string{"endpoint", "method"},
+		),
+
+		ErrorsTotal: *factory.NewCounterVec(
 			prometheus.CounterOpts{
 				Name: "mcp_time_errors_total",
 				Help: "Total number of errors by category",
 			},
 			[]string{"category", "error_type"},
 		),
+
+		BuildInfo: *factory.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "mcp_time_build_info",
+				Help: "Build information of the running server, always 1",
+			},
+			[]string{"version", "build_time", "go_version", "commit"},
+		),
+	}
+
+	info := o.buildInfo
+	if info.GoVersion == "" {
+		info.GoVersion = runtime.Version()
 	}
+	m.BuildInfo.WithLabelValues(
+		orUnknown(info.Version), orUnknown(info.BuildTime), info.GoVersion, orUnknown(info.Commit)).Set(1)
+
+	return m
+}
+
+// orUnknown returns value, or "unknown" when it is empty
+func orUnknown(value string) string {
+	if value == "" {
+		return "unknown"
+	}
+	return value
+}
+
+// newHandler serves the metrics gathered from registerer, falling back to the default gatherer
+// when registerer cannot be gathered from
+func newHandler(registerer prometheus.Registerer) http.Handler {
+	if registerer == prometheus.DefaultRegisterer {
+		return promhttp.Handler()
+	}
+
+	gatherer, ok := registerer.(prometheus.Gatherer)
+	if !ok {
+		return promhttp.Handler()
+	}
+
+	return promhttp.InstrumentMetricHandler(registerer, promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
+}
+
+// Handler returns the HTTP handler exposing the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return m.handler
+}
+
+// Reset clears every recorded observation so a test can start from zero while reusing the
+// same Metrics. The build_info gauge is kept. Tests running in parallel should instead give
+// each case its own registry with New(WithRegistry(prometheus.NewRegistry())).
+func (m *Metrics) Reset() {
+	m.ToolRequestDuration.Reset()
+	m.TimeOperationDuration.Reset()
+	m.TransportRequestsTotal.Reset()
+	m.HTTPRequestDuration.Reset()
+	m.HTTPRequestSize.Reset()
+	m.HTTPResponseSize.Reset()
+	m.ErrorsTotal.Reset()
 }
 
 // RecordToolRequestDuration records the duration of a tool request
 func (m *Metrics) RecordToolRequestDuration(tool, status string, duration float64) {
 	m.ToolRequestDuration.WithLabelValues(tool, status).Observe(duration)
+
+	if m.otel != nil {
+		m.otel.toolRequestDuration.Record(context.Background(), duration, metric.WithAttributes(
+			attribute.String("tool", tool),
+			attribute.String("status", status)))
+	}
 }
 
 // RecordTimeOperationDuration records the duration of a time operation
 func (m *Metrics) RecordTimeOperationDuration(operation, status string, duration float64) {
 	m.TimeOperationDuration.WithLabelValues(operation, status).Observe(duration)
+
+	if m.otel != nil {
+		m.otel.timeOperationDuration.Record(context.Background(), duration, metric.WithAttributes(
+			attribute.String("operation", operation),
+			attribute.String("status", status)))
+	}
 }
 
 // RecordTransportRequest records a transport request
 func (m *Metrics) RecordTransportRequest(transport, method, status string) {
 	m.TransportRequestsTotal.WithLabelValues(transport, method, status).Inc()
+
+	if m.otel != nil {
+		m.otel.transportRequestsTotal.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String("transport", transport),
+			attribute.String("method", method),
+			attribute.String("status", status)))
+	}
+}
+
+// RecordHTTPRequest records the duration and sizes of an HTTP request
+func (m *Metrics) RecordHTTPRequest(endpoint, method string, statusCode int, duration float64, requestSize, responseSize int64) {
+	m.HTTPRequestDuration.WithLabelValues(endpoint, method, strconv.Itoa(statusCode)).Observe(duration)
+	if requestSize >= 0 {
+		m.HTTPRequestSize.WithLabelValues(endpoint, method).Observe(float64(requestSize))
+	}
+	m.HTTPResponseSize.WithLabelValues(endpoint, method).Observe(float64(responseSize))
 }
 
 // RecordError records an error by category and type
 func (m *Metrics) RecordError(category, errorType string) {
 	m.ErrorsTotal.WithLabelValues(category, errorType).Inc()
+
+	if m.otel != nil {
+		m.otel.errorsTotal.Add(context.Background(), 1, metric.WithAttributes(
+			attribute.String("category", category),
+			attribute.String("error_type", errorType)))
+	}
 }
 
 // Status constants for metrics
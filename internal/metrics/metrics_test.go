@@ -1,11 +1,17 @@
 package metrics
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
 )
 
 func TestNew(t *testing.T) {
@@ -18,9 +24,55 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, metrics.ToolRequestDuration)
 	assert.NotNil(t, metrics.TimeOperationDuration)
 	assert.NotNil(t, metrics.TransportRequestsTotal)
+	assert.NotNil(t, metrics.HTTPRequestDuration)
 	assert.NotNil(t, metrics.ErrorsTotal)
 }
 
+func TestNew_WithRegistry(t *testing.T) {
+	defaultRegistry := prometheus.NewRegistry()
+	prometheus.DefaultRegisterer = defaultRegistry
+
+	registry := prometheus.NewRegistry()
+	metrics := New(WithRegistry(registry))
+	metrics.RecordToolRequestDuration("get_time", StatusSuccess, 0.1)
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	assert.NotEmpty(t, families)
+
+	// Nothing leaks into the default registry
+	families, err = defaultRegistry.Gather()
+	require.NoError(t, err)
+	assert.Empty(t, families)
+
+	// The handler exposes the injected registry
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `mcp_time_tool_request_duration_seconds_count{status="success",tool="get_time"} 1`)
+}
+
+func TestMetrics_Reset(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	metrics := New(WithRegistry(registry))
+
+	metrics.RecordToolRequestDuration("get_time", StatusSuccess, 0.1)
+	metrics.RecordTransportRequest(TransportSSE, "POST", StatusSuccess)
+	metrics.RecordError(ErrorCategoryTime, ErrorTypeInvalidTimezone)
+	require.Equal(t, 1.0, testutil.ToFloat64(metrics.ErrorsTotal.WithLabelValues(ErrorCategoryTime, ErrorTypeInvalidTimezone)))
+
+	metrics.Reset()
+
+	assert.Equal(t, 0, testutil.CollectAndCount(&metrics.ToolRequestDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(&metrics.TransportRequestsTotal))
+	assert.Equal(t, 0, testutil.CollectAndCount(&metrics.ErrorsTotal))
+	assert.Equal(t, 1, testutil.CollectAndCount(&metrics.BuildInfo))
+
+	// Recording after a reset starts from zero
+	metrics.RecordError(ErrorCategoryTime, ErrorTypeInvalidTimezone)
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.ErrorsTotal.WithLabelValues(ErrorCategoryTime, ErrorTypeInvalidTimezone)))
+}
+
 func TestMetrics_RecordToolRequestDuration(t *testing.T) {
 	// Clear any existing metrics
 	prometheus.DefaultRegisterer = prometheus.NewRegistry()
@@ -60,6 +112,22 @@ func TestMetrics_RecordTimeOperationDuration(t *testing.T) {
 	assert.NotEmpty(t, metricFamilies)
 }
 
+func TestMetrics_RecordHTTPRequest(t *testing.T) {
+	// Clear any existing metrics
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
+
+	metrics := New()
+
+	metrics.RecordHTTPRequest("/mcp", "POST", 200, 0.01, 128, 512)
+	metrics.RecordHTTPRequest("/mcp", "POST", 500, 0.02, 64, 32)
+	metrics.RecordHTTPRequest("/sse", "GET", 200, 1.5, -1, 2048)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(&metrics.HTTPRequestDuration))
+	assert.Equal(t, 2, testutil.CollectAndCount(&metrics.HTTPResponseSize))
+	// Unknown request sizes are not observed
+	assert.Equal(t, 1, testutil.CollectAndCount(&metrics.HTTPRequestSize))
+}
+
 func TestMetrics_RecordError(t *testing.T) {
 	// Clear any existing metrics
 	prometheus.DefaultRegisterer = prometheus.NewRegistry()
@@ -136,3 +204,38 @@ func TestMetrics_Integration(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, metricFamilies)
 }
+
+func TestMetrics_EnableOTEL(t *testing.T) {
+	// Clear any existing metrics
+	prometheus.DefaultRegisterer = prometheus.NewRegistry()
+
+	metrics := New()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	require.NoError(t, metrics.EnableOTEL(provider))
+
+	metrics.RecordToolRequestDuration("get_time", StatusSuccess, 0.1)
+	metrics.RecordTimeOperationDuration(OperationGetTime, StatusSuccess, 0.001)
+	metrics.RecordTransportRequest(TransportSSE, "POST", StatusSuccess)
+	metrics.RecordError(ErrorCategoryTime, ErrorTypeInvalidTimezone)
+
+	// Prometheus metrics keep being recorded
+	assert.Equal(t, 1.0, testutil.ToFloat64(metrics.TransportRequestsTotal.WithLabelValues(TransportSSE, "POST", StatusSuccess)))
+
+	// The same values are exported through OTEL
+	var collected metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &collected))
+	require.Len(t, collected.ScopeMetrics, 1)
+
+	names := make([]string, 0, len(collected.ScopeMetrics[0].Metrics))
+	for _, m := range collected.ScopeMetrics[0].Metrics {
+		names = append(names, m.Name)
+	}
+	assert.ElementsMatch(t, []string{
+		"mcp_time_tool_request_duration_seconds",
+		"mcp_time_operation_duration_seconds",
+		"mcp_time_transport_requests_total",
+		"mcp_time_errors_total",
+	}, names)
+}
@@ -0,0 +1,89 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// otelMeterName is the instrumentation scope used for all OpenTelemetry instruments
+const otelMeterName = "github.com/topfreegames/mcp-server-time"
+
+// otelInstruments holds the OpenTelemetry counterparts of the Prometheus metrics
+type otelInstruments struct {
+	toolRequestDuration    metric.Float64Histogram
+	timeOperationDuration  metric.Float64Histogram
+	transportRequestsTotal metric.Int64Counter
+	errorsTotal            metric.Int64Counter
+}
+
+// NewOTELMeterProvider creates a meter provider exporting through the configured exporter
+func NewOTELMeterProvider(ctx context.Context, cfg config.OTELMetricsConfig) (*sdkmetric.MeterProvider, error) {
+	var exporter sdkmetric.Exporter
+	var err error
+
+	switch cfg.Exporter {
+	case "otlp":
+		exporter, err = otlpmetricgrpc.New(ctx,
+			otlpmetricgrpc.WithEndpoint(cfg.Endpoint),
+			otlpmetricgrpc.WithInsecure())
+	case "stdout":
+		exporter, err = stdoutmetric.New()
+	default:
+		return nil, fmt.Errorf("unsupported OTEL exporter: %s", cfg.Exporter)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTEL %s exporter: %w", cfg.Exporter, err)
+	}
+
+	return sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter)),
+	), nil
+}
+
+// EnableOTEL creates OpenTelemetry instruments so every recorded metric is also exported through OTEL
+func (m *Metrics) EnableOTEL(provider metric.MeterProvider) error {
+	meter := provider.Meter(otelMeterName)
+
+	toolRequestDuration, err := meter.Float64Histogram("mcp_time_tool_request_duration_seconds",
+		metric.WithDescription("Duration of MCP tool requests in seconds"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return fmt.Errorf("failed to create tool request duration instrument: %w", err)
+	}
+
+	timeOperationDuration, err := meter.Float64Histogram("mcp_time_operation_duration_seconds",
+		metric.WithDescription("Duration of time operations in seconds"),
+		metric.WithUnit("s"))
+	if err != nil {
+		return fmt.Errorf("failed to create time operation duration instrument: %w", err)
+	}
+
+	transportRequestsTotal, err := meter.Int64Counter("mcp_time_transport_requests_total",
+		metric.WithDescription("Total number of transport requests"))
+	if err != nil {
+		return fmt.Errorf("failed to create transport requests instrument: %w", err)
+	}
+
+	errorsTotal, err := meter.Int64Counter("mcp_time_errors_total",
+		metric.WithDescription("Total number of errors by category"))
+	if err != nil {
+		return fmt.Errorf("failed to create errors instrument: %w", err)
+	}
+
+	m.otel = &otelInstruments{
+		toolRequestDuration:    toolRequestDuration,
+		timeOperationDuration:  timeOperationDuration,
+		transportRequestsTotal: transportRequestsTotal,
+		errorsTotal:            errorsTotal,
+	}
+
+	return nil
+}
@@ -0,0 +1,334 @@
+// Package resources exposes stateful data as MCP resources
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+)
+
+// Schedule resource URIs
+const (
+	schedulesURI        = "time://schedules"
+	scheduleURITemplate = "time://schedules/{name}"
+	schedulePrefix      = "time://schedules/"
+)
+
+// cronDescriptors are the predefined schedules accepted instead of five cron fields
+var cronDescriptors = map[string]bool{
+	"@yearly": true, "@annually": true, "@monthly": true, "@weekly": true,
+	"@daily": true, "@midnight": true, "@hourly": true,
+}
+
+// cronField matches a single cron field such as "*", "*/15", "1-5", "MON,WED" or "?"
+var cronField = regexp.MustCompile(`^[0-9A-Za-z*/,?-]+$`)
+
+// validScheduleName matches names that can be used in a resource URI
+var validScheduleName = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// Schedule is a named recurring check-in time
+type Schedule struct {
+	Name        string    `json:"name" jsonschema:"Unique name of the schedule"`
+	Cron        string    `json:"cron" jsonschema:"Cron expression or descriptor"`
+	Timezone    string    `json:"timezone" jsonschema:"IANA timezone the cron expression is evaluated in"`
+	Description string    `json:"description,omitempty" jsonschema:"What the check-in is for"`
+	UpdatedAt   time.Time `json:"updated_at" jsonschema:"When the schedule was last created or updated"`
+}
+
+// ScheduleStore keeps schedules in memory and, when a file path is configured, rewrites the file as
+// JSON after every change
+type ScheduleStore struct {
+	mu        sync.Mutex
+	schedules map[string]Schedule
+	filePath  string
+	now       func() time.Time
+}
+
+// NewScheduleStore creates a store persisted to filePath, loading the schedules it already holds.
+// An empty filePath keeps the schedules in memory only.
+func NewScheduleStore(filePath string) (*ScheduleStore, error) {
+	store := &ScheduleStore{
+		schedules: make(map[string]Schedule),
+		filePath:  filePath,
+		now:       time.Now,
+	}
+
+	if filePath == "" {
+		return store, nil
+	}
+
+	data, err := os.ReadFile(filePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schedules file %s: %w", filePath, err)
+	}
+
+	var schedules []Schedule
+	if err := json.Unmarshal(data, &schedules); err != nil {
+		return nil, fmt.Errorf("failed to decode schedules file %s: %w", filePath, err)
+	}
+	for _, schedule := range schedules {
+		store.schedules[schedule.Name] = schedule
+	}
+
+	return store, nil
+}
+
+// List returns every schedule, sorted by name
+func (s *ScheduleStore) List() []Schedule {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.sortedLocked()
+}
+
+// Get returns the schedule with the given name
+func (s *ScheduleStore) Get(name string) (Schedule, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule, ok := s.schedules[name]
+	return schedule, ok
+}
+
+// Put creates or replaces a schedule. Timezone defaults to UTC.
+func (s *ScheduleStore) Put(schedule Schedule) (Schedule, error) {
+	if schedule.Timezone == "" {
+		schedule.Timezone = "UTC"
+	}
+	if err := validateSchedule(schedule); err != nil {
+		return Schedule{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schedule.UpdatedAt = s.now().UTC()
+
+	previous, existed := s.schedules[schedule.Name]
+	s.schedules[schedule.Name] = schedule
+
+	if err := s.persistLocked(); err != nil {
+		if existed {
+			s.schedules[schedule.Name] = previous
+		} else {
+			delete(s.schedules, schedule.Name)
+		}
+		return Schedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// Delete removes a schedule, reporting whether it existed
+func (s *ScheduleStore) Delete(name string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.schedules[name]
+	if !ok {
+		return false, nil
+	}
+	delete(s.schedules, name)
+
+	if err := s.persistLocked(); err != nil {
+		s.schedules[name] = previous
+		return false, err
+	}
+
+	return true, nil
+}
+
+// sortedLocked returns the schedules sorted by name; s.mu must be held
+func (s *ScheduleStore) sortedLocked() []Schedule {
+	schedules := make([]Schedule, 0, len(s.schedules))
+	for _, schedule := range s.schedules {
+		schedules = append(schedules, schedule)
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Name < schedules[j].Name })
+	return schedules
+}
+
+// persistLocked writes every schedule to the file through a temporary file, so a crash never
+// leaves a partially written file behind; s.mu must be held
+func (s *ScheduleStore) persistLocked() error {
+	if s.filePath == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(s.sortedLocked(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode schedules: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.filePath), filepath.Base(s.filePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write schedules file %s: %w", s.filePath, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write schedules file %s: %w", s.filePath, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write schedules file %s: %w", s.filePath, err)
+	}
+
+	if err := os.Rename(tmp.Name(), s.filePath); err != nil {
+		return fmt.Errorf("failed to write schedules file %s: %w", s.filePath, err)
+	}
+
+	return nil
+}
+
+// validateSchedule checks the name, cron expression and timezone of a schedule
+func validateSchedule(schedule Schedule) error {
+	if !validScheduleName.MatchString(schedule.Name) {
+		return fmt.Errorf("invalid schedule name %q: use letters, digits, '.', '_' and '-'", schedule.Name)
+	}
+
+	if !cronDescriptors[schedule.Cron] {
+		fields := strings.Fields(schedule.Cron)
+		if len(fields) != 5 {
+			return fmt.Errorf("invalid cron expression %q: expected 5 fields or a descriptor such as @daily", schedule.Cron)
+		}
+		for _, field := range fields {
+			if !cronField.MatchString(field) {
+				return fmt.Errorf("invalid cron expression %q: bad field %q", schedule.Cron, field)
+			}
+		}
+	}
+
+	if _, err := time.LoadLocation(schedule.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %s: %w", schedule.Timezone, err)
+	}
+
+	return nil
+}
+
+// PutScheduleInput represents input for creating or updating a schedule
+type PutScheduleInput struct {
+	Name        string `json:"name" jsonschema:"Unique name of the schedule (letters, digits, '.', '_' and '-')"`
+	Cron        string `json:"cron" jsonschema:"Five-field cron expression (e.g., '0 9 * * MON-FRI') or a descriptor such as '@daily'"`
+	Timezone    string `json:"timezone,omitempty" jsonschema:"IANA timezone the cron expression is evaluated in. Defaults to UTC if not provided"`
+	Description string `json:"description,omitempty" jsonschema:"What the check-in is for"`
+}
+
+// DeleteScheduleInput represents input for deleting a schedule
+type DeleteScheduleInput struct {
+	Name string `json:"name" jsonschema:"Name of the schedule to delete"`
+}
+
+// DeleteScheduleResult represents the result of deleting a schedule
+type DeleteScheduleResult struct {
+	Name    string `json:"name" jsonschema:"Name of the schedule"`
+	Deleted bool   `json:"deleted" jsonschema:"Whether the schedule existed and was deleted"`
+}
+
+// RegisterSchedules exposes the store as the schedules resources. MCP resources are read-only, so
+// schedules are created, updated and deleted through the schedule_put and schedule_delete tools.
+func RegisterSchedules(server *mcp.Server, store *ScheduleStore, logger *zap.Logger) {
+	server.AddResource(&mcp.Resource{
+		URI:         schedulesURI,
+		Name:        "schedules",
+		Description: "Every stored recurring check-in time, sorted by name",
+		MIMEType:    "application/json",
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return jsonResource(req.Params.URI, store.List())
+	})
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: scheduleURITemplate,
+		Name:        "schedule",
+		Description: "A stored recurring check-in time, by name",
+		MIMEType:    "application/json",
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		schedule, ok := store.Get(strings.TrimPrefix(req.Params.URI, schedulePrefix))
+		if !ok {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return jsonResource(req.Params.URI, schedule)
+	})
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "schedule_put",
+		Description: "Create or update a named recurring check-in time (cron expression and timezone), readable as the " + scheduleURITemplate + " resource",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input PutScheduleInput) (*mcp.CallToolResult, Schedule, error) {
+		schedule, err := store.Put(Schedule{
+			Name:        input.Name,
+			Cron:        input.Cron,
+			Timezone:    input.Timezone,
+			Description: input.Description,
+		})
+		if err != nil {
+			logger.Error("schedule_put failed", zap.String("name", input.Name), zap.Error(err))
+			return nil, Schedule{}, err
+		}
+
+		logger.Info("Schedule saved",
+			zap.String("name", schedule.Name),
+			zap.String("cron", schedule.Cron),
+			zap.String("timezone", schedule.Timezone))
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("Saved schedule %s: %s (%s)", schedule.Name, schedule.Cron, schedule.Timezone),
+				},
+			},
+		}, schedule, nil
+	})
+
+	mcp.AddTool(server, &mcp.Tool{
+		Name:        "schedule_delete",
+		Description: "Delete a named recurring check-in time",
+	}, func(ctx context.Context, req *mcp.CallToolRequest, input DeleteScheduleInput) (*mcp.CallToolResult, DeleteScheduleResult, error) {
+		deleted, err := store.Delete(input.Name)
+		if err != nil {
+			logger.Error("schedule_delete failed", zap.String("name", input.Name), zap.Error(err))
+			return nil, DeleteScheduleResult{}, err
+		}
+
+		text := fmt.Sprintf("Schedule %s not found", input.Name)
+		if deleted {
+			logger.Info("Schedule deleted", zap.String("name", input.Name))
+			text = fmt.Sprintf("Deleted schedule %s", input.Name)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text,
+				},
+			},
+		}, DeleteScheduleResult{Name: input.Name, Deleted: deleted}, nil
+	})
+}
+
+// jsonResource encodes v as the JSON content of the resource at uri
+func jsonResource(uri string, v any) (*mcp.ReadResourceResult, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", uri, err)
+	}
+
+	return &mcp.ReadResourceResult{
+		Contents: []*mcp.ResourceContents{
+			{URI: uri, MIMEType: "application/json", Text: string(data)},
+		},
+	}, nil
+}
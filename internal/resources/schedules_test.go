@@ -0,0 +1,146 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestScheduleStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schedules.json")
+	store, err := NewScheduleStore(path)
+	require.NoError(t, err)
+	store.now = func() time.Time { return time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC) }
+
+	saved, err := store.Put(Schedule{Name: "standup", Cron: "0 9 * * MON-FRI", Timezone: "Europe/Berlin"})
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC), saved.UpdatedAt)
+
+	_, err = store.Put(Schedule{Name: "backup", Cron: "@daily"})
+	require.NoError(t, err)
+
+	schedules := store.List()
+	require.Len(t, schedules, 2)
+	assert.Equal(t, "backup", schedules[0].Name)
+	assert.Equal(t, "UTC", schedules[0].Timezone)
+
+	// A new store loads what the previous one persisted
+	reloaded, err := NewScheduleStore(path)
+	require.NoError(t, err)
+	schedule, ok := reloaded.Get("standup")
+	require.True(t, ok)
+	assert.Equal(t, "0 9 * * MON-FRI", schedule.Cron)
+	assert.Equal(t, "Europe/Berlin", schedule.Timezone)
+
+	deleted, err := store.Delete("standup")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+
+	deleted, err = store.Delete("standup")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+
+	reloaded, err = NewScheduleStore(path)
+	require.NoError(t, err)
+	assert.Len(t, reloaded.List(), 1)
+}
+
+func TestScheduleStore_Invalid(t *testing.T) {
+	store, err := NewScheduleStore("")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		expected string
+	}{
+		{"empty name", Schedule{Cron: "@daily"}, "invalid schedule name"},
+		{"name with slash", Schedule{Name: "a/b", Cron: "@daily"}, "invalid schedule name"},
+		{"too few cron fields", Schedule{Name: "a", Cron: "0 9 * *"}, "expected 5 fields"},
+		{"bad cron field", Schedule{Name: "a", Cron: "0 9 * * $"}, "bad field"},
+		{"invalid timezone", Schedule{Name: "a", Cron: "@hourly", Timezone: "Mars/Olympus"}, "invalid timezone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := store.Put(tt.schedule)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+
+	assert.Empty(t, store.List())
+}
+
+func TestScheduleStore_PersistFailureRollsBack(t *testing.T) {
+	store, err := NewScheduleStore(filepath.Join(t.TempDir(), "missing", "schedules.json"))
+	require.NoError(t, err)
+
+	_, err = store.Put(Schedule{Name: "standup", Cron: "@daily"})
+	assert.Error(t, err)
+	assert.Empty(t, store.List())
+}
+
+func TestNewScheduleStore_CorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schedules.json")
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	_, err := NewScheduleStore(path)
+	assert.Error(t, err)
+}
+
+func TestRegisterSchedules(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := NewScheduleStore("")
+	require.NoError(t, err)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	RegisterSchedules(server, store, zaptest.NewLogger(t))
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "schedule_put",
+		Arguments: map[string]any{"name": "standup", "cron": "0 9 * * MON-FRI", "timezone": "Europe/Berlin"},
+	})
+	require.NoError(t, err)
+	require.False(t, res.IsError)
+
+	read, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "time://schedules"})
+	require.NoError(t, err)
+	require.Len(t, read.Contents, 1)
+	var schedules []Schedule
+	require.NoError(t, json.Unmarshal([]byte(read.Contents[0].Text), &schedules))
+	require.Len(t, schedules, 1)
+	assert.Equal(t, "standup", schedules[0].Name)
+
+	read, err = session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "time://schedules/standup"})
+	require.NoError(t, err)
+	var schedule Schedule
+	require.NoError(t, json.Unmarshal([]byte(read.Contents[0].Text), &schedule))
+	assert.Equal(t, "Europe/Berlin", schedule.Timezone)
+
+	res, err = session.CallTool(ctx, &mcp.CallToolParams{Name: "schedule_delete", Arguments: map[string]any{"name": "standup"}})
+	require.NoError(t, err)
+	require.False(t, res.IsError)
+
+	_, err = session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "time://schedules/standup"})
+	assert.Error(t, err)
+}
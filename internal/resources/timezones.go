@@ -0,0 +1,51 @@
+package resources
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+// Timezone resource URIs
+const (
+	timezonesURI            = "timezones://list"
+	timezoneInfoURITemplate = "timezones://info/{name}"
+	timezoneInfoPrefix      = "timezones://info/"
+)
+
+// RegisterTimezones exposes the known IANA timezones and their details as resources. Timezone
+// names contain slashes, which are percent-encoded in the info URI (timezones://info/Europe%2FBerlin).
+func RegisterTimezones(server *mcp.Server, timeService timeservice.TimeService, logger *zap.Logger) {
+	server.AddResource(&mcp.Resource{
+		URI:         timezonesURI,
+		Name:        "timezones",
+		Description: "Every known IANA timezone name, sorted alphabetically",
+		MIMEType:    "application/json",
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		return jsonResource(req.Params.URI, timeservice.ZoneNames())
+	})
+
+	server.AddResourceTemplate(&mcp.ResourceTemplate{
+		URITemplate: timezoneInfoURITemplate,
+		Name:        "timezone_info",
+		Description: "Current details of an IANA timezone: offset, abbreviation, DST status and upcoming transitions",
+		MIMEType:    "application/json",
+	}, func(ctx context.Context, req *mcp.ReadResourceRequest) (*mcp.ReadResourceResult, error) {
+		name, err := url.PathUnescape(strings.TrimPrefix(req.Params.URI, timezoneInfoPrefix))
+		if err != nil || name == "" {
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+
+		info, err := timeService.GetTimezoneInfo(ctx, timeservice.TimezoneInfoInput{Timezone: name})
+		if err != nil {
+			logger.Debug("Timezone resource not found", zap.String("uri", req.Params.URI), zap.Error(err))
+			return nil, mcp.ResourceNotFoundError(req.Params.URI)
+		}
+		return jsonResource(req.Params.URI, info)
+	})
+}
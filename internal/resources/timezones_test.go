@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+func TestRegisterTimezones(t *testing.T) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+	timeService := timeservice.NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger,
+		timeservice.WithClock(timeservice.FixedClock{Time: time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)}))
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test-server", Version: "1.0.0"}, nil)
+	RegisterTimezones(server, timeService, logger)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	read, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: "timezones://list"})
+	require.NoError(t, err)
+	require.Len(t, read.Contents, 1)
+	assert.Equal(t, "application/json", read.Contents[0].MIMEType)
+	var names []string
+	require.NoError(t, json.Unmarshal([]byte(read.Contents[0].Text), &names))
+	assert.Contains(t, names, "UTC")
+	assert.Contains(t, names, "Europe/Berlin")
+	assert.IsIncreasing(t, names)
+
+	tests := []struct {
+		name         string
+		uri          string
+		expectError  bool
+		expectedName string
+		expectedAbbr string
+	}{
+		{
+			name:         "encoded slash",
+			uri:          "timezones://info/Europe%2FBerlin",
+			expectedName: "Europe/Berlin",
+			expectedAbbr: "CEST",
+		},
+		{
+			name:         "single segment",
+			uri:          "timezones://info/UTC",
+			expectedName: "UTC",
+			expectedAbbr: "UTC",
+		},
+		{
+			name:        "unknown zone",
+			uri:         "timezones://info/Mars%2FOlympus",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			read, err := session.ReadResource(ctx, &mcp.ReadResourceParams{URI: tt.uri})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, read.Contents, 1)
+			var info timeservice.TimezoneInfo
+			require.NoError(t, json.Unmarshal([]byte(read.Contents[0].Text), &info))
+			assert.Equal(t, tt.expectedName, info.Name)
+			assert.Equal(t, tt.expectedAbbr, info.Abbreviation)
+		})
+	}
+}
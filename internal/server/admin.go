@@ -0,0 +1,133 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+const (
+	// maxLogLevelChanges is how many log level changes are accepted per logLevelChangeWindow
+	maxLogLevelChanges    = 5
+	logLevelChangeWindow  = time.Minute
+	maxLogLevelBodyLength = 1024
+)
+
+// logLevelRequest is the body accepted by POST /admin/log-level
+type logLevelRequest struct {
+	Level string `json:"level"`
+}
+
+// logLevelResponse is returned after a successful log level change
+type logLevelResponse struct {
+	Level         string `json:"level"`
+	PreviousLevel string `json:"previous_level"`
+}
+
+// rateLimiter allows at most limit events within a sliding window
+type rateLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	events []time.Time
+	now    func() time.Time
+}
+
+// newRateLimiter creates a sliding-window rate limiter
+func newRateLimiter(limit int, window time.Duration) *rateLimiter {
+	return &rateLimiter{limit: limit, window: window, now: time.Now}
+}
+
+// allow records an event and reports whether it is within the limit. When it is not, it
+// also returns how long until the oldest event leaves the window.
+func (l *rateLimiter) allow() (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	cutoff := now.Add(-l.window)
+
+	kept := l.events[:0]
+	for _, event := range l.events {
+		if event.After(cutoff) {
+			kept = append(kept, event)
+		}
+	}
+	l.events = kept
+
+	if len(l.events) >= l.limit {
+		return false, l.events[0].Sub(cutoff)
+	}
+
+	l.events = append(l.events, now)
+	return true, 0
+}
+
+// NewAdminServer creates the admin HTTP server, which listens on its own port so it can be
+// kept off public networks
+func NewAdminServer(cfg *config.Config, level zap.AtomicLevel, logger *zap.Logger) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/admin/log-level", createLogLevelHandler(level, newRateLimiter(maxLogLevelChanges, logLevelChangeWindow), logger))
+
+	return &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", cfg.Admin.Host, cfg.Admin.Port),
+		Handler: mux,
+	}
+}
+
+// createLogLevelHandler changes the logger level at runtime. Every change is logged with the
+// caller's address so there is an audit trail of who changed what.
+func createLogLevelHandler(level zap.AtomicLevel, limiter *rateLimiter, logger *zap.Logger) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var req logLevelRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxLogLevelBodyLength)).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("invalid request body: %v", err), http.StatusBadRequest)
+			return
+		}
+
+		// ParseLevel treats an empty string as info, so require an explicit level
+		newLevel, err := zapcore.ParseLevel(req.Level)
+		if req.Level == "" || err != nil {
+			http.Error(w, fmt.Sprintf("invalid level %q (must be one of: debug, info, warn, error, dpanic, panic, fatal)", req.Level), http.StatusBadRequest)
+			return
+		}
+
+		if ok, retryAfter := limiter.allow(); !ok {
+			logger.Warn("Log level change rejected: rate limit exceeded",
+				zap.String("requested_level", newLevel.String()),
+				zap.String("client_ip", remoteIP(r)))
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second).Seconds())+1))
+			http.Error(w, fmt.Sprintf("at most %d log level changes per %s", maxLogLevelChanges, logLevelChangeWindow),
+				http.StatusTooManyRequests)
+			return
+		}
+
+		previous := level.Level()
+		level.SetLevel(newLevel)
+
+		// Logged at warn so the audit record survives any level the caller picked below it
+		logger.Warn("Log level changed",
+			zap.String("previous_level", previous.String()),
+			zap.String("level", newLevel.String()),
+			zap.String("client_ip", remoteIP(r)),
+			zap.String("user_agent", r.UserAgent()))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(logLevelResponse{Level: newLevel.String(), PreviousLevel: previous.String()})
+	}
+}
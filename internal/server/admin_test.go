@@ -0,0 +1,99 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestLogLevelHandler(t *testing.T) {
+	tests := []struct {
+		name          string
+		method        string
+		body          string
+		expectedCode  int
+		expectedLevel zapcore.Level
+	}{
+		{name: "raise to debug", method: http.MethodPost, body: `{"level": "debug"}`, expectedCode: http.StatusOK, expectedLevel: zapcore.DebugLevel},
+		{name: "lower to error", method: http.MethodPost, body: `{"level": "error"}`, expectedCode: http.StatusOK, expectedLevel: zapcore.ErrorLevel},
+		{name: "unknown level", method: http.MethodPost, body: `{"level": "verbose"}`, expectedCode: http.StatusBadRequest, expectedLevel: zapcore.InfoLevel},
+		{name: "missing level", method: http.MethodPost, body: `{}`, expectedCode: http.StatusBadRequest, expectedLevel: zapcore.InfoLevel},
+		{name: "malformed body", method: http.MethodPost, body: `level=debug`, expectedCode: http.StatusBadRequest, expectedLevel: zapcore.InfoLevel},
+		{name: "GET not allowed", method: http.MethodGet, expectedCode: http.StatusMethodNotAllowed, expectedLevel: zapcore.InfoLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+			handler := createLogLevelHandler(level, newRateLimiter(maxLogLevelChanges, logLevelChangeWindow), zap.NewNop())
+
+			rec := httptest.NewRecorder()
+			handler(rec, httptest.NewRequest(tt.method, "/admin/log-level", strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			assert.Equal(t, tt.expectedLevel, level.Level())
+
+			if tt.expectedCode == http.StatusOK {
+				var body logLevelResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+				assert.Equal(t, tt.expectedLevel.String(), body.Level)
+				assert.Equal(t, "info", body.PreviousLevel)
+			}
+		})
+	}
+}
+
+func TestLogLevelHandler_AuditLog(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	handler := createLogLevelHandler(level, newRateLimiter(maxLogLevelChanges, logLevelChangeWindow), zap.New(core))
+
+	req := httptest.NewRequest(http.MethodPost, "/admin/log-level", strings.NewReader(`{"level": "debug"}`))
+	req.RemoteAddr = "10.1.2.3:54321"
+	handler(httptest.NewRecorder(), req)
+
+	entries := logs.FilterMessage("Log level changed").All()
+	require.Len(t, entries, 1)
+
+	fields := entries[0].ContextMap()
+	assert.Equal(t, "info", fields["previous_level"])
+	assert.Equal(t, "debug", fields["level"])
+	assert.Equal(t, "10.1.2.3", fields["client_ip"])
+}
+
+func TestLogLevelHandler_RateLimit(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter(maxLogLevelChanges, logLevelChangeWindow)
+	limiter.now = func() time.Time { return now }
+
+	level := zap.NewAtomicLevelAt(zapcore.InfoLevel)
+	handler := createLogLevelHandler(level, limiter, zap.NewNop())
+
+	post := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler(rec, httptest.NewRequest(http.MethodPost, "/admin/log-level", strings.NewReader(`{"level": "debug"}`)))
+		return rec
+	}
+
+	for i := 0; i < maxLogLevelChanges; i++ {
+		assert.Equal(t, http.StatusOK, post().Code, "change %d", i+1)
+		now = now.Add(time.Second)
+	}
+
+	rec := post()
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.NotEmpty(t, rec.Header().Get("Retry-After"))
+
+	// The oldest change leaves the window a minute after it was made
+	now = now.Add(logLevelChangeWindow - maxLogLevelChanges*time.Second + time.Second)
+	assert.Equal(t, http.StatusOK, post().Code)
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// sseEnvelope wraps the MCP frame of an SSE message event with metadata that gateways and
+// logging proxies can read without understanding the MCP protocol
+type sseEnvelope struct {
+	ID     string          `json:"id"`
+	SentAt string          `json:"sent_at"`
+	Data   json.RawMessage `json:"data"`
+}
+
+// sseEnvelopeWriter rewrites the data of every message event written to an SSE stream as an
+// sseEnvelope. Other events, such as the endpoint event opening the stream, and non-SSE
+// responses pass through unchanged.
+type sseEnvelopeWriter struct {
+	http.ResponseWriter
+	pending bytes.Buffer
+	now     func() time.Time
+}
+
+// Write buffers the stream until an event is complete, then writes it with its data wrapped
+func (w *sseEnvelopeWriter) Write(b []byte) (int, error) {
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/event-stream") {
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.pending.Write(b)
+	for {
+		event, rest, found := bytes.Cut(w.pending.Bytes(), []byte("\n\n"))
+		if !found {
+			break
+		}
+
+		wrapped := w.wrapEvent(bytes.Clone(event))
+		remaining := bytes.Clone(rest)
+		w.pending.Reset()
+		w.pending.Write(remaining)
+
+		if _, err := w.ResponseWriter.Write(wrapped); err != nil {
+			return 0, err
+		}
+	}
+	return len(b), nil
+}
+
+// Flush sends the events written so far to the client
+func (w *sseEnvelopeWriter) Flush() {
+	http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// wrapEvent returns a complete event, including its terminating blank line, with the data of
+// message events replaced by an envelope. Events whose data is not JSON are left as they are.
+func (w *sseEnvelopeWriter) wrapEvent(event []byte) []byte {
+	name := "message"
+	var fields []string
+	var data []string
+	for _, line := range strings.Split(string(event), "\n") {
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			name = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+			fields = append(fields, line)
+		case strings.HasPrefix(line, "data:"):
+			data = append(data, strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		default:
+			fields = append(fields, line)
+		}
+	}
+
+	frame := []byte(strings.Join(data, "\n"))
+	if name != "message" || !json.Valid(frame) {
+		return append(event, '\n', '\n')
+	}
+
+	envelope, err := json.Marshal(sseEnvelope{
+		ID:     uuid.NewString(),
+		SentAt: w.now().UTC().Format(time.RFC3339Nano),
+		Data:   frame,
+	})
+	if err != nil {
+		return append(event, '\n', '\n')
+	}
+
+	var out bytes.Buffer
+	for _, field := range fields {
+		out.WriteString(field)
+		out.WriteByte('\n')
+	}
+	out.WriteString("data: ")
+	out.Write(envelope)
+	out.WriteString("\n\n")
+	return out.Bytes()
+}
+
+// sseEnvelopeMiddleware wraps the message events of SSE streams in an sseEnvelope when enabled
+func sseEnvelopeMiddleware(next http.Handler, enabled bool) http.Handler {
+	if !enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(&sseEnvelopeWriter{ResponseWriter: w, now: time.Now}, r)
+	})
+}
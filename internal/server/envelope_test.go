@@ -0,0 +1,126 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSSEEnvelopeMiddleware(t *testing.T) {
+	sentAt := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	frame := `{"jsonrpc":"2.0","id":1,"result":{}}`
+
+	tests := []struct {
+		name         string
+		contentType  string
+		writes       []string
+		expectedBody string
+		expectedData []string
+	}{
+		{
+			name:         "endpoint event is unchanged",
+			contentType:  "text/event-stream",
+			writes:       []string{"event: endpoint\ndata: /sse?sessionid=abc\n\n"},
+			expectedBody: "event: endpoint\ndata: /sse?sessionid=abc\n\n",
+		},
+		{
+			name:         "message event is wrapped",
+			contentType:  "text/event-stream",
+			writes:       []string{"event: message\ndata: " + frame + "\n\n"},
+			expectedData: []string{frame},
+		},
+		{
+			name:         "event split across writes",
+			contentType:  "text/event-stream",
+			writes:       []string{"event: message\nda", "ta: " + frame + "\n", "\n"},
+			expectedData: []string{frame},
+		},
+		{
+			name:        "several events in one write",
+			contentType: "text/event-stream",
+			writes: []string{
+				"event: endpoint\ndata: /sse?sessionid=abc\n\nevent: message\ndata: " + frame + "\n\n",
+			},
+			expectedData: []string{frame},
+		},
+		{
+			name:         "non-JSON message data is unchanged",
+			contentType:  "text/event-stream",
+			writes:       []string{"event: message\ndata: not json\n\n"},
+			expectedBody: "event: message\ndata: not json\n\n",
+		},
+		{
+			name:         "non-SSE response is unchanged",
+			contentType:  "text/plain",
+			writes:       []string{"Accepted"},
+			expectedBody: "Accepted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			recorder.Header().Set("Content-Type", tt.contentType)
+			writer := &sseEnvelopeWriter{ResponseWriter: recorder, now: func() time.Time { return sentAt }}
+
+			for _, write := range tt.writes {
+				n, err := writer.Write([]byte(write))
+				require.NoError(t, err)
+				assert.Equal(t, len(write), n)
+			}
+
+			if tt.expectedData == nil {
+				assert.Equal(t, tt.expectedBody, recorder.Body.String())
+				return
+			}
+
+			var envelopes []sseEnvelope
+			for _, line := range strings.Split(recorder.Body.String(), "\n") {
+				data, ok := strings.CutPrefix(line, "data: {")
+				if !ok {
+					continue
+				}
+				var envelope sseEnvelope
+				require.NoError(t, json.Unmarshal([]byte("{"+data), &envelope))
+				envelopes = append(envelopes, envelope)
+			}
+
+			require.Len(t, envelopes, len(tt.expectedData))
+			for i, envelope := range envelopes {
+				assert.JSONEq(t, tt.expectedData[i], string(envelope.Data))
+				assert.Equal(t, "2024-03-15T14:30:00Z", envelope.SentAt)
+				_, err := uuid.Parse(envelope.ID)
+				assert.NoError(t, err)
+			}
+			assert.Contains(t, recorder.Body.String(), "event: message\n")
+		})
+	}
+}
+
+func TestSSEEnvelopeMiddleware_Disabled(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Write([]byte("event: message\ndata: {}\n\n"))
+	})
+
+	recorder := httptest.NewRecorder()
+	sseEnvelopeMiddleware(handler, false).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/sse", nil))
+
+	assert.Equal(t, "event: message\ndata: {}\n\n", recorder.Body.String())
+}
+
+func TestSSEEnvelopeWriter_Flush(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	writer := &sseEnvelopeWriter{ResponseWriter: recorder, now: time.Now}
+
+	writer.Flush()
+
+	assert.True(t, recorder.Flushed)
+}
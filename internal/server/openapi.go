@@ -0,0 +1,233 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// swaggerUIVersion is the swagger-ui-dist release /docs loads from the CDN
+const swaggerUIVersion = "5.17.14"
+
+// docsPage renders SwaggerUI for this server's /openapi.json. The spec URL is relative so the
+// page works behind proxies and on any host.
+var docsPage = []byte(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>API documentation</title>
+  <link rel="stylesheet" href="https://cdn.jsdelivr.net/npm/swagger-ui-dist@` + swaggerUIVersion + `/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://cdn.jsdelivr.net/npm/swagger-ui-dist@` + swaggerUIVersion + `/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`)
+
+// openAPIDocument is the subset of the OpenAPI 3.0 document model used to describe the server
+type openAPIDocument struct {
+	OpenAPI string                     `json:"openapi"`
+	Info    openAPIInfo                `json:"info"`
+	Paths   map[string]openAPIPathItem `json:"paths"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type openAPIPathItem struct {
+	Get     *openAPIOperation `json:"get,omitempty"`
+	Post    *openAPIOperation `json:"post,omitempty"`
+	Options *openAPIOperation `json:"options,omitempty"`
+}
+
+type openAPIOperation struct {
+	Summary     string                     `json:"summary"`
+	Description string                     `json:"description,omitempty"`
+	Tags        []string                   `json:"tags,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema *openAPISchema `json:"schema,omitempty"`
+}
+
+type openAPISchema struct {
+	Type       string                    `json:"type"`
+	Format     string                    `json:"format,omitempty"`
+	Properties map[string]*openAPISchema `json:"properties,omitempty"`
+}
+
+// buildOpenAPIDocument describes the HTTP endpoints registered by setupMainHandler
+func buildOpenAPIDocument(cfg *config.Config) openAPIDocument {
+	jsonResponse := func(description string, schema *openAPISchema) openAPIResponse {
+		return openAPIResponse{
+			Description: description,
+			Content:     map[string]openAPIMediaType{"application/json": {Schema: schema}},
+		}
+	}
+
+	mcpOperation := func(summary, description string) *openAPIOperation {
+		return &openAPIOperation{
+			Summary:     summary,
+			Description: description,
+			Tags:        []string{"mcp"},
+			Responses: map[string]openAPIResponse{
+				"200": {Description: "MCP JSON-RPC response"},
+				"400": {Description: "Malformed MCP request"},
+			},
+		}
+	}
+
+	preflight := &openAPIOperation{
+		Summary:   "CORS preflight",
+		Tags:      []string{"mcp"},
+		Responses: map[string]openAPIResponse{"200": {Description: "CORS headers"}},
+	}
+
+	paths := map[string]openAPIPathItem{
+		"/health": {
+			Get: &openAPIOperation{
+				Summary: "Health check",
+				Tags:    []string{"monitoring"},
+				Responses: map[string]openAPIResponse{
+					"200": jsonResponse("Service is healthy", &openAPISchema{
+						Type: "object",
+						Properties: map[string]*openAPISchema{
+							"status":    {Type: "string"},
+							"service":   {Type: "string"},
+							"version":   {Type: "string"},
+							"timestamp": {Type: "string", Format: "date-time"},
+							"mock_mode": {Type: "boolean"},
+							"memory_usage": {
+								Type: "object",
+								Properties: map[string]*openAPISchema{
+									"heap_alloc_mb": {Type: "number"},
+									"heap_sys_mb":   {Type: "number"},
+									"num_gc":        {Type: "integer"},
+									"goroutines":    {Type: "integer"},
+								},
+							},
+							"service_stats": {
+								Type: "object",
+								Properties: map[string]*openAPISchema{
+									"total_get_time_calls":      {Type: "integer"},
+									"total_format_time_calls":   {Type: "integer"},
+									"total_parse_time_calls":    {Type: "integer"},
+									"total_timezone_info_calls": {Type: "integer"},
+									"total_errors":              {Type: "integer"},
+								},
+							},
+						},
+					}),
+				},
+			},
+		},
+		"/sse": {
+			Get: &openAPIOperation{
+				Summary:     "SSE transport",
+				Description: "Opens a Server-Sent Events stream for the MCP SSE transport",
+				Tags:        []string{"mcp"},
+				Responses: map[string]openAPIResponse{
+					"200": {
+						Description: "Event stream",
+						Content:     map[string]openAPIMediaType{"text/event-stream": {}},
+					},
+				},
+			},
+			Post:    mcpOperation("SSE transport messages", "Posts MCP messages for an open SSE session"),
+			Options: preflight,
+		},
+		"/streamable": {
+			Post:    mcpOperation("Streamable transport", "Stateless MCP Streamable HTTP transport"),
+			Options: preflight,
+		},
+		"/mcp": {
+			Post:    mcpOperation("Streamable transport (alias)", "Alias for /streamable"),
+			Options: preflight,
+		},
+		"/openapi.json": {
+			Get: &openAPIOperation{
+				Summary:   "OpenAPI document",
+				Tags:      []string{"docs"},
+				Responses: map[string]openAPIResponse{"200": jsonResponse("This document", &openAPISchema{Type: "object"})},
+			},
+		},
+		"/docs": {
+			Get: &openAPIOperation{
+				Summary: "API documentation",
+				Tags:    []string{"docs"},
+				Responses: map[string]openAPIResponse{
+					"200": {
+						Description: "SwaggerUI page for this document",
+						Content:     map[string]openAPIMediaType{"text/html": {}},
+					},
+				},
+			},
+		},
+	}
+
+	if cfg.Metrics.Enabled && cfg.Metrics.Port == cfg.Server.Port {
+		paths[cfg.Metrics.Path] = openAPIPathItem{
+			Get: &openAPIOperation{
+				Summary: "Prometheus metrics",
+				Tags:    []string{"monitoring"},
+				Responses: map[string]openAPIResponse{
+					"200": {
+						Description: "Metrics in Prometheus text exposition format",
+						Content:     map[string]openAPIMediaType{"text/plain": {}},
+					},
+				},
+			},
+		}
+	}
+
+	return openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       cfg.Server.Name,
+			Version:     cfg.Server.Version,
+			Description: "HTTP endpoints of the MCP time server",
+		},
+		Paths: paths,
+	}
+}
+
+// createOpenAPIHandler serves the OpenAPI document, marshaled once at setup time
+func createOpenAPIHandler(cfg *config.Config) (http.HandlerFunc, error) {
+	body, err := json.Marshal(buildOpenAPIDocument(cfg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal OpenAPI document: %w", err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(body)
+	}, nil
+}
+
+// createDocsHandler serves a SwaggerUI page loading this server's /openapi.json
+func createDocsHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(docsPage)
+	}
+}
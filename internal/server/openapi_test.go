@@ -0,0 +1,102 @@
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+func TestOpenAPIDocument(t *testing.T) {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+
+	tests := []struct {
+		name          string
+		metrics       config.MetricsConfig
+		expectedPaths []string
+	}{
+		{
+			name:          "metrics on a separate port",
+			metrics:       config.MetricsConfig{Enabled: true, Port: 9090, Path: "/metrics"},
+			expectedPaths: []string{"/docs", "/health", "/mcp", "/openapi.json", "/sse", "/streamable"},
+		},
+		{
+			name:          "metrics on the main port",
+			metrics:       config.MetricsConfig{Enabled: true, Port: 8080, Path: "/metrics"},
+			expectedPaths: []string{"/docs", "/health", "/mcp", "/metrics", "/openapi.json", "/sse", "/streamable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server:  config.ServerConfig{Name: "test-server", Version: "1.2.3", Host: "localhost", Port: 8080, Docs: true},
+				Metrics: tt.metrics,
+			}
+			mux := setupMainHandler(cfg, mcpServer, nil, testMetrics, zaptest.NewLogger(t))
+
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var document openAPIDocument
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &document))
+			assert.Equal(t, "3.0.3", document.OpenAPI)
+			assert.Equal(t, "test-server", document.Info.Title)
+			assert.Equal(t, "1.2.3", document.Info.Version)
+
+			paths := make([]string, 0, len(document.Paths))
+			for path := range document.Paths {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			assert.Equal(t, tt.expectedPaths, paths)
+
+			// Every documented path is mounted on the mux
+			for _, path := range paths {
+				_, pattern := mux.Handler(httptest.NewRequest(http.MethodGet, path, nil))
+				assert.Equal(t, path, pattern)
+			}
+		})
+	}
+}
+
+func TestDocsHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	createDocsHandler()(rec, httptest.NewRequest(http.MethodGet, "/docs", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/html; charset=utf-8", rec.Header().Get("Content-Type"))
+	assert.Contains(t, rec.Body.String(), `url: "/openapi.json"`)
+	assert.Contains(t, rec.Body.String(), "swagger-ui-dist@"+swaggerUIVersion+"/swagger-ui-bundle.js")
+	assert.NotContains(t, rec.Body.String(), "petstore")
+}
+
+func TestSetupMainHandler_DocsGating(t *testing.T) {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+
+	for _, docs := range []bool{true, false} {
+		cfg := &config.Config{Server: config.ServerConfig{Name: "test", Version: "1.0.0", Host: "localhost", Port: 8080, Docs: docs}}
+		mux := setupMainHandler(cfg, mcpServer, nil, testMetrics, zaptest.NewLogger(t))
+
+		expectedStatus := http.StatusNotFound
+		if docs {
+			expectedStatus = http.StatusOK
+		}
+
+		for _, path := range []string{"/openapi.json", "/docs"} {
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+			assert.Equal(t, expectedStatus, rec.Code, "%s with docs %t", path, docs)
+		}
+	}
+}
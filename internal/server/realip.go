@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// clientIPKey is the request context key holding the resolved client IP
+type clientIPKey struct{}
+
+// clientIP returns the client IP resolved by realIPMiddleware, falling back to the connecting address
+func clientIP(r *http.Request) string {
+	if ip, ok := r.Context().Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	return remoteIP(r)
+}
+
+// remoteIP returns the IP of the connecting peer without its port
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// realIPMiddleware resolves the real client IP and stores it in the request context.
+// When trusted proxies are configured, the forwarded IP header is only honored from them:
+// the chain is walked from the right, skipping trusted proxies, and the header is rewritten
+// to the single resolved IP. Requests carrying the header from untrusted peers are rejected.
+// Without trusted proxies the header is ignored and the connecting address is used.
+func realIPMiddleware(next http.Handler, proxy config.ProxyConfig, logger *zap.Logger) http.Handler {
+	var trusted []*net.IPNet
+	for _, cidr := range proxy.TrustedProxyCIDRs {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			// Config validation rejects invalid CIDRs, so this only happens with hand-built configs
+			logger.Error("Ignoring invalid trusted proxy CIDR", zap.String("cidr", cidr), zap.Error(err))
+			continue
+		}
+		trusted = append(trusted, network)
+	}
+
+	header := proxy.ForwardedIPHeader
+	if header == "" {
+		header = "X-Forwarded-For"
+	}
+
+	isTrusted := func(ip net.IP) bool {
+		for _, network := range trusted {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := remoteIP(r)
+
+		if len(trusted) > 0 {
+			if forwarded := r.Header.Values(header); len(forwarded) > 0 {
+				if peer := net.ParseIP(ip); peer == nil || !isTrusted(peer) {
+					logger.Warn("Rejecting forwarded request from untrusted peer",
+						zap.String("remote_addr", r.RemoteAddr),
+						zap.String("header", header))
+					http.Error(w, "forwarded headers are only accepted from trusted proxies", http.StatusForbidden)
+					return
+				}
+
+				ip = forwardedClientIP(forwarded, isTrusted, ip)
+				r.Header.Set(header, ip)
+			}
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+	})
+}
+
+// forwardedClientIP walks a forwarded-for chain from the right and returns the first address that
+// isn't a trusted proxy, or the leftmost valid address when every hop is trusted
+func forwardedClientIP(values []string, isTrusted func(net.IP) bool, fallback string) string {
+	var hops []string
+	for _, value := range values {
+		for _, hop := range strings.Split(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	client := fallback
+	for i := len(hops) - 1; i >= 0; i-- {
+		ip := net.ParseIP(hops[i])
+		if ip == nil {
+			// A malformed hop can't be trusted, so nothing to its left can be either
+			break
+		}
+
+		client = ip.String()
+		if !isTrusted(ip) {
+			break
+		}
+	}
+
+	return client
+}
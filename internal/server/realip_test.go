@@ -0,0 +1,115 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+func TestRealIPMiddleware(t *testing.T) {
+	trustedProxies := config.ProxyConfig{
+		TrustedProxyCIDRs: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		ForwardedIPHeader: "X-Forwarded-For",
+	}
+
+	tests := []struct {
+		name           string
+		proxy          config.ProxyConfig
+		remoteAddr     string
+		forwarded      []string
+		expectedStatus int
+		expectedIP     string
+	}{
+		{
+			name:           "no trusted proxies ignores the header",
+			proxy:          config.ProxyConfig{ForwardedIPHeader: "X-Forwarded-For"},
+			remoteAddr:     "203.0.113.7:5555",
+			forwarded:      []string{"198.51.100.1"},
+			expectedStatus: http.StatusOK,
+			expectedIP:     "203.0.113.7",
+		},
+		{
+			name:           "direct request without header",
+			proxy:          trustedProxies,
+			remoteAddr:     "203.0.113.7:5555",
+			expectedStatus: http.StatusOK,
+			expectedIP:     "203.0.113.7",
+		},
+		{
+			name:           "single hop through a trusted proxy",
+			proxy:          trustedProxies,
+			remoteAddr:     "10.1.2.3:5555",
+			forwarded:      []string{"198.51.100.1"},
+			expectedStatus: http.StatusOK,
+			expectedIP:     "198.51.100.1",
+		},
+		{
+			name:           "trusted hops are skipped from the right",
+			proxy:          trustedProxies,
+			remoteAddr:     "10.1.2.3:5555",
+			forwarded:      []string{"1.1.1.1, 198.51.100.1", "192.168.4.4"},
+			expectedStatus: http.StatusOK,
+			expectedIP:     "198.51.100.1",
+		},
+		{
+			name:           "all hops trusted resolves to the leftmost",
+			proxy:          trustedProxies,
+			remoteAddr:     "10.1.2.3:5555",
+			forwarded:      []string{"192.168.1.1, 10.9.9.9"},
+			expectedStatus: http.StatusOK,
+			expectedIP:     "192.168.1.1",
+		},
+		{
+			name:           "untrusted peer sending the header is rejected",
+			proxy:          trustedProxies,
+			remoteAddr:     "203.0.113.7:5555",
+			forwarded:      []string{"198.51.100.1"},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "custom header",
+			proxy:          config.ProxyConfig{TrustedProxyCIDRs: []string{"10.0.0.0/8"}, ForwardedIPHeader: "X-Real-IP"},
+			remoteAddr:     "10.1.2.3:5555",
+			forwarded:      []string{"2001:db8::1"},
+			expectedStatus: http.StatusOK,
+			expectedIP:     "2001:db8::1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIP, gotHeader string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotIP = clientIP(r)
+				gotHeader = r.Header.Get(tt.proxy.ForwardedIPHeader)
+			})
+
+			handler := realIPMiddleware(next, tt.proxy, zaptest.NewLogger(t))
+
+			req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwarded {
+				req.Header.Add(tt.proxy.ForwardedIPHeader, value)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+
+			assert.Equal(t, tt.expectedIP, gotIP)
+			if len(tt.forwarded) > 0 && len(tt.proxy.TrustedProxyCIDRs) > 0 {
+				// The header is stripped to the single resolved IP
+				assert.Equal(t, tt.expectedIP, gotHeader)
+			}
+		})
+	}
+}
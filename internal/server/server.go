@@ -2,37 +2,145 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
-	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 
 	"github.com/topfreegames/mcp-server-time/internal/config"
 	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
 )
 
 // HTTPServer wraps HTTP server functionality
 type HTTPServer struct {
 	Server        *http.Server
 	MetricsServer *http.Server
+	AdminServer   *http.Server // optional, see NewAdminServer
 	logger        *zap.Logger
 }
 
-// NewHTTPServer creates a new HTTP server with MCP endpoints
-func NewHTTPServer(cfg *config.Config, mcpServer *mcp.Server, metrics *metrics.Metrics, logger *zap.Logger) *HTTPServer {
-	mux := setupMainHandler(cfg, mcpServer, metrics, logger)
+// HTTPServerOption configures an HTTPServer
+type HTTPServerOption func(*httpServerOptions)
+
+// httpServerOptions holds the settings applied by HTTPServerOption values
+type httpServerOptions struct {
+	config    *config.Config
+	mcpServer *mcp.Server
+	metrics   *metrics.Metrics
+	logger    *zap.Logger
+	tlsConfig *tls.Config
+	cors      *config.CORSConfig
+
+	timeService timeservice.TimeService
+}
+
+// WithConfig sets the configuration the server listens and serves with
+func WithConfig(cfg *config.Config) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.config = cfg
+	}
+}
+
+// WithMCPServer sets the MCP server behind the /sse, /streamable and /mcp endpoints
+func WithMCPServer(mcpServer *mcp.Server) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.mcpServer = mcpServer
+	}
+}
+
+// WithMetrics sets the collector recording transport and HTTP request metrics
+func WithMetrics(metrics *metrics.Metrics) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.metrics = metrics
+	}
+}
+
+// WithLogger sets the logger used by the server
+func WithLogger(logger *zap.Logger) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.logger = logger
+	}
+}
+
+// WithTimeService sets the time service whose operation counts /health reports when
+// health.include_service_stats is enabled
+func WithTimeService(timeService timeservice.TimeService) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.timeService = timeService
+	}
+}
+
+// WithTLSConfig serves the main server over TLS. The config must carry the certificates, since
+// Start does not load any from disk.
+func WithTLSConfig(tlsConfig *tls.Config) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithCORSConfig overrides the server.cors settings of the configuration
+func WithCORSConfig(cors config.CORSConfig) HTTPServerOption {
+	return func(o *httpServerOptions) {
+		o.cors = &cors
+	}
+}
+
+// NewHTTPServer creates a new HTTP server with MCP endpoints. Without WithConfig the zero
+// configuration is used; without WithMCPServer, WithMetrics or WithLogger the server gets an empty
+// MCP server, metrics on a private registry and a no-op logger.
+func NewHTTPServer(opts ...HTTPServerOption) *HTTPServer {
+	o := httpServerOptions{config: &config.Config{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cfg := o.config
+	if o.cors != nil {
+		// Copy so the caller's configuration is left untouched
+		overridden := *cfg
+		overridden.Server.CORS = *o.cors
+		cfg = &overridden
+	}
+
+	logger := o.logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	collector := o.metrics
+	if collector == nil {
+		collector = metrics.New(metrics.WithRegistry(prometheus.NewRegistry()))
+	}
+	mcpServer := o.mcpServer
+	if mcpServer == nil {
+		mcpServer = mcp.NewServer(&mcp.Implementation{Name: cfg.Server.Name, Version: cfg.Server.Version}, nil)
+	}
+
+	mux := setupMainHandler(cfg, mcpServer, o.timeService, collector, logger)
 
 	server := &http.Server{
-		Addr:    fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
-		Handler: mux,
+		Addr:      fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port),
+		Handler:   newMainHandler(cfg, mux, logger),
+		TLSConfig: o.tlsConfig,
+	}
+
+	// net/http negotiates HTTP/2 over TLS by default; a non-nil empty map opts out
+	if !cfg.Server.HTTP2 {
+		server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
 	}
 
 	var metricsServer *http.Server
 	if cfg.Metrics.Enabled && cfg.Metrics.Port != cfg.Server.Port {
-		metricsServer = setupMetricsServer(cfg, logger)
+		metricsServer = setupMetricsServer(cfg, collector, logger)
 	}
 
 	return &HTTPServer{
@@ -43,7 +151,7 @@ func NewHTTPServer(cfg *config.Config, mcpServer *mcp.Server, metrics *metrics.M
 }
 
 // setupMainHandler configures the main HTTP handler with all endpoints
-func setupMainHandler(cfg *config.Config, mcpServer *mcp.Server, metrics *metrics.Metrics, logger *zap.Logger) *http.ServeMux {
+func setupMainHandler(cfg *config.Config, mcpServer *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	// Create MCP transport handlers
@@ -57,26 +165,54 @@ func setupMainHandler(cfg *config.Config, mcpServer *mcp.Server, metrics *metric
 		Stateless: true,
 	})
 
+	// Streamable responses are bounded; the SSE stream is long-lived, so neither a size limit
+	// nor a request deadline applies to it
+	limitedStreamableHandler := responseSizeLimitMiddleware(
+		requestTimeoutMiddleware(streamableHandler, cfg.ToolTimeouts, logger),
+		cfg.Server.MaxResponseBodyBytes, logger)
+
 	// Register MCP endpoints with metrics
-	mux.Handle("/sse", withMetrics(sseHandler, metrics, logger, "sse"))
-	mux.Handle("/streamable", withMetrics(streamableHandler, metrics, logger, "streamable"))
-	mux.Handle("/mcp", withMetrics(streamableHandler, metrics, logger, "streamable")) // Alias
+	mux.Handle("/sse", withMetrics(sseEnvelopeMiddleware(sseHandler, cfg.Server.SSE.WrapInEnvelope), cfg.Server.CORS, metrics, logger, "sse"))
+	mux.Handle("/streamable", withMetrics(limitedStreamableHandler, cfg.Server.CORS, metrics, logger, "streamable"))
+	mux.Handle("/mcp", withMetrics(limitedStreamableHandler, cfg.Server.CORS, metrics, logger, "streamable")) // Alias
 
 	// Register health check
-	mux.HandleFunc("/health", createHealthHandler(cfg))
+	mux.Handle("/health", withHTTPMetrics(createHealthHandler(cfg, timeService), metrics, "/health"))
 
 	// Register metrics endpoint if enabled on same port
 	if cfg.Metrics.Enabled && cfg.Metrics.Port == cfg.Server.Port {
-		mux.Handle(cfg.Metrics.Path, promhttp.Handler())
+		mux.Handle(cfg.Metrics.Path, metrics.Handler())
+	}
+
+	// Register API documentation if enabled
+	if cfg.Server.Docs {
+		openAPIHandler, err := createOpenAPIHandler(cfg)
+		if err != nil {
+			logger.Error("Failed to build OpenAPI document", zap.Error(err))
+		} else {
+			mux.HandleFunc("/openapi.json", openAPIHandler)
+			mux.HandleFunc("/docs", createDocsHandler())
+		}
 	}
 
 	return mux
 }
 
+// newMainHandler wraps the main mux to resolve the real client IP and, when enabled, to accept
+// HTTP/2 without TLS (h2c)
+func newMainHandler(cfg *config.Config, mux *http.ServeMux, logger *zap.Logger) http.Handler {
+	handler := realIPMiddleware(mux, cfg.Server.Proxy, logger)
+
+	if cfg.Server.HTTP2Cleartext {
+		return h2c.NewHandler(handler, &http2.Server{})
+	}
+	return handler
+}
+
 // setupMetricsServer creates a separate metrics server if configured
-func setupMetricsServer(cfg *config.Config, logger *zap.Logger) *http.Server {
+func setupMetricsServer(cfg *config.Config, metrics *metrics.Metrics, logger *zap.Logger) *http.Server {
 	metricsMux := http.NewServeMux()
-	metricsMux.Handle(cfg.Metrics.Path, promhttp.Handler())
+	metricsMux.Handle(cfg.Metrics.Path, metrics.Handler())
 
 	return &http.Server{
 		Addr:    fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Metrics.Port),
@@ -84,13 +220,63 @@ func setupMetricsServer(cfg *config.Config, logger *zap.Logger) *http.Server {
 	}
 }
 
-// createHealthHandler creates the health check endpoint handler
-func createHealthHandler(cfg *config.Config) http.HandlerFunc {
+// healthResponse is the /health response body
+type healthResponse struct {
+	Status      string       `json:"status"`
+	Service     string       `json:"service"`
+	Version     string       `json:"version"`
+	Timestamp   string       `json:"timestamp"`
+	MockMode    bool         `json:"mock_mode,omitempty"`
+	MemoryUsage *MemoryStats `json:"memory_usage,omitempty"`
+
+	ServiceStats *timeservice.ServiceStats `json:"service_stats,omitempty"`
+}
+
+// MemoryStats contains Go runtime memory statistics reported by /health
+type MemoryStats struct {
+	HeapAllocMB float64 `json:"heap_alloc_mb"`
+	HeapSysMB   float64 `json:"heap_sys_mb"`
+	NumGC       uint32  `json:"num_gc"`
+	Goroutines  int     `json:"goroutines"`
+}
+
+// readMemoryStats samples the current runtime memory statistics
+func readMemoryStats() *MemoryStats {
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+
+	const bytesPerMB = 1024 * 1024
+	return &MemoryStats{
+		HeapAllocMB: float64(m.HeapAlloc) / bytesPerMB,
+		HeapSysMB:   float64(m.HeapSys) / bytesPerMB,
+		NumGC:       m.NumGC,
+		Goroutines:  runtime.NumGoroutine(),
+	}
+}
+
+// createHealthHandler creates the health check endpoint handler. timeService may be nil, in
+// which case no service stats are reported.
+func createHealthHandler(cfg *config.Config, timeService timeservice.TimeService) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		response := healthResponse{
+			Status:    "healthy",
+			Service:   cfg.Server.Name,
+			Version:   cfg.Server.Version,
+			Timestamp: time.Now().UTC().Format(time.RFC3339),
+			MockMode:  cfg.Server.MockMode,
+		}
+		// Runtime internals are opt-in so they are not exposed by default
+		if cfg.Health.IncludeRuntime {
+			response.MemoryUsage = readMemoryStats()
+		}
+		if cfg.Health.IncludeServiceStats && timeService != nil {
+			stats := timeService.Stats()
+			response.ServiceStats = &stats
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		fmt.Fprintf(w, `{"status":"healthy","service":"%s","version":"%s","timestamp":"%s"}`,
-			cfg.Server.Name, cfg.Server.Version, time.Now().UTC().Format(time.RFC3339))
+		json.NewEncoder(w).Encode(response)
 	}
 }
 
@@ -108,11 +294,28 @@ func (s *HTTPServer) Start() error {
 		}()
 	}
 
+	// Start admin server in background if configured
+	if s.AdminServer != nil {
+		go func() {
+			s.logger.Info("Starting admin server",
+				zap.String("addr", s.AdminServer.Addr))
+
+			if err := s.AdminServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				s.logger.Error("Admin server failed", zap.Error(err))
+			}
+		}()
+	}
+
 	// Start main server
 	s.logger.Info("Starting MCP server",
 		zap.String("addr", s.Server.Addr),
+		zap.Bool("tls", s.Server.TLSConfig != nil),
 		zap.Strings("endpoints", []string{"/sse", "/streamable", "/mcp", "/health"}))
 
+	// Certificates come from the TLS config set with WithTLSConfig
+	if s.Server.TLSConfig != nil {
+		return s.Server.ListenAndServeTLS("", "")
+	}
 	return s.Server.ListenAndServe()
 }
 
@@ -134,12 +337,20 @@ func (s *HTTPServer) Shutdown(ctx context.Context) error {
 		}
 	}
 
+	// Shutdown admin server if running
+	if s.AdminServer != nil {
+		if err := s.AdminServer.Shutdown(ctx); err != nil {
+			s.logger.Error("Admin server forced shutdown", zap.Error(err))
+			return err
+		}
+	}
+
 	s.logger.Info("Server shutdown complete")
 	return nil
 }
 
 // withMetrics wraps an HTTP handler with metrics collection
-func withMetrics(handler http.Handler, metrics *metrics.Metrics, logger *zap.Logger, transport string) http.Handler {
+func withMetrics(handler http.Handler, cors config.CORSConfig, metrics *metrics.Metrics, logger *zap.Logger, transport string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		startTime := time.Now()
 
@@ -147,23 +358,29 @@ func withMetrics(handler http.Handler, metrics *metrics.Metrics, logger *zap.Log
 			zap.String("transport", transport),
 			zap.String("method", r.Method),
 			zap.String("path", r.URL.Path),
+			zap.String("client_ip", clientIP(r)),
 			zap.String("remote_addr", r.RemoteAddr))
 
 		// Set CORS headers for all transports
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, "+requestTimeoutHeader)
+
+		// Wrap response writer to capture status and size
+		wrapped := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
 
-		// Handle preflight requests
+		// Handle preflight requests, letting browsers cache the result
 		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusOK)
+			if cors.PreflightMaxAgeSeconds > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(cors.PreflightMaxAgeSeconds))
+			}
+			wrapped.WriteHeader(http.StatusOK)
 			metrics.RecordTransportRequest(transport, r.Method, "success")
+			metrics.RecordHTTPRequest(r.URL.Path, r.Method, wrapped.statusCode,
+				time.Since(startTime).Seconds(), r.ContentLength, wrapped.bytesWritten)
 			return
 		}
 
-		// Wrap response writer to capture status
-		wrapped := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
-
 		// Call the actual handler
 		handler.ServeHTTP(wrapped, r)
 
@@ -176,21 +393,56 @@ func withMetrics(handler http.Handler, metrics *metrics.Metrics, logger *zap.Log
 		metrics.RecordTransportRequest(transport, r.Method, status)
 
 		duration := time.Since(startTime)
+		metrics.RecordHTTPRequest(r.URL.Path, r.Method, wrapped.statusCode,
+			duration.Seconds(), r.ContentLength, wrapped.bytesWritten)
 		logger.Debug("MCP transport request completed",
 			zap.String("transport", transport),
 			zap.String("method", r.Method),
+			zap.String("client_ip", clientIP(r)),
 			zap.Int("status", wrapped.statusCode),
 			zap.Duration("duration", duration))
 	})
 }
 
-// responseWriterWrapper captures the status code
+// withHTTPMetrics records the HTTP request duration and size metrics of a non-MCP endpoint, without
+// the CORS headers and transport counters of withMetrics
+func withHTTPMetrics(handler http.Handler, metrics *metrics.Metrics, endpoint string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+
+		wrapped := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
+		handler.ServeHTTP(wrapped, r)
+
+		metrics.RecordHTTPRequest(endpoint, r.Method, wrapped.statusCode,
+			time.Since(startTime).Seconds(), r.ContentLength, wrapped.bytesWritten)
+	})
+}
+
+// responseWriterWrapper captures the status code and response size
 type responseWriterWrapper struct {
 	http.ResponseWriter
-	statusCode int
+	statusCode   int
+	bytesWritten int64
 }
 
 func (w *responseWriterWrapper) WriteHeader(code int) {
 	w.statusCode = code
 	w.ResponseWriter.WriteHeader(code)
 }
+
+func (w *responseWriterWrapper) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.bytesWritten += int64(n)
+	return n, err
+}
+
+// Flush passes flushes through to the underlying writer. The MCP SSE transport flushes each event
+// through the http.Flusher interface, so the wrapper must implement it directly.
+func (w *responseWriterWrapper) Flush() {
+	_ = http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController, so flushes reach the client
+func (w *responseWriterWrapper) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
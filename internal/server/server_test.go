@@ -0,0 +1,349 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/net/http2"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+// testMetrics uses a private registry so tests don't register collectors globally
+var testMetrics = metrics.New(metrics.WithRegistry(prometheus.NewRegistry()))
+
+// h2cClient speaks HTTP/2 with prior knowledge over a plain TCP connection
+func h2cClient() *http.Client {
+	return &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, network, addr)
+			},
+		},
+	}
+}
+
+func TestNewHTTPServer_HTTP2Cleartext(t *testing.T) {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+
+	tests := []struct {
+		name           string
+		http2Cleartext bool
+		wantErr        bool
+	}{
+		{name: "h2c enabled negotiates HTTP/2", http2Cleartext: true},
+		{name: "h2c disabled rejects HTTP/2 prior knowledge", http2Cleartext: false, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server: config.ServerConfig{Name: "test", Version: "1.0.0", Host: "localhost", Port: 8080,
+					HTTP2: true, HTTP2Cleartext: tt.http2Cleartext},
+			}
+
+			httpServer := NewHTTPServer(WithConfig(cfg), WithMCPServer(mcpServer), WithMetrics(testMetrics), WithLogger(zaptest.NewLogger(t)))
+			ts := httptest.NewServer(httpServer.Server.Handler)
+			defer ts.Close()
+
+			resp, err := h2cClient().Get(ts.URL + "/health")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Equal(t, 2, resp.ProtoMajor)
+		})
+	}
+}
+
+func TestNewHTTPServer_HTTP2OverTLS(t *testing.T) {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+
+	tests := []struct {
+		name          string
+		http2         bool
+		expectedProto int
+	}{
+		{name: "enabled", http2: true, expectedProto: 2},
+		{name: "disabled", http2: false, expectedProto: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server: config.ServerConfig{Name: "test", Version: "1.0.0", Host: "localhost", Port: 8080, HTTP2: tt.http2},
+			}
+
+			httpServer := NewHTTPServer(WithConfig(cfg), WithMCPServer(mcpServer), WithMetrics(testMetrics), WithLogger(zaptest.NewLogger(t)))
+
+			ts := httptest.NewUnstartedServer(httpServer.Server.Handler)
+			ts.Config.TLSNextProto = httpServer.Server.TLSNextProto
+			ts.EnableHTTP2 = tt.http2
+			ts.StartTLS()
+			defer ts.Close()
+
+			resp, err := ts.Client().Get(ts.URL + "/health")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedProto, resp.ProtoMajor)
+		})
+	}
+}
+
+func TestHealthHandler_IncludeRuntime(t *testing.T) {
+	tests := []struct {
+		name           string
+		includeRuntime bool
+	}{
+		{name: "disabled returns minimal response", includeRuntime: false},
+		{name: "enabled adds memory usage", includeRuntime: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server: config.ServerConfig{Name: "test", Version: "1.0.0"},
+				Health: config.HealthConfig{IncludeRuntime: tt.includeRuntime},
+			}
+
+			rec := httptest.NewRecorder()
+			createHealthHandler(cfg, nil)(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+			assert.Equal(t, "healthy", body["status"])
+			assert.Equal(t, "test", body["service"])
+
+			if !tt.includeRuntime {
+				assert.NotContains(t, body, "memory_usage")
+				return
+			}
+
+			memory, ok := body["memory_usage"].(map[string]interface{})
+			require.True(t, ok, "memory_usage should be an object")
+			assert.Greater(t, memory["heap_alloc_mb"], 0.0)
+			assert.Greater(t, memory["heap_sys_mb"], 0.0)
+			assert.Contains(t, memory, "num_gc")
+			assert.GreaterOrEqual(t, memory["goroutines"], 1.0)
+		})
+	}
+}
+
+func TestHealthHandler_IncludeServiceStats(t *testing.T) {
+	timeService := timeservice.NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+	_, err := timeService.GetCurrentTime(context.Background(), timeservice.GetTimeInput{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name                string
+		includeServiceStats bool
+		timeService         timeservice.TimeService
+		expectStats         bool
+	}{
+		{name: "disabled", timeService: timeService},
+		{name: "enabled", includeServiceStats: true, timeService: timeService, expectStats: true},
+		{name: "enabled without time service", includeServiceStats: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{Health: config.HealthConfig{IncludeServiceStats: tt.includeServiceStats}}
+
+			rec := httptest.NewRecorder()
+			createHealthHandler(cfg, tt.timeService)(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var body map[string]interface{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+
+			if !tt.expectStats {
+				assert.NotContains(t, body, "service_stats")
+				return
+			}
+
+			stats, ok := body["service_stats"].(map[string]interface{})
+			require.True(t, ok, "service_stats should be an object")
+			assert.Equal(t, 1.0, stats["total_get_time_calls"])
+			assert.Equal(t, 0.0, stats["total_errors"])
+		})
+	}
+}
+
+// BenchmarkHealthHandler measures /health latency with and without runtime stats; both
+// stay well under a millisecond per request
+func BenchmarkHealthHandler(b *testing.B) {
+	for _, includeRuntime := range []bool{false, true} {
+		name := "minimal"
+		if includeRuntime {
+			name = "include_runtime"
+		}
+
+		b.Run(name, func(b *testing.B) {
+			cfg := &config.Config{
+				Server: config.ServerConfig{Name: "test", Version: "1.0.0"},
+				Health: config.HealthConfig{IncludeRuntime: includeRuntime},
+			}
+			handler := createHealthHandler(cfg, nil)
+			req := httptest.NewRequest(http.MethodGet, "/health", nil)
+
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				handler(httptest.NewRecorder(), req)
+			}
+		})
+	}
+}
+
+func TestWithMetrics_PreflightMaxAge(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	tests := []struct {
+		name           string
+		method         string
+		maxAge         int
+		expectedStatus int
+		expectedHeader string
+	}{
+		{name: "preflight cached", method: http.MethodOptions, maxAge: 600, expectedStatus: http.StatusOK, expectedHeader: "600"},
+		{name: "preflight caching disabled", method: http.MethodOptions, maxAge: 0, expectedStatus: http.StatusOK, expectedHeader: ""},
+		{name: "regular request", method: http.MethodPost, maxAge: 600, expectedStatus: http.StatusAccepted, expectedHeader: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := withMetrics(next, config.CORSConfig{PreflightMaxAgeSeconds: tt.maxAge}, testMetrics, zaptest.NewLogger(t), "streamable")
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(tt.method, "/mcp", nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, tt.expectedHeader, rec.Header().Get("Access-Control-Max-Age"))
+		})
+	}
+}
+
+func TestWithHTTPMetrics_Health(t *testing.T) {
+	m := metrics.New(metrics.WithRegistry(prometheus.NewRegistry()))
+	cfg := &config.Config{Server: config.ServerConfig{Name: "test-server", Version: "1.0.0"}}
+
+	handler := withHTTPMetrics(createHealthHandler(cfg, nil), m, "/health")
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/health", nil))
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+
+	assert.Equal(t, 1, testutil.CollectAndCount(&m.HTTPRequestDuration))
+	assert.Equal(t, 1, testutil.CollectAndCount(&m.HTTPResponseSize))
+	assert.Equal(t, 0, testutil.CollectAndCount(&m.TransportRequestsTotal))
+}
+
+func TestNewHTTPServer_SSEFlushesEvents(t *testing.T) {
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	cfg := &config.Config{Server: config.ServerConfig{Name: "test", Version: "1.0.0", Host: "localhost", Port: 8080}}
+
+	httpServer := NewHTTPServer(WithConfig(cfg), WithMCPServer(mcpServer), WithMetrics(testMetrics), WithLogger(zaptest.NewLogger(t)))
+	ts := httptest.NewServer(httpServer.Server.Handler)
+	defer ts.Close()
+
+	// The SSE stream stays open, so the endpoint event only arrives if it is flushed
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+"/sse", nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	line, err := bufio.NewReader(resp.Body).ReadString('\n')
+	require.NoError(t, err)
+	assert.Equal(t, "event: endpoint\n", line)
+}
+
+func TestNewHTTPServer_Defaults(t *testing.T) {
+	httpServer := NewHTTPServer()
+	ts := httptest.NewServer(httpServer.Server.Handler)
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/health")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Nil(t, httpServer.Server.TLSConfig)
+	assert.Nil(t, httpServer.MetricsServer)
+}
+
+func TestNewHTTPServer_WithTLSConfig(t *testing.T) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13}
+	httpServer := NewHTTPServer(WithConfig(&config.Config{}), WithTLSConfig(tlsConfig), WithLogger(zaptest.NewLogger(t)))
+
+	assert.Same(t, tlsConfig, httpServer.Server.TLSConfig)
+}
+
+func TestNewHTTPServer_WithCORSConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           []HTTPServerOption
+		expectedMaxAge string
+	}{
+		{name: "from configuration", expectedMaxAge: "600"},
+		{name: "overridden", opts: []HTTPServerOption{WithCORSConfig(config.CORSConfig{PreflightMaxAgeSeconds: 60})}, expectedMaxAge: "60"},
+		{name: "overridden without max age", opts: []HTTPServerOption{WithCORSConfig(config.CORSConfig{})}, expectedMaxAge: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Server: config.ServerConfig{Name: "test", Version: "1.0.0", CORS: config.CORSConfig{PreflightMaxAgeSeconds: 600}},
+			}
+
+			opts := append([]HTTPServerOption{WithConfig(cfg), WithMetrics(testMetrics), WithLogger(zaptest.NewLogger(t))}, tt.opts...)
+			ts := httptest.NewServer(NewHTTPServer(opts...).Server.Handler)
+			defer ts.Close()
+
+			req, err := http.NewRequest(http.MethodOptions, ts.URL+"/mcp", nil)
+			require.NoError(t, err)
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.expectedMaxAge, resp.Header.Get("Access-Control-Max-Age"))
+			assert.Equal(t, 600, cfg.Server.CORS.PreflightMaxAgeSeconds, "the caller's configuration is not modified")
+		})
+	}
+}
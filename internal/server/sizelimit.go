@@ -0,0 +1,112 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap"
+)
+
+// errResponseTooLarge is returned to handlers writing past the response size limit
+var errResponseTooLarge = errors.New("response exceeds the configured size limit")
+
+// sizeLimitResponseWriter holds a response back until the handler flushes or returns, so a
+// response that turns out to be too large can be replaced by an error. Once the response has
+// been committed, writes past the limit are dropped instead.
+type sizeLimitResponseWriter struct {
+	http.ResponseWriter
+	limit      int64
+	written    int64
+	statusCode int
+	buffer     bytes.Buffer
+	committed  bool
+	exceeded   bool
+}
+
+// WriteHeader records the status code until the response is committed
+func (w *sizeLimitResponseWriter) WriteHeader(code int) {
+	if w.committed {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if w.statusCode == 0 {
+		w.statusCode = code
+	}
+}
+
+// Write buffers the body while it fits the limit, or passes it through once committed
+func (w *sizeLimitResponseWriter) Write(b []byte) (int, error) {
+	if w.exceeded {
+		return 0, errResponseTooLarge
+	}
+
+	if w.written+int64(len(b)) > w.limit {
+		w.exceeded = true
+		w.buffer.Reset()
+		return 0, errResponseTooLarge
+	}
+	w.written += int64(len(b))
+
+	if w.committed {
+		return w.ResponseWriter.Write(b)
+	}
+	return w.buffer.Write(b)
+}
+
+// Flush commits the buffered response so streamed events reach the client
+func (w *sizeLimitResponseWriter) Flush() {
+	if w.exceeded {
+		return
+	}
+	w.commit()
+	// Writers that cannot flush still deliver the data when the handler returns
+	http.NewResponseController(w.ResponseWriter).Flush()
+}
+
+// commit sends the recorded status and the buffered body
+func (w *sizeLimitResponseWriter) commit() {
+	if w.committed {
+		return
+	}
+	w.committed = true
+
+	if w.statusCode != 0 {
+		w.ResponseWriter.WriteHeader(w.statusCode)
+	}
+	if w.buffer.Len() > 0 {
+		w.ResponseWriter.Write(w.buffer.Bytes())
+		w.buffer.Reset()
+	}
+}
+
+// responseSizeLimitMiddleware rejects responses larger than limit bytes with 507 Insufficient
+// Storage, protecting clients from accidentally huge results. A limit of 0 disables the check.
+func responseSizeLimitMiddleware(next http.Handler, limit int64, logger *zap.Logger) http.Handler {
+	if limit <= 0 {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limited := &sizeLimitResponseWriter{ResponseWriter: w, limit: limit}
+		next.ServeHTTP(limited, r)
+
+		if !limited.exceeded {
+			limited.commit()
+			return
+		}
+
+		logger.Warn("Response exceeded size limit",
+			zap.String("path", r.URL.Path),
+			zap.String("method", r.Method),
+			zap.String("client_ip", clientIP(r)),
+			zap.Int64("max_response_body_bytes", limit),
+			zap.Bool("committed", limited.committed))
+
+		// Part of a streamed response may already be on its way; it is truncated instead
+		if !limited.committed {
+			http.Error(w, fmt.Sprintf("response exceeds the %d byte limit", limit), http.StatusInsufficientStorage)
+		}
+	})
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestResponseSizeLimitMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		limit          int64
+		handler        http.HandlerFunc
+		expectedStatus int
+		expectedBody   string
+		expectWarning  bool
+	}{
+		{
+			name:  "response within the limit",
+			limit: 16,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte("small"))
+			},
+			expectedStatus: http.StatusAccepted,
+			expectedBody:   "small",
+		},
+		{
+			name:  "response over the limit",
+			limit: 16,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.Write([]byte(strings.Repeat("x", 10)))
+				w.Write([]byte(strings.Repeat("x", 10)))
+			},
+			expectedStatus: http.StatusInsufficientStorage,
+			expectedBody:   "response exceeds the 16 byte limit\n",
+			expectWarning:  true,
+		},
+		{
+			name:  "streamed response is truncated after it is committed",
+			limit: 16,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte("event one\n"))
+				w.(http.Flusher).Flush()
+				w.Write([]byte("event two\n"))
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "event one\n",
+			expectWarning:  true,
+		},
+		{
+			name:  "zero disables the limit",
+			limit: 0,
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Write([]byte(strings.Repeat("x", 64)))
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   strings.Repeat("x", 64),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			core, logs := observer.New(zapcore.WarnLevel)
+			handler := responseSizeLimitMiddleware(tt.handler, tt.limit, zap.New(core))
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedBody, rec.Body.String())
+			if tt.expectWarning {
+				assert.Equal(t, 1, logs.FilterMessage("Response exceeded size limit").Len())
+			} else {
+				assert.Zero(t, logs.Len())
+			}
+		})
+	}
+}
+
+func TestResponseSizeLimitMiddleware_FlushThroughMetricsWrapper(t *testing.T) {
+	rec := httptest.NewRecorder()
+
+	handler := responseSizeLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("event one\n"))
+		w.(http.Flusher).Flush()
+
+		// The flushed event has reached the client before the handler returns
+		assert.True(t, rec.Flushed)
+		assert.Equal(t, "event one\n", rec.Body.String())
+
+		w.Write([]byte("event two\n"))
+	}), 1024, zap.NewNop())
+
+	wrapped := &responseWriterWrapper{ResponseWriter: rec, statusCode: http.StatusOK}
+	handler.ServeHTTP(wrapped, httptest.NewRequest(http.MethodPost, "/mcp", nil))
+
+	assert.Equal(t, "event one\nevent two\n", rec.Body.String())
+	assert.Equal(t, int64(20), wrapped.bytesWritten)
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+// requestTimeoutHeader lets clients with a short (or long) deadline override the default timeout
+const requestTimeoutHeader = "X-Request-Timeout-Ms"
+
+// parseRequestTimeout returns the deadline requested with the X-Request-Timeout-Ms header, capped
+// at the configured maximum, or the default timeout when the header is missing. A zero duration
+// means the request has no deadline.
+func parseRequestTimeout(r *http.Request, timeouts config.ToolTimeoutsConfig) (time.Duration, error) {
+	value := r.Header.Get(requestTimeoutHeader)
+	if value == "" {
+		return time.Duration(timeouts.DefaultMs) * time.Millisecond, nil
+	}
+
+	ms, err := strconv.Atoi(value)
+	if err != nil || ms <= 0 {
+		return 0, fmt.Errorf("invalid %s header %q: must be a positive number of milliseconds", requestTimeoutHeader, value)
+	}
+
+	if timeouts.MaxAllowedMs > 0 && ms > timeouts.MaxAllowedMs {
+		ms = timeouts.MaxAllowedMs
+	}
+	return time.Duration(ms) * time.Millisecond, nil
+}
+
+// requestTimeoutMiddleware bounds the request context with the deadline from parseRequestTimeout,
+// so tool calls give up with a context error instead of hanging past the client's deadline.
+// Requests with an invalid header are rejected with 400 Bad Request.
+func requestTimeoutMiddleware(next http.Handler, timeouts config.ToolTimeoutsConfig, logger *zap.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout, err := parseRequestTimeout(r, timeouts)
+		if err != nil {
+			logger.Debug("Rejected request timeout header",
+				zap.String("path", r.URL.Path),
+				zap.String("client_ip", clientIP(r)),
+				zap.Error(err))
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if timeout == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
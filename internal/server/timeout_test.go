@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+)
+
+func TestParseRequestTimeout(t *testing.T) {
+	timeouts := config.ToolTimeoutsConfig{DefaultMs: 30000, MaxAllowedMs: 60000}
+
+	tests := []struct {
+		name     string
+		header   string
+		timeouts config.ToolTimeoutsConfig
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"missing header uses the default", "", timeouts, 30 * time.Second, false},
+		{"short deadline", "500", timeouts, 500 * time.Millisecond, false},
+		{"capped at the maximum", "120000", timeouts, time.Minute, false},
+		{"uncapped without a maximum", "120000", config.ToolTimeoutsConfig{}, 2 * time.Minute, false},
+		{"no default deadline", "", config.ToolTimeoutsConfig{}, 0, false},
+		{"not a number", "soon", timeouts, 0, true},
+		{"zero", "0", timeouts, 0, true},
+		{"negative", "-5", timeouts, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+			if tt.header != "" {
+				req.Header.Set(requestTimeoutHeader, tt.header)
+			}
+
+			timeout, err := parseRequestTimeout(req, tt.timeouts)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, timeout)
+		})
+	}
+}
+
+func TestRequestTimeoutMiddleware(t *testing.T) {
+	var deadline time.Time
+	var hasDeadline bool
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		deadline, hasDeadline = r.Context().Deadline()
+	})
+	handler := requestTimeoutMiddleware(next, config.ToolTimeoutsConfig{DefaultMs: 30000, MaxAllowedMs: 60000}, zaptest.NewLogger(t))
+
+	req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	req.Header.Set(requestTimeoutHeader, "500")
+	start := time.Now()
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	require.True(t, hasDeadline)
+	assert.WithinDuration(t, start.Add(500*time.Millisecond), deadline, 100*time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/mcp", nil)
+	req.Header.Set(requestTimeoutHeader, "later")
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "invalid X-Request-Timeout-Ms header")
+
+	// Without a default, requests without the header get no deadline
+	hasDeadline = false
+	handler = requestTimeoutMiddleware(next, config.ToolTimeoutsConfig{}, zaptest.NewLogger(t))
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/mcp", nil))
+	assert.False(t, hasDeadline)
+}
@@ -0,0 +1,142 @@
+// Package testing provides fixtures for exercising the MCP time server in test suites. Import it
+// as mcptesting to avoid clashing with the standard library package.
+package testing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/config"
+	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	"github.com/topfreegames/mcp-server-time/internal/resources"
+	"github.com/topfreegames/mcp-server-time/internal/server"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+	"github.com/topfreegames/mcp-server-time/internal/tools"
+)
+
+// DefaultTime is the moment the test server reports as now unless WithTime is given
+var DefaultTime = time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC)
+
+// defaultCallTimeout bounds each CallTool round trip
+const defaultCallTimeout = 10 * time.Second
+
+// Option configures a TestServer
+type Option func(*options)
+
+// options holds the settings applied by Option values
+type options struct {
+	now             time.Time
+	defaultTimezone string
+	logger          *zap.Logger
+}
+
+// WithTime freezes the server clock at now instead of DefaultTime
+func WithTime(now time.Time) Option {
+	return func(o *options) {
+		o.now = now
+	}
+}
+
+// WithDefaultTimezone sets the timezone used when a tool input does not name one
+func WithDefaultTimezone(timezone string) Option {
+	return func(o *options) {
+		o.defaultTimezone = timezone
+	}
+}
+
+// WithLogger sets the logger used by the server, such as one from zaptest.NewLogger
+func WithLogger(logger *zap.Logger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// TestServer runs the MCP time server on a random local port with a fixed clock, so tool results
+// are deterministic, and keeps a connected MCP client for calling its tools
+type TestServer struct {
+	httpServer *httptest.Server
+	session    *mcp.ClientSession
+}
+
+// NewTestServer starts a server with every time tool and resource registered. Call Close when done.
+func NewTestServer(opts ...Option) (*TestServer, error) {
+	o := options{now: DefaultTime, defaultTimezone: "UTC", logger: zap.NewNop()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	cfg := &config.Config{
+		Server: config.ServerConfig{Name: "mcp-server-time", Version: "test", Host: "127.0.0.1", HTTP2: true},
+	}
+
+	timeService := timeservice.NewTimeService(o.defaultTimezone, "RFC3339",
+		[]string{"RFC3339", "RFC3339Nano", "Unix", "UnixMilli", "UnixNano"}, o.logger,
+		timeservice.WithClock(timeservice.FixedClock{Time: o.now}))
+
+	// A private registry lets several test servers run side by side
+	collector := metrics.New(metrics.WithRegistry(prometheus.NewRegistry()))
+
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: cfg.Server.Name, Version: cfg.Server.Version}, nil)
+	tools.RegisterTimeTools(mcpServer, timeService, collector, o.logger)
+	resources.RegisterTimezones(mcpServer, timeService, o.logger)
+
+	httpServer := httptest.NewServer(server.NewHTTPServer(server.WithConfig(cfg), server.WithMCPServer(mcpServer),
+		server.WithMetrics(collector), server.WithLogger(o.logger)).Server.Handler)
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "mcptesting", Version: "test"}, nil)
+	session, err := client.Connect(context.Background(), &mcp.StreamableClientTransport{Endpoint: httpServer.URL + "/mcp"}, nil)
+	if err != nil {
+		httpServer.Close()
+		return nil, fmt.Errorf("failed to connect to test server: %w", err)
+	}
+
+	return &TestServer{httpServer: httpServer, session: session}, nil
+}
+
+// URL returns the base URL of the server, such as http://127.0.0.1:54321. MCP clients connect to
+// URL()+"/mcp" (streamable HTTP) or URL()+"/sse".
+func (s *TestServer) URL() string {
+	return s.httpServer.URL
+}
+
+// Close disconnects the client and stops the server
+func (s *TestServer) Close() {
+	s.session.Close()
+	s.httpServer.Close()
+}
+
+// CallTool calls the named tool with input, which must encode to a JSON object, and returns its
+// structured result decoded as generic JSON (usually a map[string]any). A tool reporting an
+// error is returned as an error carrying its message.
+func (s *TestServer) CallTool(name string, input interface{}) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), defaultCallTimeout)
+	defer cancel()
+
+	res, err := s.session.CallTool(ctx, &mcp.CallToolParams{Name: name, Arguments: input})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", name, err)
+	}
+
+	if res.IsError {
+		var messages []string
+		for _, content := range res.Content {
+			if text, ok := content.(*mcp.TextContent); ok {
+				messages = append(messages, text.Text)
+			}
+		}
+		if len(messages) == 0 {
+			return nil, fmt.Errorf("%s failed", name)
+		}
+		return nil, errors.New(strings.Join(messages, "\n"))
+	}
+
+	return res.StructuredContent, nil
+}
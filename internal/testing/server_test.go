@@ -0,0 +1,74 @@
+package testing_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	mcptesting "github.com/topfreegames/mcp-server-time/internal/testing"
+)
+
+func TestTestServer(t *testing.T) {
+	tests := []struct {
+		name          string
+		opts          []mcptesting.Option
+		input         map[string]any
+		expectedTime  string
+		expectedZone  string
+		expectedError bool
+	}{
+		{
+			name:         "default fixed time",
+			input:        map[string]any{},
+			expectedTime: "2024-01-15T12:00:00Z",
+			expectedZone: "UTC",
+		},
+		{
+			name:         "custom time and default timezone",
+			opts:         []mcptesting.Option{mcptesting.WithTime(time.Date(2024, 7, 1, 9, 30, 0, 0, time.UTC)), mcptesting.WithDefaultTimezone("Europe/Berlin")},
+			input:        map[string]any{},
+			expectedTime: "2024-07-01T11:30:00+02:00",
+			expectedZone: "Europe/Berlin",
+		},
+		{
+			name:          "tool error",
+			input:         map[string]any{"timezone": "Mars/Olympus"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, err := mcptesting.NewTestServer(append(tt.opts, mcptesting.WithLogger(zaptest.NewLogger(t)))...)
+			require.NoError(t, err)
+			defer server.Close()
+
+			result, err := server.CallTool("get_time", tt.input)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			fields, ok := result.(map[string]any)
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedTime, fields["formatted_time"])
+			assert.Equal(t, tt.expectedZone, fields["timezone"])
+		})
+	}
+}
+
+func TestTestServer_URL(t *testing.T) {
+	server, err := mcptesting.NewTestServer()
+	require.NoError(t, err)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL() + "/health")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
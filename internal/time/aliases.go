@@ -0,0 +1,73 @@
+package time
+
+import (
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+// TimezoneAliases maps common timezone abbreviations to the IANA zone they usually refer to, so
+// tools accept "EST" as America/New_York. Ambiguous abbreviations map to their most common use:
+// IST is India, CST is US Central and BST is British Summer Time. Matching ignores case.
+var TimezoneAliases = map[string]string{
+	// North America
+	"EST": "America/New_York", "EDT": "America/New_York",
+	"CST": "America/Chicago", "CDT": "America/Chicago",
+	"MST": "America/Denver", "MDT": "America/Denver",
+	"PST": "America/Los_Angeles", "PDT": "America/Los_Angeles",
+	"AKST": "America/Anchorage", "AKDT": "America/Anchorage",
+	"HST": "Pacific/Honolulu",
+	"AST": "America/Halifax", "ADT": "America/Halifax",
+	"NST": "America/St_Johns", "NDT": "America/St_Johns",
+
+	// South America
+	"BRT": "America/Sao_Paulo",
+	"ART": "America/Argentina/Buenos_Aires",
+
+	// Europe
+	"BST": "Europe/London",
+	"WET": "Europe/Lisbon", "WEST": "Europe/Lisbon",
+	"CET": "Europe/Paris", "CEST": "Europe/Paris",
+	"EET": "Europe/Athens", "EEST": "Europe/Athens",
+	"MSK": "Europe/Moscow",
+
+	// Africa
+	"WAT":  "Africa/Lagos",
+	"CAT":  "Africa/Maputo",
+	"EAT":  "Africa/Nairobi",
+	"SAST": "Africa/Johannesburg",
+
+	// Asia
+	"GST": "Asia/Dubai",
+	"PKT": "Asia/Karachi",
+	"IST": "Asia/Kolkata",
+	"ICT": "Asia/Bangkok",
+	"WIB": "Asia/Jakarta",
+	"SGT": "Asia/Singapore",
+	"HKT": "Asia/Hong_Kong",
+	"PHT": "Asia/Manila",
+	"KST": "Asia/Seoul",
+	"JST": "Asia/Tokyo",
+
+	// Oceania
+	"AWST": "Australia/Perth",
+	"ACST": "Australia/Adelaide", "ACDT": "Australia/Adelaide",
+	"AEST": "Australia/Sydney", "AEDT": "Australia/Sydney",
+	"NZST": "Pacific/Auckland", "NZDT": "Pacific/Auckland",
+}
+
+// expandTimezoneAlias returns the IANA zone a known abbreviation stands for, or timezone unchanged.
+// The zone database also has fixed-offset zones named EST, MST and HST; the alias takes precedence
+// so that "EST" follows New York's daylight saving time like users expect.
+func (s *timeService) expandTimezoneAlias(timezone string) string {
+	zone, ok := TimezoneAliases[strings.ToUpper(strings.TrimSpace(timezone))]
+	if !ok {
+		return timezone
+	}
+
+	s.logger.Debug("Expanded timezone alias",
+		zap.String("alias", timezone),
+		zap.String("timezone", zone))
+
+	return zone
+}
@@ -0,0 +1,84 @@
+package time
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimezoneAliases(t *testing.T) {
+	assert.GreaterOrEqual(t, len(TimezoneAliases), 30)
+
+	for alias, zone := range TimezoneAliases {
+		_, err := time.LoadLocation(zone)
+		assert.NoError(t, err, "alias %s", alias)
+		assert.Equal(t, strings.ToUpper(alias), alias)
+	}
+}
+
+func TestTimezoneAliases_DocumentedInSchema(t *testing.T) {
+	aliases := make([]string, 0, len(TimezoneAliases))
+	for alias := range TimezoneAliases {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	documented := regexp.MustCompile(`Also accepts the abbreviations ([A-Z, ]+),`)
+	for _, input := range []reflect.Type{
+		reflect.TypeFor[GetTimeInput](),
+		reflect.TypeFor[FormatTimeInput](),
+		reflect.TypeFor[ParseTimeInput](),
+		reflect.TypeFor[TimezoneInfoInput](),
+		reflect.TypeFor[TimezoneOffsetAtInput](),
+	} {
+		field, ok := input.FieldByName("Timezone")
+		require.True(t, ok, input.Name())
+
+		match := documented.FindStringSubmatch(field.Tag.Get("jsonschema"))
+		require.NotNil(t, match, input.Name())
+		assert.True(t, slices.Equal(aliases, strings.Split(match[1], ", ")), "%s documents %s", input.Name(), match[1])
+	}
+}
+
+func TestTimeService_TimezoneAliasExpansion(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)}))
+	ctx := context.Background()
+
+	result, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "EST"})
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", result.Timezone)
+	// EST follows New York's daylight saving time rather than the fixed-offset EST zone
+	assert.Equal(t, "2024-07-15T08:00:00-04:00", result.FormattedTime)
+
+	// Likewise MST follows Denver instead of the fixed-offset MST zone
+	result, err = service.GetCurrentTime(ctx, GetTimeInput{Timezone: "MST"})
+	require.NoError(t, err)
+	assert.Equal(t, "America/Denver", result.Timezone)
+	assert.Equal(t, "2024-07-15T06:00:00-06:00", result.FormattedTime)
+
+	info, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "jst"})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Tokyo", info.Name)
+	assert.Equal(t, "+09:00", info.Offset)
+
+	formatted, err := service.FormatTime(ctx, FormatTimeInput{Timestamp: int64(1721044800), Format: "RFC3339", Timezone: " IST "})
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Kolkata", formatted.Timezone)
+
+	converted, err := service.ConvertTimezone(ctx, time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC), "", "CEST")
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Paris", converted.Location().String())
+
+	_, err = service.GetCurrentTime(ctx, GetTimeInput{Timezone: "XYZT"})
+	assert.Error(t, err)
+}
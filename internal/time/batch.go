@@ -0,0 +1,122 @@
+package time
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// maxBatchQueries is the maximum number of queries accepted in a single batch
+	maxBatchQueries = 20
+
+	// maxBatchFormatItems is the maximum number of timestamps accepted in a single format batch
+	maxBatchFormatItems = 100
+
+	// batchConcurrency limits how many batch queries are processed at the same time
+	batchConcurrency = 5
+)
+
+// BatchGetCurrentTime returns the current time for several queries at once.
+// Failed queries are reported per entry instead of failing the whole batch.
+func (s *timeService) BatchGetCurrentTime(ctx context.Context, input BatchGetTimeInput) (BatchGetTimeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return BatchGetTimeResult{}, err
+	}
+
+	if len(input.Queries) == 0 {
+		return BatchGetTimeResult{}, validationErrorf(CodeMissingArgument, "queries cannot be empty")
+	}
+	if len(input.Queries) > maxBatchQueries {
+		return BatchGetTimeResult{}, validationErrorf(CodeLimitExceeded, "too many queries: %d (maximum: %d)", len(input.Queries), maxBatchQueries)
+	}
+
+	s.logger.Debug("Processing batch time queries",
+		zap.Int("query_count", len(input.Queries)))
+
+	entries := make([]BatchGetTimeEntry, len(input.Queries))
+
+	var g errgroup.Group
+	g.SetLimit(batchConcurrency)
+
+	for i, query := range input.Queries {
+		g.Go(func() error {
+			entry := BatchGetTimeEntry{Timezone: query.Timezone}
+
+			result, err := s.GetCurrentTime(ctx, query)
+			if err != nil {
+				entry.Error = err.Error()
+			} else {
+				entry.Result = &result
+			}
+
+			entries[i] = entry
+			return nil
+		})
+	}
+
+	// Per-query errors are captured in the entries, so the group never fails
+	_ = g.Wait()
+
+	// A cancelled batch fails as a whole rather than reporting the cancellation per query
+	if err := ctx.Err(); err != nil {
+		return BatchGetTimeResult{}, err
+	}
+
+	return BatchGetTimeResult{Results: entries}, nil
+}
+
+// BatchFormatTime formats several timestamps at once.
+// Failed items are reported per entry instead of failing the whole batch.
+func (s *timeService) BatchFormatTime(ctx context.Context, input BatchFormatTimeInput) (BatchFormatTimeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return BatchFormatTimeResult{}, err
+	}
+
+	if len(input.Items) == 0 {
+		return BatchFormatTimeResult{}, validationErrorf(CodeMissingArgument, "items cannot be empty")
+	}
+	if len(input.Items) > maxBatchFormatItems {
+		return BatchFormatTimeResult{}, validationErrorf(CodeLimitExceeded, "too many items: %d (maximum: %d)", len(input.Items), maxBatchFormatItems)
+	}
+
+	s.logger.Debug("Processing batch format items",
+		zap.Int("item_count", len(input.Items)))
+
+	entries := make([]BatchFormatTimeEntry, len(input.Items))
+
+	var g errgroup.Group
+	g.SetLimit(batchConcurrency)
+
+	for i, item := range input.Items {
+		g.Go(func() error {
+			entry := BatchFormatTimeEntry{ID: item.ID}
+
+			result, err := s.FormatTime(ctx, FormatTimeInput{
+				Timestamp: item.Timestamp,
+				Format:    item.Format,
+				Timezone:  item.Timezone,
+			})
+			if err != nil {
+				entry.Error = err.Error()
+			} else {
+				entry.FormattedTime = result.FormattedTime
+				entry.Warning = result.Warning
+			}
+
+			entries[i] = entry
+			return nil
+		})
+	}
+
+	// Per-item errors are captured in the entries, so the group never fails
+	_ = g.Wait()
+
+	// A cancelled batch fails as a whole rather than reporting the cancellation per item
+	if err := ctx.Err(); err != nil {
+		return BatchFormatTimeResult{}, err
+	}
+
+	return BatchFormatTimeResult{Results: entries}, nil
+}
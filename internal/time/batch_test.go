@@ -0,0 +1,100 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_BatchGetCurrentTime(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, logger)
+
+	t.Run("partial results", func(t *testing.T) {
+		result, err := service.BatchGetCurrentTime(context.Background(), BatchGetTimeInput{
+			Queries: []GetTimeInput{
+				{Timezone: "America/New_York"},
+				{Timezone: "Invalid/Timezone"},
+				{Timezone: "Asia/Tokyo", Format: "Unix"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 3)
+
+		assert.Equal(t, "America/New_York", result.Results[0].Timezone)
+		require.NotNil(t, result.Results[0].Result)
+		assert.Equal(t, "America/New_York", result.Results[0].Result.Timezone)
+		assert.Empty(t, result.Results[0].Error)
+
+		assert.Equal(t, "Invalid/Timezone", result.Results[1].Timezone)
+		assert.Nil(t, result.Results[1].Result)
+		assert.Contains(t, result.Results[1].Error, "invalid timezone")
+
+		require.NotNil(t, result.Results[2].Result)
+		assert.Equal(t, "Unix", result.Results[2].Result.Format)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		_, err := service.BatchGetCurrentTime(context.Background(), BatchGetTimeInput{})
+		assert.Error(t, err)
+	})
+
+	t.Run("too many queries", func(t *testing.T) {
+		queries := make([]GetTimeInput, maxBatchQueries+1)
+		for i := range queries {
+			queries[i] = GetTimeInput{Timezone: fmt.Sprintf("Etc/GMT+%d", i%12)}
+		}
+
+		_, err := service.BatchGetCurrentTime(context.Background(), BatchGetTimeInput{Queries: queries})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "too many queries")
+	})
+}
+
+func TestTimeService_BatchFormatTime(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, logger)
+
+	t.Run("partial results", func(t *testing.T) {
+		result, err := service.BatchFormatTime(context.Background(), BatchFormatTimeInput{
+			Items: []FormatTimeItem{
+				{ID: "created_at", Timestamp: int64(1710513000), Timezone: "Asia/Tokyo"},
+				{ID: "updated_at", Timestamp: "not a time"},
+				{ID: "deleted_at", Timestamp: "2024-03-15T14:30:00Z", Format: "Unix"},
+				{ID: "bad_zone", Timestamp: "2024-03-15T14:30:00Z", Timezone: "Invalid/Timezone"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Results, 4)
+
+		assert.Equal(t, BatchFormatTimeEntry{ID: "created_at", FormattedTime: "2024-03-15T23:30:00+09:00"}, result.Results[0])
+
+		assert.Equal(t, "updated_at", result.Results[1].ID)
+		assert.Empty(t, result.Results[1].FormattedTime)
+		assert.NotEmpty(t, result.Results[1].Error)
+
+		assert.Equal(t, BatchFormatTimeEntry{ID: "deleted_at", FormattedTime: "1710513000"}, result.Results[2])
+
+		assert.Contains(t, result.Results[3].Error, "invalid timezone")
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		_, err := service.BatchFormatTime(context.Background(), BatchFormatTimeInput{})
+		assert.Error(t, err)
+	})
+
+	t.Run("too many items", func(t *testing.T) {
+		items := make([]FormatTimeItem, maxBatchFormatItems+1)
+		for i := range items {
+			items[i] = FormatTimeItem{Timestamp: int64(1700000000 + i)}
+		}
+
+		_, err := service.BatchFormatTime(context.Background(), BatchFormatTimeInput{Items: items})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "too many items")
+	})
+}
@@ -0,0 +1,120 @@
+package time
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/time/holidays"
+)
+
+// maxBusinessDays bounds how many business days one call may add, about 40 years
+const maxBusinessDays = 10000
+
+// AddBusinessDays moves a date forward or backward by a number of business days, skipping weekends
+// and, for a country, its public holidays. Days are counted from the day after the start date, so
+// one business day after a Friday or a Saturday is the following Monday.
+func (s *timeService) AddBusinessDays(ctx context.Context, input DateAddBusinessDaysInput) (DateAddBusinessDaysResult, error) {
+	if err := ctx.Err(); err != nil {
+		return DateAddBusinessDaysResult{}, err
+	}
+
+	if input.BusinessDays < -maxBusinessDays || input.BusinessDays > maxBusinessDays {
+		return DateAddBusinessDaysResult{}, validationErrorf(CodeLimitExceeded, "business_days must be between -%d and %d, got %d", maxBusinessDays, maxBusinessDays, input.BusinessDays)
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return DateAddBusinessDaysResult{}, err
+	}
+
+	start := s.clock.Now().In(loc)
+	if strings.TrimSpace(input.StartDate) != "" {
+		start, err = parseFlexibleTime(input.StartDate, loc)
+		if err != nil {
+			return DateAddBusinessDaysResult{}, parseErrorf(CodeInvalidTimestamp, "invalid start_date: %w", err)
+		}
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+
+	if input.Country != "" {
+		if _, _, err := holidays.IsHoliday(input.Country, start); err != nil && !errors.Is(err, holidays.ErrYearNotCovered) {
+			return DateAddBusinessDaysResult{}, validationErrorf(CodeInvalidArgument, "%w", err)
+		}
+	}
+
+	step := 1
+	if input.BusinessDays < 0 {
+		step = -1
+	}
+
+	result := DateAddBusinessDaysResult{SkippedHolidays: []string{}}
+	uncoveredYears := map[int]bool{}
+	date := start
+	for remaining := input.BusinessDays * step; remaining > 0; {
+		date = date.AddDate(0, 0, step)
+
+		if weekday := date.Weekday(); weekday == time.Saturday || weekday == time.Sunday {
+			result.SkippedWeekends++
+			continue
+		}
+
+		if input.Country != "" {
+			// The country was validated above, so the only possible error is an uncovered year
+			isHoliday, name, err := holidays.IsHoliday(input.Country, date)
+			switch {
+			case err != nil:
+				uncoveredYears[date.Year()] = true
+			case isHoliday:
+				result.SkippedHolidays = append(result.SkippedHolidays, date.Format("2006-01-02")+" "+name)
+				continue
+			}
+		}
+
+		remaining--
+	}
+
+	result.StartDate = start.Format("2006-01-02")
+	result.ResultDate = date.Format("2006-01-02")
+	result.ResultWeekday = date.Weekday().String()
+	result.CalendarDaysElapsed = daysBetweenDates(start, date)
+	result.Timezone = loc.String()
+	result.Warning = joinWarnings(warning, uncoveredHolidayWarning(uncoveredYears))
+
+	s.logger.Debug("Added business days",
+		zap.String("start_date", result.StartDate),
+		zap.Int("business_days", input.BusinessDays),
+		zap.String("country", input.Country),
+		zap.String("result_date", result.ResultDate))
+
+	return result, nil
+}
+
+// uncoveredHolidayWarning names the years whose holidays could not be checked, if any
+func uncoveredHolidayWarning(years map[int]bool) string {
+	if len(years) == 0 {
+		return ""
+	}
+
+	sorted := make([]int, 0, len(years))
+	for year := range years {
+		sorted = append(sorted, year)
+	}
+	sort.Ints(sorted)
+
+	names := make([]string, len(sorted))
+	for i, year := range sorted {
+		names[i] = fmt.Sprint(year)
+	}
+	first, last := holidays.Default().Years()
+	return fmt.Sprintf("holidays not checked for %s: holiday data covers %d-%d", strings.Join(names, ", "), first, last)
+}
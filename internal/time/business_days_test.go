@@ -0,0 +1,105 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_AddBusinessDays(t *testing.T) {
+	// 2024-03-15 is a Friday
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name             string
+		input            DateAddBusinessDaysInput
+		expectedDate     string
+		expectedWeekends int
+		expectedHolidays []string
+		expectedElapsed  int
+		expectWarning    bool
+		expectError      bool
+	}{
+		{
+			name:             "across a weekend from today",
+			input:            DateAddBusinessDaysInput{BusinessDays: 1},
+			expectedDate:     "2024-03-18",
+			expectedWeekends: 2,
+			expectedHolidays: []string{},
+			expectedElapsed:  3,
+		},
+		{
+			name:             "from a Saturday",
+			input:            DateAddBusinessDaysInput{StartDate: "2024-03-16", BusinessDays: 1},
+			expectedDate:     "2024-03-18",
+			expectedWeekends: 1,
+			expectedHolidays: []string{},
+			expectedElapsed:  2,
+		},
+		{
+			name:             "backward across a month boundary",
+			input:            DateAddBusinessDaysInput{StartDate: "2024-04-02", BusinessDays: -3},
+			expectedDate:     "2024-03-28",
+			expectedWeekends: 2,
+			expectedHolidays: []string{},
+			expectedElapsed:  -5,
+		},
+		{
+			name:             "holidays across a year boundary",
+			input:            DateAddBusinessDaysInput{StartDate: "2024-12-23", BusinessDays: 5, Country: "UK"},
+			expectedDate:     "2025-01-02",
+			expectedWeekends: 2,
+			expectedHolidays: []string{"2024-12-25 Christmas Day", "2024-12-26 Boxing Day", "2025-01-01 New Year's Day"},
+			expectedElapsed:  10,
+		},
+		{
+			name:             "holidays example from list_tools",
+			input:            DateAddBusinessDaysInput{StartDate: "2024-12-23", BusinessDays: 3, Country: "UK"},
+			expectedDate:     "2024-12-30",
+			expectedWeekends: 2,
+			expectedHolidays: []string{"2024-12-25 Christmas Day", "2024-12-26 Boxing Day"},
+			expectedElapsed:  7,
+		},
+		{
+			name:             "zero business days",
+			input:            DateAddBusinessDaysInput{StartDate: "2024-03-16", BusinessDays: 0},
+			expectedDate:     "2024-03-16",
+			expectedHolidays: []string{},
+		},
+		{
+			name:             "year outside the holiday data",
+			input:            DateAddBusinessDaysInput{StartDate: "2035-06-01", BusinessDays: 1, Country: "US"},
+			expectedDate:     "2035-06-04",
+			expectedWeekends: 2,
+			expectedHolidays: []string{},
+			expectedElapsed:  3,
+			expectWarning:    true,
+		},
+		{name: "unknown country", input: DateAddBusinessDaysInput{BusinessDays: 0, Country: "XX"}, expectError: true},
+		{name: "too many business days", input: DateAddBusinessDaysInput{BusinessDays: 10001}, expectError: true},
+		{name: "invalid start date", input: DateAddBusinessDaysInput{StartDate: "someday", BusinessDays: 1}, expectError: true},
+		{name: "invalid timezone", input: DateAddBusinessDaysInput{Timezone: "Mars/Olympus", BusinessDays: 1}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.AddBusinessDays(context.Background(), tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDate, result.ResultDate)
+			assert.Equal(t, tt.expectedWeekends, result.SkippedWeekends)
+			assert.Equal(t, tt.expectedHolidays, result.SkippedHolidays)
+			assert.Equal(t, tt.expectedElapsed, result.CalendarDaysElapsed)
+			assert.Equal(t, tt.expectWarning, result.Warning != "")
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package time
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// candidateLayout is a Go layout tried when ranking parse candidates, with its base confidence.
+// Layouts carrying an explicit offset are the most specific; zone-less and date-only layouts
+// leave more to interpretation.
+type candidateLayout struct {
+	name       string
+	layout     string
+	confidence float64
+}
+
+var candidateLayouts = []candidateLayout{
+	{name: string(FormatRFC3339Nano), layout: time.RFC3339Nano, confidence: 1.0},
+	{name: string(FormatRFC3339), layout: time.RFC3339, confidence: 0.95},
+	{name: "RFC1123Z", layout: time.RFC1123Z, confidence: 0.9},
+	{name: "RFC1123", layout: time.RFC1123, confidence: 0.8},
+	{name: "2006-01-02T15:04:05", layout: "2006-01-02T15:04:05", confidence: 0.75},
+	{name: "2006-01-02 15:04:05", layout: "2006-01-02 15:04:05", confidence: 0.75},
+	{name: "2006-01-02T15:04", layout: "2006-01-02T15:04", confidence: 0.65},
+	{name: "2006-01-02 15:04", layout: "2006-01-02 15:04", confidence: 0.65},
+	{name: "2006-01-02", layout: "2006-01-02", confidence: 0.5},
+}
+
+// unixCandidate is an epoch-based format and the digit count of present-day values in it
+type unixCandidate struct {
+	format FormatType
+	digits int
+	toTime func(int64) time.Time
+}
+
+var unixCandidates = []unixCandidate{
+	{format: FormatUnix, digits: 10, toTime: func(v int64) time.Time { return time.Unix(v, 0) }},
+	{format: FormatUnixMilli, digits: 13, toTime: time.UnixMilli},
+	{format: FormatUnixMicro, digits: 16, toTime: time.UnixMicro},
+	{format: FormatUnixNano, digits: 19, toTime: func(v int64) time.Time { return time.Unix(0, v) }},
+}
+
+// parsedCandidate pairs a candidate with the time it parsed to
+type parsedCandidate struct {
+	ParseCandidate
+	time time.Time
+}
+
+// rankParseCandidates tries every known format against timeStr and returns the matches sorted by
+// descending confidence. When loc is set, zone-less results are interpreted in it.
+func rankParseCandidates(timeStr string, loc *time.Location) []parsedCandidate {
+	value := strings.TrimSpace(timeStr)
+	var candidates []parsedCandidate
+
+	add := func(format string, t time.Time, confidence float64) {
+		if loc != nil {
+			t = applyParseLocation(t, loc)
+		}
+		candidates = append(candidates, parsedCandidate{
+			ParseCandidate: ParseCandidate{
+				Format:     format,
+				ParsedAs:   t.Format(time.RFC3339Nano),
+				Confidence: confidence,
+			},
+			time: t,
+		})
+	}
+
+	for _, candidate := range candidateLayouts {
+		t, err := time.Parse(candidate.layout, value)
+		if err != nil {
+			continue
+		}
+
+		confidence := candidate.confidence
+		// RFC3339Nano also accepts values without fractional seconds, where RFC3339 is the better fit
+		if candidate.layout == time.RFC3339Nano && !strings.Contains(value, ".") {
+			confidence = 0.9
+		}
+		add(candidate.name, t, confidence)
+	}
+
+	if epoch, err := strconv.ParseInt(value, 10, 64); err == nil {
+		digits := len(strings.TrimPrefix(value, "-"))
+		for _, candidate := range unixCandidates {
+			add(string(candidate.format), candidate.toTime(epoch), unixConfidence(digits, candidate.digits))
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Confidence > candidates[j].Confidence
+	})
+
+	return candidates
+}
+
+// unixConfidence rates an epoch interpretation by how close the value's digit count is to that of
+// present-day timestamps in the format
+func unixConfidence(digits, expected int) float64 {
+	switch distance := digits - expected; {
+	case distance == 0:
+		return 0.9
+	case distance == -1 || distance == 1:
+		return 0.6
+	case distance < 0 && distance >= -3:
+		return 0.3
+	default:
+		return 0.1
+	}
+}
+
+// applyParseLocation interprets a zone-less parsed time in loc, or converts a zoned one to loc
+func applyParseLocation(t time.Time, loc *time.Location) time.Time {
+	if t.Location() == time.UTC {
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+	}
+	return t.In(loc)
+}
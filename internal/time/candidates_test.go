@@ -0,0 +1,98 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_ParseTime_Candidates(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, logger)
+
+	tests := []struct {
+		name             string
+		input            ParseTimeInput
+		wantErr          bool
+		expectedUnix     int64
+		expectedFormats  []string
+		expectedTopScore float64
+	}{
+		{
+			name:             "seconds epoch ranks Unix first",
+			input:            ParseTimeInput{TimeString: "1709296200", ReturnAllCandidates: true},
+			expectedUnix:     1709296200,
+			expectedFormats:  []string{"Unix", "UnixMilli", "UnixMicro", "UnixNano"},
+			expectedTopScore: 0.9,
+		},
+		{
+			name:             "milliseconds epoch ranks UnixMilli first",
+			input:            ParseTimeInput{TimeString: "1709296200000", ReturnAllCandidates: true},
+			expectedUnix:     1709296200,
+			expectedFormats:  []string{"UnixMilli", "UnixMicro", "Unix", "UnixNano"},
+			expectedTopScore: 0.9,
+		},
+		{
+			name:             "RFC3339 without fraction prefers RFC3339 over RFC3339Nano",
+			input:            ParseTimeInput{TimeString: "2024-03-01T12:30:00Z", ReturnAllCandidates: true},
+			expectedUnix:     1709296200,
+			expectedFormats:  []string{"RFC3339", "RFC3339Nano"},
+			expectedTopScore: 0.95,
+		},
+		{
+			name:             "zone-less value uses the timezone",
+			input:            ParseTimeInput{TimeString: "2024-03-01 07:30", Timezone: "America/New_York", ReturnAllCandidates: true},
+			expectedUnix:     1709296200,
+			expectedFormats:  []string{"2006-01-02 15:04"},
+			expectedTopScore: 0.65,
+		},
+		{
+			name:             "explicit format stays primary",
+			input:            ParseTimeInput{TimeString: "1709296200000", Format: "Unix", ReturnAllCandidates: true},
+			expectedUnix:     1709296200000,
+			expectedFormats:  []string{"UnixMilli", "UnixMicro", "Unix", "UnixNano"},
+			expectedTopScore: 0.9,
+		},
+		{
+			name:    "no candidate matches",
+			input:   ParseTimeInput{TimeString: "sometime soon", ReturnAllCandidates: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.ParseTime(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedUnix, result.UnixTimestamp)
+
+			formats := make([]string, 0, len(result.Candidates))
+			for _, candidate := range result.Candidates {
+				formats = append(formats, candidate.Format)
+				assert.NotEmpty(t, candidate.ParsedAs)
+				assert.GreaterOrEqual(t, candidate.Confidence, 0.0)
+				assert.LessOrEqual(t, candidate.Confidence, 1.0)
+			}
+			assert.Equal(t, tt.expectedFormats, formats)
+			assert.Equal(t, tt.expectedTopScore, result.Candidates[0].Confidence)
+		})
+	}
+}
+
+func TestTimeService_ParseTime_NoCandidatesByDefault(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	result, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "2024-03-01T12:30:00Z"})
+	require.NoError(t, err)
+	assert.Nil(t, result.Candidates)
+}
@@ -0,0 +1,29 @@
+package time
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// timezoneCitiesJSON maps zone names to the largest cities observing them, most populous first
+//
+//go:embed data/timezone_cities.json
+var timezoneCitiesJSON []byte
+
+var timezoneCities = mustParseTimezoneCities(timezoneCitiesJSON)
+
+// mustParseTimezoneCities decodes the embedded city list, panicking on malformed data
+func mustParseTimezoneCities(data []byte) map[string][]string {
+	var cities map[string][]string
+	if err := json.Unmarshal(data, &cities); err != nil {
+		panic(fmt.Sprintf("invalid embedded timezone_cities.json: %v", err))
+	}
+	return cities
+}
+
+// majorCities returns the major cities observing a zone, or nil when the zone is not listed
+func majorCities(timezone string) []string {
+	return slices.Clone(timezoneCities[timezone])
+}
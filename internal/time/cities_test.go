@@ -0,0 +1,43 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimezoneCities_AreValidZones(t *testing.T) {
+	require.NotEmpty(t, timezoneCities)
+
+	for zone, cities := range timezoneCities {
+		_, err := time.LoadLocation(zone)
+		assert.NoError(t, err, "zone %s", zone)
+		assert.NotEmpty(t, cities, "zone %s has no cities", zone)
+		assert.LessOrEqual(t, len(cities), 5, "zone %s lists too many cities", zone)
+	}
+}
+
+func TestTimeService_GetTimezoneInfo_IncludeCities(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	info, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "America/New_York", IncludeCities: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"New York", "Philadelphia", "Boston", "Atlanta"}, info.MajorCities)
+
+	// The returned list is a copy of the embedded data
+	info.MajorCities[0] = "Gotham"
+	assert.Equal(t, "New York", timezoneCities["America/New_York"][0])
+
+	unlisted, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "Etc/GMT+5", IncludeCities: true})
+	require.NoError(t, err)
+	assert.Nil(t, unlisted.MajorCities)
+
+	without, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "America/New_York"})
+	require.NoError(t, err)
+	assert.Nil(t, without.MajorCities)
+}
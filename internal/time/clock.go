@@ -0,0 +1,26 @@
+package time
+
+import "time"
+
+// Clock provides the current time to the time service
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock reads the system clock
+type RealClock struct{}
+
+// Now returns the current system time
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock always returns the same configured time, for deterministic tests
+type FixedClock struct {
+	Time time.Time
+}
+
+// Now returns the configured time
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
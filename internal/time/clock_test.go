@@ -0,0 +1,46 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestWithClock(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: fixed}))
+
+	t.Run("get_time uses the clock", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "America/New_York"})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-01T07:00:00-05:00", result.FormattedTime)
+		assert.Equal(t, fixed.Unix(), result.UnixTimestamp)
+	})
+
+	t.Run("countdown defaults its reference to the clock", func(t *testing.T) {
+		result, err := service.Countdown(context.Background(), CountdownInput{TargetDate: "2024-03-02T13:00:00Z"})
+		require.NoError(t, err)
+		assert.Equal(t, "1 day and 1 hour", result.NaturalLanguage)
+	})
+
+	t.Run("offset_from_now is relative to the clock", func(t *testing.T) {
+		result, err := service.FormatTime(context.Background(), FormatTimeInput{OffsetFromNow: "-90m"})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-01T10:30:00Z", result.FormattedTime)
+	})
+}
+
+func TestWithClock_NilKeepsRealClock(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(nil))
+
+	before := time.Now().Unix()
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, result.UnixTimestamp, before)
+}
@@ -0,0 +1,61 @@
+package time
+
+import (
+	"sort"
+	"time"
+)
+
+// maxComparableZones caps the number of comparable zones returned by timezone_info
+const maxComparableZones = 5
+
+// capitalZones are zones named after a national capital, preferred when listing comparable zones
+var capitalZones = map[string]bool{
+	"Africa/Accra": true, "Africa/Addis_Ababa": true, "Africa/Algiers": true, "Africa/Cairo": true,
+	"Africa/Dakar": true, "Africa/Harare": true, "Africa/Khartoum": true, "Africa/Kinshasa": true,
+	"Africa/Luanda": true, "Africa/Maputo": true, "Africa/Nairobi": true, "Africa/Tunis": true,
+	"America/Argentina/Buenos_Aires": true, "America/Asuncion": true, "America/Bogota": true,
+	"America/Caracas": true, "America/Guatemala": true, "America/Havana": true, "America/La_Paz": true,
+	"America/Lima": true, "America/Mexico_City": true, "America/Montevideo": true, "America/Panama": true,
+	"America/Santiago": true, "Asia/Amman": true, "Asia/Baghdad": true, "Asia/Baku": true,
+	"Asia/Bangkok": true, "Asia/Beirut": true, "Asia/Damascus": true, "Asia/Dhaka": true,
+	"Asia/Jakarta": true, "Asia/Jerusalem": true, "Asia/Kabul": true, "Asia/Kathmandu": true,
+	"Asia/Kuala_Lumpur": true, "Asia/Manila": true, "Asia/Muscat": true, "Asia/Riyadh": true,
+	"Asia/Seoul": true, "Asia/Singapore": true, "Asia/Taipei": true, "Asia/Tashkent": true,
+	"Asia/Tbilisi": true, "Asia/Tehran": true, "Asia/Tokyo": true, "Asia/Ulaanbaatar": true,
+	"Asia/Yerevan": true, "Atlantic/Reykjavik": true, "Europe/Amsterdam": true, "Europe/Athens": true,
+	"Europe/Berlin": true, "Europe/Brussels": true, "Europe/Budapest": true, "Europe/Dublin": true,
+	"Europe/Helsinki": true, "Europe/Kyiv": true, "Europe/Lisbon": true, "Europe/London": true,
+	"Europe/Madrid": true, "Europe/Moscow": true, "Europe/Oslo": true, "Europe/Paris": true,
+	"Europe/Prague": true, "Europe/Rome": true, "Europe/Stockholm": true, "Europe/Vienna": true,
+	"Europe/Warsaw": true,
+}
+
+// comparableZones returns up to maxComparableZones other zones whose offset at t matches the given
+// zone's offset at t. Capital city zones are listed first, then the rest alphabetically.
+func comparableZones(name string, t time.Time, loc *time.Location) []string {
+	_, offset := t.In(loc).Zone()
+
+	var matches []string
+	for _, zone := range loadZoneLocations() {
+		if zone.name == name {
+			continue
+		}
+
+		if _, zoneOffset := t.In(zone.location).Zone(); zoneOffset == offset {
+			matches = append(matches, zone.name)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if capitalZones[matches[i]] != capitalZones[matches[j]] {
+			return capitalZones[matches[i]]
+		}
+		return matches[i] < matches[j]
+	})
+
+	if len(matches) > maxComparableZones {
+		matches = matches[:maxComparableZones]
+	}
+
+	return matches
+}
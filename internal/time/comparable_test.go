@@ -0,0 +1,77 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetTimezoneInfo_ComparableZones(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name          string
+		timezone      string
+		referenceTime time.Time
+		contains      []string
+		excludes      []string
+	}{
+		{
+			name:          "paris in winter prefers capitals",
+			timezone:      "Europe/Paris",
+			referenceTime: time.Date(2024, time.January, 15, 12, 0, 0, 0, time.UTC),
+			contains:      []string{"Africa/Algiers", "Europe/Amsterdam"},
+			excludes:      []string{"Europe/Paris", "Europe/London"},
+		},
+		{
+			name:          "london in summer matches CET winter zones",
+			timezone:      "Europe/London",
+			referenceTime: time.Date(2024, time.July, 15, 12, 0, 0, 0, time.UTC),
+			contains:      []string{"Africa/Algiers"},
+			excludes:      []string{"Europe/London", "Atlantic/Reykjavik"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{
+				Timezone:               tt.timezone,
+				ReferenceTime:          tt.referenceTime.Format(time.RFC3339),
+				IncludeComparableZones: true,
+			})
+			require.NoError(t, err)
+
+			assert.LessOrEqual(t, len(info.ComparableZones), maxComparableZones)
+			for _, zone := range tt.contains {
+				assert.Contains(t, info.ComparableZones, zone)
+			}
+			for _, zone := range tt.excludes {
+				assert.NotContains(t, info.ComparableZones, zone)
+			}
+
+			_, offset := tt.referenceTime.In(mustLoadLocation(t, tt.timezone)).Zone()
+			for _, zone := range info.ComparableZones {
+				_, zoneOffset := tt.referenceTime.In(mustLoadLocation(t, zone)).Zone()
+				assert.Equal(t, offset, zoneOffset, zone)
+			}
+		})
+	}
+
+	t.Run("not requested", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "Europe/Paris"})
+		require.NoError(t, err)
+		assert.Nil(t, info.ComparableZones)
+	})
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	require.NoError(t, err)
+	return loc
+}
@@ -0,0 +1,57 @@
+package time
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// CompareTimestamps reports whether time_a is before, equal to or after time_b
+func (s *timeService) CompareTimestamps(ctx context.Context, input CompareTimestampsInput) (CompareTimestampsResult, error) {
+	if err := ctx.Err(); err != nil {
+		return CompareTimestampsResult{}, err
+	}
+
+	if input.TimeA == "" || input.TimeB == "" {
+		return CompareTimestampsResult{}, validationErrorf(CodeMissingArgument, "time_a and time_b are required")
+	}
+
+	s.logger.Debug("Comparing timestamps",
+		zap.String("time_a", input.TimeA),
+		zap.String("time_b", input.TimeB))
+
+	a, err := s.parseComparedTime(input.TimeA, input.FormatA)
+	if err != nil {
+		return CompareTimestampsResult{}, parseErrorf(CodeInvalidTimestamp, "invalid time_a: %w", err)
+	}
+
+	b, err := s.parseComparedTime(input.TimeB, input.FormatB)
+	if err != nil {
+		return CompareTimestampsResult{}, parseErrorf(CodeInvalidTimestamp, "invalid time_b: %w", err)
+	}
+
+	comparison := "equal"
+	switch a.Compare(b) {
+	case -1:
+		comparison = "before"
+	case 1:
+		comparison = "after"
+	}
+
+	return CompareTimestampsResult{
+		Comparison:        comparison,
+		DifferenceSeconds: int64(b.Sub(a) / time.Second),
+		TimeAUTC:          a.UTC().Format(time.RFC3339Nano),
+		TimeBUTC:          b.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
+
+// parseComparedTime parses a timestamp with an explicit format, or with the flexible layouts in
+// UTC when no format is given
+func (s *timeService) parseComparedTime(value, format string) (time.Time, error) {
+	if format == "" {
+		return parseFlexibleTime(value, time.UTC)
+	}
+	return s.parseTimeInternal(value, format)
+}
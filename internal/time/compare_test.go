@@ -0,0 +1,83 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_CompareTimestamps(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name               string
+		input              CompareTimestampsInput
+		expectedComparison string
+		expectedDifference int64
+	}{
+		{
+			name:               "before across offsets",
+			input:              CompareTimestampsInput{TimeA: "2024-03-15T10:00:00-04:00", TimeB: "1710515700", FormatB: "Unix"},
+			expectedComparison: "before",
+			expectedDifference: 4500,
+		},
+		{
+			name:               "equal instants in different zones",
+			input:              CompareTimestampsInput{TimeA: "2024-03-15T14:00:00Z", TimeB: "2024-03-15T23:00:00+09:00"},
+			expectedComparison: "equal",
+		},
+		{
+			name:               "after",
+			input:              CompareTimestampsInput{TimeA: "2024-03-16", TimeB: "2024-03-15 23:00"},
+			expectedComparison: "after",
+			expectedDifference: -3600,
+		},
+		{
+			name:               "explicit layout",
+			input:              CompareTimestampsInput{TimeA: "15/03/2024", FormatA: "02/01/2006", TimeB: "2024-03-15T00:00:01Z"},
+			expectedComparison: "before",
+			expectedDifference: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.CompareTimestamps(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedComparison, result.Comparison)
+			assert.Equal(t, tt.expectedDifference, result.DifferenceSeconds)
+		})
+	}
+
+	t.Run("UTC representations", func(t *testing.T) {
+		result, err := service.CompareTimestamps(context.Background(), CompareTimestampsInput{TimeA: "2024-03-15T10:00:00-04:00", TimeB: "1710515700"})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-15T14:00:00Z", result.TimeAUTC)
+		assert.Equal(t, "2024-03-15T15:15:00Z", result.TimeBUTC)
+	})
+}
+
+func TestTimeService_CompareTimestamps_Errors(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name     string
+		input    CompareTimestampsInput
+		expected string
+	}{
+		{"missing time_b", CompareTimestampsInput{TimeA: "2024-03-15"}, "required"},
+		{"invalid time_a", CompareTimestampsInput{TimeA: "soon", TimeB: "2024-03-15"}, "invalid time_a"},
+		{"format mismatch", CompareTimestampsInput{TimeA: "2024-03-15", TimeB: "2024-03-15", FormatB: "Unix"}, "invalid time_b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CompareTimestamps(context.Background(), tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package time
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+// cancellingClock cancels a context the first time the current time is read, simulating a
+// caller that goes away while an operation is in progress
+type cancellingClock struct {
+	once   sync.Once
+	cancel context.CancelFunc
+}
+
+func (c *cancellingClock) Now() time.Time {
+	c.once.Do(c.cancel)
+	return time.Date(2024, time.March, 15, 12, 0, 0, 0, time.UTC)
+}
+
+func TestTimeService_CancelledMidOperation(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	t.Run("batch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(&cancellingClock{cancel: cancel}))
+
+		queries := make([]GetTimeInput, maxBatchQueries)
+		_, err := service.BatchGetCurrentTime(ctx, BatchGetTimeInput{Queries: queries})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("timezone offset list", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(&cancellingClock{cancel: cancel}))
+
+		_, err := service.ListTimezoneOffsets(ctx, TimezoneOffsetListInput{})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestTimeService_CancelledBeforeStart(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"get_time", func() error { _, err := service.GetCurrentTime(ctx, GetTimeInput{}); return err }},
+		{"format_time", func() error { _, err := service.FormatTime(ctx, FormatTimeInput{Format: "RFC3339"}); return err }},
+		{"parse_time", func() error {
+			_, err := service.ParseTime(ctx, ParseTimeInput{TimeString: "2024-03-15T12:00:00Z"})
+			return err
+		}},
+		{"timezone_info", func() error { _, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "UTC"}); return err }},
+		{"histogram", func() error {
+			_, err := service.BucketTimestamps(ctx, TimeHistogramInput{Timestamps: []string{"2024-03-15"}})
+			return err
+		}},
+		{"convert_timezone", func() error { _, err := service.ConvertTimezone(ctx, time.Now(), "UTC", "Asia/Tokyo"); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.call(), context.Canceled)
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Countdown returns the time remaining until (or elapsed since) a target date
+func (s *timeService) Countdown(ctx context.Context, input CountdownInput) (CountdownResult, error) {
+	if err := ctx.Err(); err != nil {
+		return CountdownResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	if input.TargetDate == "" {
+		return CountdownResult{}, validationErrorf(CodeMissingArgument, "target_date cannot be empty")
+	}
+
+	s.logger.Debug("Computing countdown",
+		zap.String("event_name", input.EventName),
+		zap.String("target_date", input.TargetDate),
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return CountdownResult{}, err
+	}
+
+	target, err := parseFlexibleTime(input.TargetDate, loc)
+	if err != nil {
+		return CountdownResult{}, parseErrorf(CodeInvalidTimestamp, "invalid target_date: %w", err)
+	}
+
+	// Use provided reference time or current time
+	reference := s.clock.Now()
+	if input.ReferenceTime != "" {
+		reference, err = parseFlexibleTime(input.ReferenceTime, loc)
+		if err != nil {
+			return CountdownResult{}, parseErrorf(CodeInvalidTimestamp, "invalid reference_time: %w", err)
+		}
+	}
+
+	totalSeconds := wholeSecondsBetween(reference, target)
+	isPast := totalSeconds < 0
+	if isPast {
+		totalSeconds = -totalSeconds
+	}
+
+	naturalLanguage := humanizeSeconds(totalSeconds)
+	if isPast && totalSeconds > 0 {
+		naturalLanguage += " ago"
+	}
+
+	remaining := DurationComponents{
+		Days:    int(totalSeconds / 86400),
+		Hours:   int(totalSeconds % 86400 / 3600),
+		Minutes: int(totalSeconds % 3600 / 60),
+		Seconds: int(totalSeconds % 60),
+	}
+	iso8601Duration := remaining.ISO8601()
+	if isPast {
+		iso8601Duration = remaining.negated().ISO8601()
+	}
+
+	return CountdownResult{
+		EventName:       input.EventName,
+		IsPast:          isPast,
+		Days:            remaining.Days,
+		Hours:           remaining.Hours,
+		Minutes:         remaining.Minutes,
+		Seconds:         remaining.Seconds,
+		TotalSeconds:    totalSeconds,
+		NaturalLanguage: naturalLanguage,
+		ISO8601Duration: iso8601Duration,
+		Warning:         warning,
+	}, nil
+}
+
+// describeElapsed describes how far t is from now, e.g. "3 days and 2 hours ago" for a past time
+// or "in 45 minutes" for a future one
+func describeElapsed(t, now time.Time) string {
+	elapsed := wholeSecondsBetween(t, now)
+	switch {
+	case elapsed > 0:
+		return humanizeSeconds(elapsed) + " ago"
+	case elapsed < 0:
+		return "in " + humanizeSeconds(-elapsed)
+	default:
+		return "now"
+	}
+}
+
+// wholeSecondsBetween returns the whole seconds from one time to another, truncated toward zero.
+// Unlike time.Sub it does not saturate for spans longer than about 292 years.
+func wholeSecondsBetween(from, to time.Time) int64 {
+	seconds := to.Unix() - from.Unix()
+	nanos := to.Nanosecond() - from.Nanosecond()
+
+	switch {
+	case seconds > 0 && nanos < 0:
+		seconds--
+	case seconds < 0 && nanos > 0:
+		seconds++
+	}
+	return seconds
+}
+
+// humanizeSeconds describes a non-negative number of seconds using its two most significant units,
+// e.g. "15 days and 3 hours"
+func humanizeSeconds(totalSeconds int64) string {
+	units := []struct {
+		name  string
+		value int64
+	}{
+		{"day", totalSeconds / 86400},
+		{"hour", totalSeconds % 86400 / 3600},
+		{"minute", totalSeconds % 3600 / 60},
+		{"second", totalSeconds % 60},
+	}
+
+	var parts []string
+	for _, unit := range units {
+		if unit.value == 0 {
+			continue
+		}
+
+		name := unit.name
+		if unit.value != 1 {
+			name += "s"
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", unit.value, name))
+
+		if len(parts) == 2 {
+			break
+		}
+	}
+
+	if len(parts) == 0 {
+		return "now"
+	}
+
+	return strings.Join(parts, " and ")
+}
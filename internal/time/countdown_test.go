@@ -0,0 +1,121 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_Countdown(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name     string
+		input    CountdownInput
+		wantErr  bool
+		expected CountdownResult
+	}{
+		{
+			name: "future event",
+			input: CountdownInput{
+				TargetDate:    "2024-03-16T03:00:00Z",
+				EventName:     "Product launch",
+				ReferenceTime: "2024-03-01T00:00:00Z",
+			},
+			expected: CountdownResult{
+				EventName:       "Product launch",
+				Days:            15,
+				Hours:           3,
+				TotalSeconds:    15*86400 + 3*3600,
+				NaturalLanguage: "15 days and 3 hours",
+				ISO8601Duration: "P15DT3H",
+			},
+		},
+		{
+			name: "past event in timezone",
+			input: CountdownInput{
+				TargetDate:    "2024-03-01 09:00",
+				Timezone:      "America/New_York",
+				ReferenceTime: "2024-03-01T14:01:30Z",
+			},
+			expected: CountdownResult{
+				IsPast:          true,
+				Minutes:         1,
+				Seconds:         30,
+				TotalSeconds:    90,
+				NaturalLanguage: "1 minute and 30 seconds ago",
+				ISO8601Duration: "-PT1M30S",
+			},
+		},
+		{
+			name: "event happening now",
+			input: CountdownInput{
+				TargetDate:    "2024-03-01T00:00:00Z",
+				ReferenceTime: "2024-03-01T00:00:00Z",
+			},
+			expected: CountdownResult{NaturalLanguage: "now", ISO8601Duration: "PT0S"},
+		},
+		{
+			name: "span longer than a time.Duration",
+			input: CountdownInput{
+				TargetDate:    "0001-01-01T00:00:00Z",
+				ReferenceTime: "9999-01-01T00:00:00Z",
+			},
+			expected: CountdownResult{
+				IsPast:          true,
+				Days:            3651694,
+				TotalSeconds:    3651694 * 86400,
+				NaturalLanguage: "3651694 days ago",
+				ISO8601Duration: "-P3651694D",
+			},
+		},
+		{
+			name:    "missing target date",
+			input:   CountdownInput{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			input:   CountdownInput{TargetDate: "2024-03-01", Timezone: "Invalid/Timezone"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.Countdown(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func Test_humanizeSeconds(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{0, "now"},
+		{time.Second, "1 second"},
+		{90 * time.Minute, "1 hour and 30 minutes"},
+		{49*time.Hour + 5*time.Minute + 3*time.Second, "2 days and 1 hour"},
+		{24*time.Hour + 30*time.Second, "1 day and 30 seconds"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, humanizeSeconds(int64(tt.duration/time.Second)))
+		})
+	}
+}
@@ -0,0 +1,18 @@
+package time
+
+import "time"
+
+// ValidateDateRange returns a *ValidationError with code CodeInvertedDateRange when start is after
+// end, or when they are equal and allowEqual is false. Tools accepting a start and an end call it
+// so inverted ranges fail the same way everywhere.
+func ValidateDateRange(start, end time.Time, allowEqual bool) error {
+	switch {
+	case start.After(end):
+		return validationErrorf(CodeInvertedDateRange, "invalid date range: end %s is before start %s",
+			end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+	case start.Equal(end) && !allowEqual:
+		return validationErrorf(CodeInvertedDateRange, "invalid date range: end %s must be after start %s",
+			end.Format(time.RFC3339Nano), start.Format(time.RFC3339Nano))
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDateRange(t *testing.T) {
+	noon := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		allowEqual  bool
+		expectError bool
+	}{
+		{name: "ordered", start: noon, end: noon.Add(time.Hour)},
+		{name: "same day", start: noon, end: noon.Add(11 * time.Hour)},
+		{name: "identical times allowed", start: noon, end: noon, allowEqual: true},
+		{name: "identical times rejected", start: noon, end: noon, expectError: true},
+		{name: "same instant in different zones", start: noon, end: noon.In(time.FixedZone("+05:30", 19800)), allowEqual: true},
+		{name: "reversed dates", start: noon, end: noon.AddDate(0, 0, -1), expectError: true},
+		{name: "reversed dates even when equal is allowed", start: noon, end: noon.Add(-time.Nanosecond), allowEqual: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDateRange(tt.start, tt.end, tt.allowEqual)
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, CodeInvertedDateRange, validationErr.Code)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package time
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// layoutProbe is formatted with custom layouts to check they contain at least one time element
+var layoutProbe = time.Date(2001, time.February, 3, 4, 5, 6, 0, time.UTC)
+
+// WithFallbackFormats sets the formats tried in order when the default format is empty or
+// invalid. The first usable one becomes the default format.
+func WithFallbackFormats(formats []string) Option {
+	return func(s *timeService) {
+		s.fallbackFormats = formats
+	}
+}
+
+// resolveDefaultFormat returns the configured default format when it is usable, otherwise the
+// first usable fallback format. When none is usable the configured default is kept, so requests
+// relying on it fail with the usual unsupported format error.
+func (s *timeService) resolveDefaultFormat() string {
+	if s.isUsableFormat(s.configuredFormat) {
+		return s.configuredFormat
+	}
+
+	for _, format := range s.fallbackFormats {
+		if s.isUsableFormat(format) {
+			s.logger.Warn("Default format is not usable, using fallback format",
+				zap.String("default_format", s.configuredFormat),
+				zap.String("fallback_format", format))
+			return format
+		}
+	}
+
+	if len(s.fallbackFormats) > 0 {
+		s.logger.Error("Neither the default format nor any fallback format is usable",
+			zap.String("default_format", s.configuredFormat),
+			zap.Strings("fallback_formats", s.fallbackFormats))
+	}
+	return s.configuredFormat
+}
+
+// isUsableFormat reports whether format is supported and, unless it is a built-in or plugin
+// format type, is a Go layout that renders at least one part of the time
+func (s *timeService) isUsableFormat(format string) bool {
+	if format == "" || !s.IsFormatSupported(format) {
+		return false
+	}
+
+	if _, builtin := formatDescriptions[FormatType(format)]; builtin {
+		return true
+	}
+	if _, ok := s.pluginFor(format); ok {
+		return true
+	}
+	return layoutProbe.Format(format) != format
+}
@@ -0,0 +1,90 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_FallbackFormats(t *testing.T) {
+	fixed := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		defaultFormat    string
+		supportedFormats []string
+		fallbackFormats  []string
+		expectedFormat   string
+		expectedTime     string
+		expectError      bool
+	}{
+		{
+			name:             "usable default format ignores fallbacks",
+			defaultFormat:    "Unix",
+			supportedFormats: []string{"RFC3339", "Unix"},
+			fallbackFormats:  []string{"RFC3339"},
+			expectedFormat:   "Unix",
+			expectedTime:     "1709296200",
+		},
+		{
+			name:             "empty default format",
+			defaultFormat:    "",
+			supportedFormats: []string{"RFC3339", "Unix"},
+			fallbackFormats:  []string{"RFC3339"},
+			expectedFormat:   "RFC3339",
+			expectedTime:     "2024-03-01T12:30:00Z",
+		},
+		{
+			name:             "unsupported default format",
+			defaultFormat:    "UnixMilli",
+			supportedFormats: []string{"RFC3339", "Unix"},
+			fallbackFormats:  []string{"Unix"},
+			expectedFormat:   "Unix",
+			expectedTime:     "1709296200",
+		},
+		{
+			name:             "custom layout without time elements",
+			defaultFormat:    "timestamp",
+			supportedFormats: []string{"timestamp", "Jan 2 15:04", "RFC3339"},
+			fallbackFormats:  []string{"Jan 2 15:04", "RFC3339"},
+			expectedFormat:   "Jan 2 15:04",
+			expectedTime:     "Mar 1 12:30",
+		},
+		{
+			name:             "unusable fallbacks are skipped",
+			defaultFormat:    "",
+			supportedFormats: []string{"RFC3339"},
+			fallbackFormats:  []string{"Unix", "nothing", "RFC3339"},
+			expectedFormat:   "RFC3339",
+			expectedTime:     "2024-03-01T12:30:00Z",
+		},
+		{
+			name:             "no usable format",
+			defaultFormat:    "",
+			supportedFormats: []string{"RFC3339"},
+			fallbackFormats:  []string{"Unix"},
+			expectError:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTimeService("UTC", tt.defaultFormat, tt.supportedFormats, zaptest.NewLogger(t),
+				WithClock(FixedClock{Time: fixed}), WithFallbackFormats(tt.fallbackFormats))
+
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, result.Format)
+			assert.Equal(t, tt.expectedTime, result.FormattedTime)
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package dsl
+
+import (
+	"fmt"
+	"time"
+)
+
+// maxRelativeDaySearch bounds the search for the closest matching day; every day set matches at
+// least once a week
+const maxRelativeDaySearch = 7
+
+// Evaluate computes the moment a query describes. Calendar arithmetic happens in the location of
+// now, so the caller converts now to the query's Zone first.
+func (q *Query) Evaluate(now time.Time) (time.Time, error) {
+	t, err := q.Selection.resolve(now)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if q.At != nil {
+		t = time.Date(t.Year(), t.Month(), t.Day(), q.At.Hour, q.At.Minute, 0, 0, t.Location())
+	}
+
+	for _, offset := range q.Offsets {
+		t = offset.addTo(t)
+	}
+
+	return t, nil
+}
+
+func (a Anchor) resolve(now time.Time) (time.Time, error) {
+	switch a.Name {
+	case "today":
+		return midnight(now), nil
+	case "epoch":
+		return time.Unix(0, 0).In(now.Location()), nil
+	default:
+		return now, nil
+	}
+}
+
+func (p PeriodStart) resolve(now time.Time) (time.Time, error) {
+	start, _ := p.Period.bounds(now)
+	return start, nil
+}
+
+func (o OrdinalDay) resolve(now time.Time) (time.Time, error) {
+	if o.Period.Unit != UnitWeek && o.Period.Unit != UnitMonth && o.Period.Unit != UnitQuarter && o.Period.Unit != UnitYear {
+		return time.Time{}, fmt.Errorf("%s: days can only be selected in a week, month, quarter or year", o)
+	}
+
+	start, end := o.Period.bounds(now)
+
+	var matches []time.Time
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if o.Days.Matches(day) {
+			matches = append(matches, day)
+		}
+	}
+
+	if o.Ordinal == -1 && len(matches) > 0 {
+		return matches[len(matches)-1], nil
+	}
+	if o.Ordinal < 1 || o.Ordinal > len(matches) {
+		return time.Time{}, fmt.Errorf("%s: %s only has %d", o, o.Period, len(matches))
+	}
+	return matches[o.Ordinal-1], nil
+}
+
+func (r RelativeDay) resolve(now time.Time) (time.Time, error) {
+	today := midnight(now)
+
+	step, day := 1, today.AddDate(0, 0, 1)
+	switch r.Relation {
+	case "this":
+		day = today
+	case "previous":
+		step, day = -1, today.AddDate(0, 0, -1)
+	}
+
+	for i := 0; i < maxRelativeDaySearch; i++ {
+		if r.Days.Matches(day) {
+			return day, nil
+		}
+		day = day.AddDate(0, 0, step)
+	}
+	return time.Time{}, fmt.Errorf("%s: no matching day within a week", r)
+}
+
+// bounds returns the start and exclusive end of the period relative to the one containing now.
+// Weeks start on Monday.
+func (p Period) bounds(now time.Time) (time.Time, time.Time) {
+	start := startOf(now, p.Unit)
+	start = shift(start, p.Unit, p.Offset)
+	return start, shift(start, p.Unit, 1)
+}
+
+// startOf truncates t to the start of the unit containing it
+func startOf(t time.Time, unit Unit) time.Time {
+	loc := t.Location()
+	switch unit {
+	case UnitSecond:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+	case UnitMinute:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc)
+	case UnitHour:
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+	case UnitDay:
+		return midnight(t)
+	case UnitWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return midnight(t).AddDate(0, 0, -daysSinceMonday)
+	case UnitMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc)
+	case UnitQuarter:
+		firstMonth := time.Month((int(t.Month())-1)/3*3 + 1)
+		return time.Date(t.Year(), firstMonth, 1, 0, 0, 0, 0, loc)
+	default:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, loc)
+	}
+}
+
+// shift moves t by n units. Calendar units keep the wall clock time across DST changes; clock
+// units are exact.
+func shift(t time.Time, unit Unit, n int) time.Time {
+	switch unit {
+	case UnitSecond:
+		return t.Add(time.Duration(n) * time.Second)
+	case UnitMinute:
+		return t.Add(time.Duration(n) * time.Minute)
+	case UnitHour:
+		return t.Add(time.Duration(n) * time.Hour)
+	case UnitDay:
+		return t.AddDate(0, 0, n)
+	case UnitWeek:
+		return t.AddDate(0, 0, 7*n)
+	case UnitMonth:
+		return t.AddDate(0, n, 0)
+	case UnitQuarter:
+		return t.AddDate(0, 3*n, 0)
+	default:
+		return t.AddDate(n, 0, 0)
+	}
+}
+
+// addTo applies the offset to t, skipping weekends when counting business days
+func (o Offset) addTo(t time.Time) time.Time {
+	if !o.Business {
+		return shift(t, o.Unit, o.Amount)
+	}
+
+	step, remaining := 1, o.Amount
+	if remaining < 0 {
+		step, remaining = -1, -remaining
+	}
+	for remaining > 0 {
+		t = t.AddDate(0, 0, step)
+		if t.Weekday() != time.Saturday && t.Weekday() != time.Sunday {
+			remaining--
+		}
+	}
+	return t
+}
+
+// midnight returns the start of the day of t in its location
+func midnight(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,73 @@
+package dsl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery_Evaluate(t *testing.T) {
+	now := time.Date(2024, time.March, 13, 10, 0, 0, 0, time.UTC) // a Wednesday
+
+	tests := []struct {
+		name     string
+		query    string
+		timezone string
+		wantErr  bool
+		expected string
+	}{
+		{name: "now", query: "now - 90 minutes", expected: "2024-03-13T08:30:00Z"},
+		{name: "today", query: "today", expected: "2024-03-13T00:00:00Z"},
+		{name: "epoch", query: "epoch + 1 day", expected: "1970-01-02T00:00:00Z"},
+		{name: "last business day of previous quarter", query: "last business day of previous quarter", expected: "2023-12-29T00:00:00Z"},
+		{name: "first monday of next month", query: "first Monday of next month at 9am UTC", expected: "2024-04-01T09:00:00Z"},
+		{name: "third friday of this month", query: "third Friday of this month", expected: "2024-03-15T00:00:00Z"},
+		{name: "last day of month", query: "last day of month", expected: "2024-03-31T00:00:00Z"},
+		{name: "second weekday of next year", query: "2nd weekday of next year", expected: "2025-01-02T00:00:00Z"},
+		{name: "first day of next week", query: "first day of next week at 17:30", expected: "2024-03-18T17:30:00Z"},
+		{name: "next business day", query: "next business day", expected: "2024-03-14T00:00:00Z"},
+		{name: "previous weekend day", query: "previous weekend", expected: "2024-03-10T00:00:00Z"},
+		{name: "last monday", query: "last Monday", expected: "2024-03-11T00:00:00Z"},
+		{name: "this wednesday is today", query: "this Wednesday", expected: "2024-03-13T00:00:00Z"},
+		{name: "next week", query: "next week", expected: "2024-03-18T00:00:00Z"},
+		{name: "this quarter", query: "this quarter", expected: "2024-01-01T00:00:00Z"},
+		{name: "end of month", query: "next month - 1 second", expected: "2024-03-31T23:59:59Z"},
+		{name: "business days skip the weekend", query: "today + 3 business days", expected: "2024-03-18T00:00:00Z"},
+		{name: "business days backwards", query: "today - 4 weekdays", expected: "2024-03-07T00:00:00Z"},
+		{name: "quarters", query: "this month + 2 quarters", expected: "2024-09-01T00:00:00Z"},
+		{
+			name:     "evaluated in the location of now",
+			query:    "today at noon",
+			timezone: "Asia/Tokyo",
+			expected: "2024-03-13T12:00:00+09:00",
+		},
+		{name: "not enough matching days", query: "fifth Monday of previous month", wantErr: true},
+		{name: "days of a day", query: "first Monday of next hour", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.query)
+			require.NoError(t, err)
+
+			evaluatedAt := now
+			if tt.timezone != "" {
+				loc, err := time.LoadLocation(tt.timezone)
+				require.NoError(t, err)
+				evaluatedAt = now.In(loc)
+			}
+
+			result, err := query.Evaluate(evaluatedAt)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Format(time.RFC3339))
+		})
+	}
+}
@@ -0,0 +1,654 @@
+// Package dsl parses and evaluates time queries such as "last business day of previous quarter"
+// or "first Monday of next month at 9am UTC".
+package dsl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Grammar:
+//
+//	query     := selection ["at" clock] (("+" | "-") duration)* [zone]
+//	selection := anchor
+//	           | ordinal days "of" period
+//	           | relation days
+//	           | period
+//	anchor    := "now" | "today" | "epoch"
+//	ordinal   := "first" | "second" | "third" | "fourth" | "fifth" | "last" | "1st" | "2nd" | ...
+//	days      := ["business" | "weekday" | "weekend"] "day" | "weekday" | "weekend" | weekday-name
+//	period    := [relation] unit
+//	relation  := "this" | "next" | "last" | "previous"
+//	duration  := number ["business" | "weekday"] unit
+//	unit      := "second" | "minute" | "hour" | "day" | "week" | "month" | "quarter" | "year"
+//	clock     := "9am" | "9:30" | "9:30pm" | "17:00" | number ["am" | "pm"] | "noon" | "midnight"
+//	zone      := "UTC" | "GMT" | IANA name such as "Europe/Paris"
+
+// Unit is a calendar or clock unit
+type Unit string
+
+// Units supported in periods and durations
+const (
+	UnitSecond  Unit = "second"
+	UnitMinute  Unit = "minute"
+	UnitHour    Unit = "hour"
+	UnitDay     Unit = "day"
+	UnitWeek    Unit = "week"
+	UnitMonth   Unit = "month"
+	UnitQuarter Unit = "quarter"
+	UnitYear    Unit = "year"
+)
+
+// units maps unit spellings to their unit
+var units = map[string]Unit{
+	"second": UnitSecond, "seconds": UnitSecond,
+	"minute": UnitMinute, "minutes": UnitMinute,
+	"hour": UnitHour, "hours": UnitHour,
+	"day": UnitDay, "days": UnitDay,
+	"week": UnitWeek, "weeks": UnitWeek,
+	"month": UnitMonth, "months": UnitMonth,
+	"quarter": UnitQuarter, "quarters": UnitQuarter,
+	"year": UnitYear, "years": UnitYear,
+}
+
+// maxOffsetAmount bounds the amount of an offset, keeping business day counting cheap
+const maxOffsetAmount = 100000
+
+// ordinalWords are the ordinals that can be written as words, by position
+var ordinalWords = []string{"", "first", "second", "third", "fourth", "fifth"}
+
+// relations maps period relations to the number of periods away from the current one
+var relations = map[string]int{
+	"this": 0, "next": 1, "last": -1, "previous": -1,
+}
+
+// DayKind restricts which days of the week a day selection matches
+type DayKind string
+
+// Day kinds supported in day selections
+const (
+	DayAny      DayKind = "day"
+	DayBusiness DayKind = "business day"
+	DayWeekday  DayKind = "weekday"
+	DayWeekend  DayKind = "weekend day"
+	DayNamed    DayKind = "named"
+)
+
+// Days is a set of days of the week
+type Days struct {
+	Kind    DayKind
+	Weekday time.Weekday // Set when Kind is DayNamed
+}
+
+// Matches reports whether the day of t belongs to the set. Business days are Monday to Friday;
+// holidays are not considered.
+func (d Days) Matches(t time.Time) bool {
+	weekend := t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+	switch d.Kind {
+	case DayBusiness, DayWeekday:
+		return !weekend
+	case DayWeekend:
+		return weekend
+	case DayNamed:
+		return t.Weekday() == d.Weekday
+	default:
+		return true
+	}
+}
+
+func (d Days) String() string {
+	if d.Kind == DayNamed {
+		return d.Weekday.String()
+	}
+	return string(d.Kind)
+}
+
+// Period is a whole unit of time relative to the current one, such as "next month"
+type Period struct {
+	Offset int // -1, 0 or 1
+	Unit   Unit
+}
+
+func (p Period) String() string {
+	switch p.Offset {
+	case 0:
+		return "this " + string(p.Unit)
+	case 1:
+		return "next " + string(p.Unit)
+	default:
+		return "previous " + string(p.Unit)
+	}
+}
+
+// Selection is the part of a query that picks a moment before any clock time or offsets apply
+type Selection interface {
+	fmt.Stringer
+	resolve(now time.Time) (time.Time, error)
+}
+
+// Anchor is one of the fixed starting points "now", "today" and "epoch"
+type Anchor struct {
+	Name string
+}
+
+func (a Anchor) String() string { return a.Name }
+
+// OrdinalDay selects the nth matching day of a period, such as "first Monday of next month"
+type OrdinalDay struct {
+	Ordinal int // 1-based, or -1 for the last matching day
+	Days    Days
+	Period  Period
+}
+
+func (o OrdinalDay) String() string {
+	ordinal := "last"
+	switch {
+	case o.Ordinal > 0 && o.Ordinal < len(ordinalWords):
+		ordinal = ordinalWords[o.Ordinal]
+	case o.Ordinal > 0:
+		ordinal = fmt.Sprintf("%dth", o.Ordinal)
+	}
+	return fmt.Sprintf("%s %s of %s", ordinal, o.Days, o.Period)
+}
+
+// RelativeDay selects the closest matching day before or after today, such as "next business day"
+type RelativeDay struct {
+	Relation string // "this" (today or later), "next" (after today) or "previous" (before today)
+	Days     Days
+}
+
+func (r RelativeDay) String() string {
+	return fmt.Sprintf("%s %s", r.Relation, r.Days)
+}
+
+// PeriodStart selects the start of a period, such as "next quarter"
+type PeriodStart struct {
+	Period Period
+}
+
+func (p PeriodStart) String() string { return p.Period.String() }
+
+// Clock is a time of day
+type Clock struct {
+	Hour   int
+	Minute int
+}
+
+func (c Clock) String() string {
+	return fmt.Sprintf("%02d:%02d", c.Hour, c.Minute)
+}
+
+// Offset is a duration added to or subtracted from the selected moment
+type Offset struct {
+	Amount   int // Negative when subtracted
+	Unit     Unit
+	Business bool // Counts only business days; Unit is then UnitDay
+}
+
+func (o Offset) String() string {
+	sign, amount := "+", o.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	unit := string(o.Unit)
+	if o.Business {
+		unit = "business " + unit
+	}
+	if amount != 1 {
+		unit += "s"
+	}
+	return fmt.Sprintf("%s %d %s", sign, amount, unit)
+}
+
+// Query is a parsed time query
+type Query struct {
+	Selection Selection
+	At        *Clock
+	Offsets   []Offset
+	Zone      string // IANA timezone named at the end of the query, if any
+}
+
+// String returns the query in canonical form
+func (q *Query) String() string {
+	parts := []string{q.Selection.String()}
+	if q.At != nil {
+		parts = append(parts, "at", q.At.String())
+	}
+	for _, offset := range q.Offsets {
+		parts = append(parts, offset.String())
+	}
+	if q.Zone != "" {
+		parts = append(parts, q.Zone)
+	}
+	return strings.Join(parts, " ")
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenNumber
+	tokenOrdinal
+	tokenClock
+	tokenPlus
+	tokenMinus
+)
+
+type token struct {
+	kind   tokenKind
+	text   string // Lowercase text
+	raw    string // Text as written, used for timezone names
+	number int
+	clock  Clock
+}
+
+var (
+	numericOrdinal = regexp.MustCompile(`^(\d{1,2})(st|nd|rd|th)$`)
+	clockPattern   = regexp.MustCompile(`^(\d{1,2})(?::(\d{2}))?(am|pm)?$`)
+)
+
+// tokenize splits a query on whitespace and classifies each chunk. Operators may be attached to
+// the following number ("+2 days").
+func tokenize(query string) ([]token, error) {
+	var tokens []token
+
+	for _, raw := range strings.Fields(query) {
+		if raw[0] == '+' || raw[0] == '-' {
+			kind := tokenPlus
+			if raw[0] == '-' {
+				kind = tokenMinus
+			}
+			tokens = append(tokens, token{kind: kind, text: raw[:1], raw: raw[:1]})
+			raw = raw[1:]
+			if raw == "" {
+				continue
+			}
+		}
+
+		text := strings.ToLower(raw)
+		switch {
+		case isDigits(text):
+			n, err := strconv.Atoi(text)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %s", raw)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, raw: raw, number: n})
+		case numericOrdinal.MatchString(text):
+			n, _ := strconv.Atoi(numericOrdinal.FindStringSubmatch(text)[1])
+			tokens = append(tokens, token{kind: tokenOrdinal, text: text, raw: raw, number: n})
+		case clockPattern.MatchString(text):
+			clock, err := parseClock(clockPattern.FindStringSubmatch(text))
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenClock, text: text, raw: raw, clock: clock})
+		default:
+			tokens = append(tokens, token{kind: tokenWord, text: text, raw: raw})
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("query cannot be empty")
+	}
+	return tokens, nil
+}
+
+// parseClock converts the submatches of clockPattern into a time of day
+func parseClock(match []string) (Clock, error) {
+	hour, _ := strconv.Atoi(match[1])
+	minute := 0
+	if match[2] != "" {
+		minute, _ = strconv.Atoi(match[2])
+	}
+	return clockFrom(match[0], hour, minute, match[3])
+}
+
+// clockFrom validates a time of day with an optional "am" or "pm" suffix
+func clockFrom(text string, hour, minute int, meridiem string) (Clock, error) {
+	if meridiem != "" {
+		if hour < 1 || hour > 12 {
+			return Clock{}, fmt.Errorf("invalid time of day %s", text)
+		}
+		hour %= 12
+		if meridiem == "pm" {
+			hour += 12
+		}
+	}
+	if hour > 23 || minute > 59 {
+		return Clock{}, fmt.Errorf("invalid time of day %s", text)
+	}
+	return Clock{Hour: hour, Minute: minute}, nil
+}
+
+// Parse parses a time query
+func Parse(query string) (*Query, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	parsed, err := p.parseQuery()
+	if err != nil {
+		return nil, err
+	}
+	if tok, ok := p.peek(); ok {
+		return nil, fmt.Errorf("unexpected %q", tok.raw)
+	}
+	return parsed, nil
+}
+
+// parser is a recursive-descent parser over the token stream
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) parseQuery() (*Query, error) {
+	selection, err := p.parseSelection()
+	if err != nil {
+		return nil, err
+	}
+	query := &Query{Selection: selection}
+
+	if tok, ok := p.peek(); ok && tok.text == "at" {
+		p.pos++
+		clock, err := p.parseClock()
+		if err != nil {
+			return nil, err
+		}
+		query.At = &clock
+	}
+
+	for {
+		tok, ok := p.peek()
+		if !ok || (tok.kind != tokenPlus && tok.kind != tokenMinus) {
+			break
+		}
+		p.pos++
+
+		offset, err := p.parseDuration()
+		if err != nil {
+			return nil, err
+		}
+		if tok.kind == tokenMinus {
+			offset.Amount = -offset.Amount
+		}
+		query.Offsets = append(query.Offsets, offset)
+	}
+
+	if tok, ok := p.peek(); ok && isZone(tok) {
+		p.pos++
+		query.Zone = tok.raw
+		if tok.text == "utc" || tok.text == "gmt" || tok.text == "z" {
+			query.Zone = "UTC"
+		}
+	}
+
+	return query, nil
+}
+
+func (p *parser) parseSelection() (Selection, error) {
+	tok, ok := p.next()
+	if !ok {
+		return nil, fmt.Errorf("expected a time")
+	}
+
+	switch tok.text {
+	case "now", "today", "epoch":
+		return Anchor{Name: tok.text}, nil
+	}
+
+	// "last" and "second" are both ordinals and other words, so look ahead for a day selection
+	ordinal, isOrdinal := parseOrdinal(tok)
+	if isOrdinal && p.startsDays() && p.hasOf() {
+		days, err := p.parseDays()
+		if err != nil {
+			return nil, err
+		}
+		if ordinal == 0 {
+			return nil, fmt.Errorf("invalid ordinal %q", tok.raw)
+		}
+		if of, _ := p.next(); of.text != "of" {
+			return nil, fmt.Errorf("expected 'of' after %s %s", tok.raw, days)
+		}
+		period, err := p.parsePeriod()
+		if err != nil {
+			return nil, err
+		}
+		return OrdinalDay{Ordinal: ordinal, Days: days, Period: period}, nil
+	}
+
+	if offset, isRelation := relations[tok.text]; isRelation {
+		if p.startsDays() {
+			days, err := p.parseDays()
+			if err != nil {
+				return nil, err
+			}
+			relation := tok.text
+			if relation == "last" {
+				relation = "previous"
+			}
+			return RelativeDay{Relation: relation, Days: days}, nil
+		}
+
+		unitTok, ok := p.next()
+		unit, isUnit := units[unitTok.text]
+		if !ok || !isUnit {
+			return nil, fmt.Errorf("expected a unit or days after %q", tok.raw)
+		}
+		return PeriodStart{Period: Period{Offset: offset, Unit: unit}}, nil
+	}
+
+	return nil, fmt.Errorf("unknown time %q", tok.raw)
+}
+
+// startsDays reports whether the next tokens form a day selection
+func (p *parser) startsDays() bool {
+	tok, ok := p.peek()
+	if !ok {
+		return false
+	}
+	switch tok.text {
+	case "day", "business", "weekday", "weekend":
+		return true
+	}
+	_, isWeekday := parseWeekday(tok.text)
+	return isWeekday
+}
+
+// hasOf reports whether an "of" follows the day selection at the current position
+func (p *parser) hasOf() bool {
+	for _, tok := range p.tokens[p.pos:] {
+		if tok.text == "of" {
+			return true
+		}
+		if tok.kind != tokenWord {
+			return false
+		}
+	}
+	return false
+}
+
+func (p *parser) parseDays() (Days, error) {
+	tok, _ := p.next()
+
+	var kind DayKind
+	switch tok.text {
+	case "day":
+		return Days{Kind: DayAny}, nil
+	case "business":
+		kind = DayBusiness
+	case "weekday", "weekend":
+		kind = DayWeekday
+		if tok.text == "weekend" {
+			kind = DayWeekend
+		}
+		// "weekday" and "weekend" stand alone or qualify "day"
+		if next, ok := p.peek(); ok && next.text == "day" {
+			p.pos++
+		}
+		return Days{Kind: kind}, nil
+	default:
+		day, _ := parseWeekday(tok.text)
+		return Days{Kind: DayNamed, Weekday: day}, nil
+	}
+
+	if next, ok := p.next(); !ok || next.text != "day" {
+		return Days{}, fmt.Errorf("expected 'day' after %q", tok.raw)
+	}
+	return Days{Kind: kind}, nil
+}
+
+func (p *parser) parsePeriod() (Period, error) {
+	tok, ok := p.next()
+	if !ok {
+		return Period{}, fmt.Errorf("expected a period")
+	}
+
+	offset := 0
+	if relation, isRelation := relations[tok.text]; isRelation {
+		offset = relation
+		if tok, ok = p.next(); !ok {
+			return Period{}, fmt.Errorf("expected a unit after %q", "of")
+		}
+	}
+
+	unit, ok := units[tok.text]
+	if !ok {
+		return Period{}, fmt.Errorf("expected a unit, got %q", tok.raw)
+	}
+	return Period{Offset: offset, Unit: unit}, nil
+}
+
+func (p *parser) parseClock() (Clock, error) {
+	tok, ok := p.next()
+	if !ok {
+		return Clock{}, fmt.Errorf("expected a time of day after 'at'")
+	}
+
+	switch {
+	case tok.text == "noon":
+		return Clock{Hour: 12}, nil
+	case tok.text == "midnight":
+		return Clock{}, nil
+	case tok.kind == tokenNumber:
+		// "9 am" is written with a space
+		if next, ok := p.peek(); ok && (next.text == "am" || next.text == "pm") {
+			p.pos++
+			return clockFrom(tok.raw+" "+next.raw, tok.number, 0, next.text)
+		}
+		return clockFrom(tok.raw, tok.number, 0, "")
+	case tok.kind == tokenClock:
+		// So is "5:30 pm"
+		hasMeridiem := strings.HasSuffix(tok.text, "am") || strings.HasSuffix(tok.text, "pm")
+		if next, ok := p.peek(); ok && !hasMeridiem && (next.text == "am" || next.text == "pm") {
+			p.pos++
+			return clockFrom(tok.raw+" "+next.raw, tok.clock.Hour, tok.clock.Minute, next.text)
+		}
+		return tok.clock, nil
+	default:
+		return Clock{}, fmt.Errorf("expected a time of day, got %q", tok.raw)
+	}
+}
+
+func (p *parser) parseDuration() (Offset, error) {
+	tok, ok := p.next()
+	if !ok || tok.kind != tokenNumber {
+		return Offset{}, fmt.Errorf("expected a number after the operator")
+	}
+	if tok.number > maxOffsetAmount {
+		return Offset{}, fmt.Errorf("offset %d is too large (max %d)", tok.number, maxOffsetAmount)
+	}
+
+	unitTok, ok := p.next()
+	if !ok {
+		return Offset{}, fmt.Errorf("expected a unit after %d", tok.number)
+	}
+
+	// Business days are written "3 business days" or "3 weekdays"
+	business := false
+	switch unitTok.text {
+	case "business":
+		business = true
+		if unitTok, ok = p.next(); !ok {
+			return Offset{}, fmt.Errorf("expected 'days' after %q", "business")
+		}
+	case "weekday", "weekdays":
+		business = true
+		unitTok.text = "days"
+	}
+
+	unit, ok := units[unitTok.text]
+	if !ok {
+		return Offset{}, fmt.Errorf("unknown unit %q", unitTok.raw)
+	}
+	if business && unit != UnitDay {
+		return Offset{}, fmt.Errorf("business can only count days, got %q", unitTok.raw)
+	}
+
+	return Offset{Amount: tok.number, Unit: unit, Business: business}, nil
+}
+
+func (p *parser) peek() (token, bool) {
+	if p.pos >= len(p.tokens) {
+		return token{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (token, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+// parseOrdinal returns the position an ordinal token stands for; "last" is -1
+func parseOrdinal(tok token) (int, bool) {
+	if tok.kind == tokenOrdinal {
+		return tok.number, true
+	}
+	if tok.text == "last" {
+		return -1, true
+	}
+	for position, word := range ordinalWords {
+		if word != "" && tok.text == word {
+			return position, true
+		}
+	}
+	return 0, false
+}
+
+// isZone reports whether a token names a timezone
+func isZone(tok token) bool {
+	return tok.kind == tokenWord && (tok.text == "utc" || tok.text == "gmt" || tok.text == "z" || strings.Contains(tok.text, "/"))
+}
+
+// parseWeekday parses a full or three-letter English weekday name
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, true
+		}
+	}
+	return time.Sunday, false
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
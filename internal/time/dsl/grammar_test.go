@@ -0,0 +1,69 @@
+package dsl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name         string
+		query        string
+		wantErr      bool
+		expected     string
+		expectedZone string
+	}{
+		{name: "anchor", query: "now", expected: "now"},
+		{name: "anchor with offsets", query: "NOW -90 minutes + 1 hour", expected: "now - 90 minutes + 1 hour"},
+		{name: "ordinal day of period", query: "last business day of previous quarter", expected: "last business day of previous quarter"},
+		{
+			name:         "clock and zone",
+			query:        "first Monday of next month at 9am utc",
+			expected:     "first Monday of next month at 09:00 UTC",
+			expectedZone: "UTC",
+		},
+		{
+			name:         "IANA zone",
+			query:        "today at 5:30 pm Europe/Paris",
+			expected:     "today at 17:30 Europe/Paris",
+			expectedZone: "Europe/Paris",
+		},
+		{name: "numeric ordinal", query: "2nd weekday of next year", expected: "second weekday of next year"},
+		{name: "ordinal without relation", query: "last day of month", expected: "last day of this month"},
+		{name: "second is an ordinal before days", query: "second Tuesday of last month", expected: "second Tuesday of previous month"},
+		{name: "relative day", query: "next business day", expected: "next business day"},
+		{name: "last is a relation without of", query: "last Monday", expected: "previous Monday"},
+		{name: "weekend day", query: "previous weekend", expected: "previous weekend day"},
+		{name: "period start", query: "next quarter", expected: "next quarter"},
+		{name: "business day offset", query: "today + 3 business days", expected: "today + 3 business days"},
+		{name: "weekdays offset", query: "today - 1 weekday", expected: "today - 1 business day"},
+		{name: "unknown anchor", query: "tomorrow", wantErr: true},
+		{name: "empty", query: "   ", wantErr: true},
+		{name: "missing period", query: "first Monday of next", wantErr: true},
+		{name: "dangling operator", query: "now +", wantErr: true},
+		{name: "business hours", query: "now + 3 business hours", wantErr: true},
+		{name: "missing clock", query: "today at", wantErr: true},
+		{name: "invalid clock", query: "today at 25:00", wantErr: true},
+		{name: "invalid meridiem", query: "today at 13pm", wantErr: true},
+		{name: "unknown unit", query: "next fortnight", wantErr: true},
+		{name: "trailing words", query: "now please", wantErr: true},
+		{name: "offset too large", query: "now + 999999 days", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := Parse(tt.query)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, query.String())
+			assert.Equal(t, tt.expectedZone, query.Zone)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package time
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// AddWallClock adds calendar and clock units to a timestamp on the wall clock of its timezone, so
+// adding 24 hours across a DST change lands on the same local time of day rather than shifting by
+// the hour gained or lost. A result inside a spring-forward gap moves forward by the gap.
+func (s *timeService) AddWallClock(ctx context.Context, input DSTSafeAddInput) (DSTSafeAddResult, error) {
+	if err := ctx.Err(); err != nil {
+		return DSTSafeAddResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return DSTSafeAddResult{}, err
+	}
+
+	start := s.clock.Now().In(loc)
+	if strings.TrimSpace(input.Timestamp) != "" {
+		start, err = parseFlexibleTime(input.Timestamp, loc)
+		if err != nil {
+			return DSTSafeAddResult{}, parseErrorf(CodeInvalidTimestamp, "invalid timestamp: %w", err)
+		}
+	}
+
+	add := DurationComponents{
+		Years:   input.Years,
+		Months:  input.Months,
+		Days:    input.Days,
+		Hours:   input.Hours,
+		Minutes: input.Minutes,
+		Seconds: input.Seconds,
+	}
+	if strings.TrimSpace(input.Duration) != "" {
+		duration, err := ParseISO8601Duration(input.Duration)
+		if err != nil {
+			return DSTSafeAddResult{}, err
+		}
+		add.Years += duration.Years
+		add.Months += duration.Months
+		add.Days += duration.Days
+		add.Hours += duration.Hours
+		add.Minutes += duration.Minutes
+		add.Seconds += duration.Seconds
+	}
+
+	// time.Date normalizes out-of-range fields, so each unit is added to the local wall clock
+	wall := time.Date(
+		start.Year()+add.Years,
+		start.Month()+time.Month(add.Months),
+		start.Day()+add.Days,
+		start.Hour()+add.Hours,
+		start.Minute()+add.Minutes,
+		start.Second()+add.Seconds,
+		start.Nanosecond(),
+		time.UTC)
+	end := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), wall.Nanosecond(), loc)
+
+	// time.Date resolves a wall time skipped by a DST change to before the change; move it
+	// past the change instead
+	if gap := wall.Sub(wallClockOf(end)); gap != 0 {
+		end = end.Add(gap)
+	}
+	if end.Year() < 1 || end.Year() > 9999 {
+		return DSTSafeAddResult{}, validationErrorf(CodeInvalidArgument, "result year %d is outside 1-9999", end.Year())
+	}
+
+	_, startOffset := start.Zone()
+	_, endOffset := end.Zone()
+
+	result := DSTSafeAddResult{
+		StartTime:             start.Format(time.RFC3339),
+		ResultTime:            end.Format(time.RFC3339),
+		WallClockHoursElapsed: end.Sub(start).Hours(),
+		DSTChangeApplied:      endOffset != startOffset,
+		OffsetChange:          formatOffset(endOffset - startOffset),
+		OffsetChangeSeconds:   endOffset - startOffset,
+		Timezone:              loc.String(),
+		Warning:               warning,
+	}
+
+	s.logger.Debug("Added wall clock time",
+		zap.String("start_time", result.StartTime),
+		zap.String("result_time", result.ResultTime),
+		zap.Bool("dst_change_applied", result.DSTChangeApplied))
+
+	return result, nil
+}
+
+// wallClockOf returns t's local date and time of day as a UTC time, for comparing wall clocks
+func wallClockOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
@@ -0,0 +1,100 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_AddWallClock(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name                 string
+		input                DSTSafeAddInput
+		expectedResult       string
+		expectedElapsed      float64
+		expectedDSTChange    bool
+		expectedOffsetChange string
+		expectError          bool
+	}{
+		{
+			name:                 "hours across spring forward",
+			input:                DSTSafeAddInput{Timestamp: "2024-03-10 00:00", Timezone: "America/New_York", Hours: 24},
+			expectedResult:       "2024-03-11T00:00:00-04:00",
+			expectedElapsed:      23,
+			expectedDSTChange:    true,
+			expectedOffsetChange: "+01:00",
+		},
+		{
+			name:                 "day across fall back",
+			input:                DSTSafeAddInput{Timestamp: "2024-11-03 00:00", Timezone: "America/New_York", Days: 1},
+			expectedResult:       "2024-11-04T00:00:00-05:00",
+			expectedElapsed:      25,
+			expectedDSTChange:    true,
+			expectedOffsetChange: "-01:00",
+		},
+		{
+			name:                 "result in spring forward gap",
+			input:                DSTSafeAddInput{Timestamp: "2024-03-09 02:30", Timezone: "America/New_York", Days: 1},
+			expectedResult:       "2024-03-10T03:30:00-04:00",
+			expectedElapsed:      24,
+			expectedDSTChange:    true,
+			expectedOffsetChange: "+01:00",
+		},
+		{
+			name:                 "mixed units without DST change",
+			input:                DSTSafeAddInput{Timestamp: "2024-01-31T10:00:00Z", Months: 1, Hours: -2, Minutes: 30, Seconds: 15},
+			expectedResult:       "2024-03-02T08:30:15Z",
+			expectedElapsed:      31*24 - 1.5 + 15.0/3600,
+			expectedOffsetChange: "+00:00",
+		},
+		{
+			name:                 "defaults to now",
+			input:                DSTSafeAddInput{Years: 1},
+			expectedResult:       "2025-03-15T12:00:00Z",
+			expectedElapsed:      365 * 24,
+			expectedOffsetChange: "+00:00",
+		},
+		{
+			name:                 "ISO 8601 duration",
+			input:                DSTSafeAddInput{Timestamp: "2024-03-10 00:00", Timezone: "America/New_York", Duration: "P1DT12H"},
+			expectedResult:       "2024-03-11T12:00:00-04:00",
+			expectedElapsed:      35,
+			expectedDSTChange:    true,
+			expectedOffsetChange: "+01:00",
+		},
+		{
+			name:                 "negative ISO 8601 duration on top of units",
+			input:                DSTSafeAddInput{Timestamp: "2024-01-31T10:00:00Z", Days: 1, Duration: "-PT90M"},
+			expectedResult:       "2024-02-01T08:30:00Z",
+			expectedElapsed:      22.5,
+			expectedOffsetChange: "+00:00",
+		},
+		{name: "invalid duration", input: DSTSafeAddInput{Duration: "P-3D"}, expectError: true},
+		{name: "invalid timestamp", input: DSTSafeAddInput{Timestamp: "not a time"}, expectError: true},
+		{name: "invalid timezone", input: DSTSafeAddInput{Timezone: "Mars/Olympus"}, expectError: true},
+		{name: "result out of range", input: DSTSafeAddInput{Years: 8000}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.AddWallClock(context.Background(), tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResult, result.ResultTime)
+			assert.InDelta(t, tt.expectedElapsed, result.WallClockHoursElapsed, 1e-9)
+			assert.Equal(t, tt.expectedDSTChange, result.DSTChangeApplied)
+			assert.Equal(t, tt.expectedOffsetChange, result.OffsetChange)
+		})
+	}
+}
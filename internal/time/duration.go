@@ -0,0 +1,119 @@
+package time
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// DurationComponents holds a calendar-aware duration broken down into its components
+type DurationComponents struct {
+	Years   int `json:"years"`
+	Months  int `json:"months"`
+	Days    int `json:"days"`
+	Hours   int `json:"hours"`
+	Minutes int `json:"minutes"`
+	Seconds int `json:"seconds"`
+}
+
+// iso8601DurationPattern matches ISO 8601 durations such as P1Y2M3DT4H5M6S, P2W or -P3D
+var iso8601DurationPattern = regexp.MustCompile(`^([+-]?)P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ISO8601 formats the duration as an ISO 8601 duration string, omitting zero components.
+// Components are expected to share a sign; a negative duration is written with a leading minus
+// sign, e.g. -P3DT4H.
+func (d DurationComponents) ISO8601() string {
+	var b strings.Builder
+	if d.isNegative() {
+		b.WriteString("-")
+		d = d.negated()
+	}
+	b.WriteString("P")
+
+	writeComponent(&b, d.Years, "Y")
+	writeComponent(&b, d.Months, "M")
+	writeComponent(&b, d.Days, "D")
+
+	if d.Hours != 0 || d.Minutes != 0 || d.Seconds != 0 {
+		b.WriteString("T")
+		writeComponent(&b, d.Hours, "H")
+		writeComponent(&b, d.Minutes, "M")
+		writeComponent(&b, d.Seconds, "S")
+	}
+
+	// A zero duration still needs at least one component
+	if b.String() == "P" {
+		return "PT0S"
+	}
+
+	return b.String()
+}
+
+// isNegative reports whether any component is negative
+func (d DurationComponents) isNegative() bool {
+	return d.Years < 0 || d.Months < 0 || d.Days < 0 || d.Hours < 0 || d.Minutes < 0 || d.Seconds < 0
+}
+
+// negated returns the duration with every component negated
+func (d DurationComponents) negated() DurationComponents {
+	return DurationComponents{
+		Years:   -d.Years,
+		Months:  -d.Months,
+		Days:    -d.Days,
+		Hours:   -d.Hours,
+		Minutes: -d.Minutes,
+		Seconds: -d.Seconds,
+	}
+}
+
+// ParseISO8601Duration parses an ISO 8601 duration string into its components.
+// Weeks are converted to days, and a leading minus sign negates every component.
+func ParseISO8601Duration(value string) (DurationComponents, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+
+	// The time designator must be followed by at least one time component
+	matches := iso8601DurationPattern.FindStringSubmatch(normalized)
+	if matches == nil || strings.HasSuffix(normalized, "T") {
+		return DurationComponents{}, parseErrorf(CodeInvalidDuration, "invalid ISO 8601 duration: %s", value)
+	}
+
+	values := make([]int, len(matches)-2)
+	found := false
+	for i, match := range matches[2:] {
+		if match == "" {
+			continue
+		}
+		n, err := strconv.Atoi(match)
+		if err != nil {
+			return DurationComponents{}, parseErrorf(CodeInvalidDuration, "invalid ISO 8601 duration %s: %w", value, err)
+		}
+		values[i] = n
+		found = true
+	}
+
+	if !found {
+		return DurationComponents{}, parseErrorf(CodeInvalidDuration, "invalid ISO 8601 duration: %s", value)
+	}
+
+	d := DurationComponents{
+		Years:   values[0],
+		Months:  values[1],
+		Days:    values[2]*7 + values[3],
+		Hours:   values[4],
+		Minutes: values[5],
+		Seconds: values[6],
+	}
+	if matches[1] == "-" {
+		d = d.negated()
+	}
+
+	return d, nil
+}
+
+// writeComponent appends a non-zero duration component with its designator
+func writeComponent(b *strings.Builder, value int, designator string) {
+	if value != 0 {
+		b.WriteString(strconv.Itoa(value))
+		b.WriteString(designator)
+	}
+}
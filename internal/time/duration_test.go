@@ -0,0 +1,72 @@
+package time
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDurationComponents_ISO8601(t *testing.T) {
+	tests := []struct {
+		duration DurationComponents
+		expected string
+	}{
+		{DurationComponents{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}, "P1Y2M3DT4H5M6S"},
+		{DurationComponents{Days: 3, Hours: 4}, "P3DT4H"},
+		{DurationComponents{Months: 1}, "P1M"},
+		{DurationComponents{Minutes: 1}, "PT1M"},
+		{DurationComponents{Seconds: 45}, "PT45S"},
+		{DurationComponents{}, "PT0S"},
+		{DurationComponents{Days: -3, Hours: -4}, "-P3DT4H"},
+		{DurationComponents{Seconds: -1}, "-PT1S"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.duration.ISO8601())
+		})
+	}
+}
+
+func TestParseISO8601Duration(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected DurationComponents
+		wantErr  bool
+	}{
+		{value: "P1Y2M3DT4H5M6S", expected: DurationComponents{Years: 1, Months: 2, Days: 3, Hours: 4, Minutes: 5, Seconds: 6}},
+		{value: "P3DT4H", expected: DurationComponents{Days: 3, Hours: 4}},
+		{value: "PT1M", expected: DurationComponents{Minutes: 1}},
+		{value: "P2W", expected: DurationComponents{Days: 14}},
+		{value: "PT0S", expected: DurationComponents{}},
+		{value: "-P3DT4H", expected: DurationComponents{Days: -3, Hours: -4}},
+		{value: "+P1M", expected: DurationComponents{Months: 1}},
+		{value: "-P", wantErr: true},
+		{value: "P-3D", wantErr: true},
+		{value: "P", wantErr: true},
+		{value: "P1DT", wantErr: true},
+		{value: "1D", wantErr: true},
+		{value: "PT1.5S", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			result, err := ParseISO8601Duration(tt.value)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+
+			// Formatting the result should parse back to the same components
+			roundTrip, err := ParseISO8601Duration(result.ISO8601())
+			require.NoError(t, err)
+			assert.Equal(t, result, roundTrip)
+		})
+	}
+}
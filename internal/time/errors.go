@@ -0,0 +1,149 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error codes carried by the typed errors of TimeService. Codes are stable identifiers clients
+// can match on, unlike the messages.
+const (
+	// CodeInvalidTimezone is an unknown or malformed timezone name
+	CodeInvalidTimezone = "invalid_timezone"
+	// CodeInvalidUTCOffset is a UTC offset timezone outside -12:00 to +14:00
+	CodeInvalidUTCOffset = "invalid_utc_offset"
+
+	// CodeInvalidTimestamp is a time or date string that could not be parsed
+	CodeInvalidTimestamp = "invalid_timestamp"
+	// CodeInvalidExpression is a relative, arithmetic or query expression that could not be parsed
+	CodeInvalidExpression = "invalid_expression"
+	// CodeInvalidDuration is a duration that could not be parsed
+	CodeInvalidDuration = "invalid_duration"
+
+	// CodeUnsupportedFormat is an output format that is neither built in nor configured
+	CodeUnsupportedFormat = "unsupported_format"
+	// CodeFormatFailed is a time a plugin failed to render
+	CodeFormatFailed = "format_failed"
+	// CodeTimestampOverflow is a time outside the range of the requested format
+	CodeTimestampOverflow = "timestamp_overflow"
+
+	// CodeMissingArgument is a required input that was not given
+	CodeMissingArgument = "missing_argument"
+	// CodeInvalidArgument is an input with a value outside its accepted range or set
+	CodeInvalidArgument = "invalid_argument"
+	// CodeConflictingArguments is a pair of inputs that cannot be used together
+	CodeConflictingArguments = "conflicting_arguments"
+	// CodeLimitExceeded is an input over one of the service's size limits
+	CodeLimitExceeded = "limit_exceeded"
+	// CodeInvertedDateRange is a range whose end comes before its start
+	CodeInvertedDateRange = "inverted_date_range"
+	// CodeIrregularSeries is a time series whose intervals are too uneven to extrapolate
+	CodeIrregularSeries = "irregular_series"
+)
+
+// TimezoneError reports a timezone that cannot be loaded
+type TimezoneError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+// ParseError reports a time, duration or expression that cannot be parsed
+type ParseError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+// FormatError reports a time that cannot be rendered in the requested format
+type FormatError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+// ValidationError reports an input that is missing, out of range or conflicts with another
+type ValidationError struct {
+	Code    string
+	Message string
+	Cause   error
+}
+
+// Error returns the message, which already describes the cause
+func (e *TimezoneError) Error() string { return e.Message }
+
+// Unwrap returns the underlying error
+func (e *TimezoneError) Unwrap() error { return e.Cause }
+
+// Is matches another *TimezoneError with the same code, or any code when the target has none,
+// so errors.Is(err, &TimezoneError{}) tells whether err is a timezone error
+func (e *TimezoneError) Is(target error) bool {
+	t, ok := target.(*TimezoneError)
+	return ok && (t.Code == "" || t.Code == e.Code)
+}
+
+// Error returns the message, which already describes the cause
+func (e *ParseError) Error() string { return e.Message }
+
+// Unwrap returns the underlying error
+func (e *ParseError) Unwrap() error { return e.Cause }
+
+// Is matches another *ParseError with the same code, or any code when the target has none
+func (e *ParseError) Is(target error) bool {
+	t, ok := target.(*ParseError)
+	return ok && (t.Code == "" || t.Code == e.Code)
+}
+
+// Error returns the message, which already describes the cause
+func (e *FormatError) Error() string { return e.Message }
+
+// Unwrap returns the underlying error
+func (e *FormatError) Unwrap() error { return e.Cause }
+
+// Is matches another *FormatError with the same code, or any code when the target has none
+func (e *FormatError) Is(target error) bool {
+	t, ok := target.(*FormatError)
+	return ok && (t.Code == "" || t.Code == e.Code)
+}
+
+// Error returns the message, which already describes the cause
+func (e *ValidationError) Error() string { return e.Message }
+
+// Unwrap returns the underlying error
+func (e *ValidationError) Unwrap() error { return e.Cause }
+
+// Is matches another *ValidationError with the same code, or any code when the target has none
+func (e *ValidationError) Is(target error) bool {
+	t, ok := target.(*ValidationError)
+	return ok && (t.Code == "" || t.Code == e.Code)
+}
+
+// errorf formats a message like fmt.Errorf and returns it with the error wrapped by %w, if any
+func errorf(format string, args ...any) (string, error) {
+	err := fmt.Errorf(format, args...)
+	return err.Error(), errors.Unwrap(err)
+}
+
+// timezoneErrorf returns a *TimezoneError formatted like fmt.Errorf
+func timezoneErrorf(code, format string, args ...any) error {
+	message, cause := errorf(format, args...)
+	return &TimezoneError{Code: code, Message: message, Cause: cause}
+}
+
+// parseErrorf returns a *ParseError formatted like fmt.Errorf
+func parseErrorf(code, format string, args ...any) error {
+	message, cause := errorf(format, args...)
+	return &ParseError{Code: code, Message: message, Cause: cause}
+}
+
+// formatErrorf returns a *FormatError formatted like fmt.Errorf
+func formatErrorf(code, format string, args ...any) error {
+	message, cause := errorf(format, args...)
+	return &FormatError{Code: code, Message: message, Cause: cause}
+}
+
+// validationErrorf returns a *ValidationError formatted like fmt.Errorf
+func validationErrorf(code, format string, args ...any) error {
+	message, cause := errorf(format, args...)
+	return &ValidationError{Code: code, Message: message, Cause: cause}
+}
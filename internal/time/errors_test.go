@@ -0,0 +1,100 @@
+package time
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTypedErrors_IsAndAs(t *testing.T) {
+	cause := errors.New("unknown time zone Mars/Olympus")
+	err := fmt.Errorf("participant 1: %w", timezoneErrorf(CodeInvalidTimezone, "invalid timezone Mars/Olympus: %w", cause))
+
+	assert.Equal(t, "participant 1: invalid timezone Mars/Olympus: unknown time zone Mars/Olympus", err.Error())
+	assert.ErrorIs(t, err, cause)
+	assert.ErrorIs(t, err, &TimezoneError{})
+	assert.ErrorIs(t, err, &TimezoneError{Code: CodeInvalidTimezone})
+	assert.NotErrorIs(t, err, &TimezoneError{Code: CodeInvalidUTCOffset})
+	assert.NotErrorIs(t, err, &ValidationError{})
+
+	var timezoneErr *TimezoneError
+	require.ErrorAs(t, err, &timezoneErr)
+	assert.Equal(t, CodeInvalidTimezone, timezoneErr.Code)
+	assert.Equal(t, cause, timezoneErr.Unwrap())
+
+	// Messages without %w have no cause
+	assert.Nil(t, errors.Unwrap(validationErrorf(CodeMissingArgument, "timezones cannot be empty")))
+}
+
+func TestTimeService_TypedErrors(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)}))
+	ctx := context.Background()
+
+	tests := []struct {
+		name     string
+		call     func() error
+		target   error
+		sentinel error
+	}{
+		{
+			name: "invalid timezone",
+			call: func() error {
+				_, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "Mars/Olympus"})
+				return err
+			},
+			target: &TimezoneError{Code: CodeInvalidTimezone},
+		},
+		{
+			name: "out of range UTC offset",
+			call: func() error {
+				_, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "+15:00"})
+				return err
+			},
+			target:   &TimezoneError{Code: CodeInvalidUTCOffset},
+			sentinel: ErrInvalidUTCOffset,
+		},
+		{
+			name: "unparseable time",
+			call: func() error {
+				_, err := service.ParseTime(ctx, ParseTimeInput{TimeString: "not a time"})
+				return err
+			},
+			target: &ParseError{Code: CodeInvalidTimestamp},
+		},
+		{
+			name: "unsupported format",
+			call: func() error {
+				_, err := service.GetCurrentTime(ctx, GetTimeInput{Format: "Klingon"})
+				return err
+			},
+			target: &FormatError{Code: CodeUnsupportedFormat},
+		},
+		{
+			name: "timestamp overflow",
+			call: func() error {
+				_, err := service.FormatTime(ctx, FormatTimeInput{Timestamp: "3000-01-01T00:00:00Z", Format: "UnixNano"})
+				return err
+			},
+			target:   &FormatError{Code: CodeTimestampOverflow},
+			sentinel: ErrTimestampOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			require.Error(t, err)
+			assert.ErrorIs(t, err, tt.target)
+			if tt.sentinel != nil {
+				assert.ErrorIs(t, err, tt.sentinel)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package time
+
+import (
+	"fmt"
+	"time"
+)
+
+// Explainer collects numbered, human-readable steps describing how a result was computed.
+// A nil Explainer discards every step, so calculations can record steps unconditionally
+// and only pay for the formatting when an explanation was requested.
+type Explainer struct {
+	steps []string
+}
+
+// newExplainer returns an Explainer when enabled, and nil otherwise
+func newExplainer(enabled bool) *Explainer {
+	if !enabled {
+		return nil
+	}
+	return &Explainer{}
+}
+
+// Step records the next step of the calculation
+func (e *Explainer) Step(format string, args ...interface{}) {
+	if e == nil {
+		return
+	}
+	e.steps = append(e.steps, fmt.Sprintf("%d. %s", len(e.steps)+1, fmt.Sprintf(format, args...)))
+}
+
+// Steps returns the recorded steps, or nil when explanations are disabled
+func (e *Explainer) Steps() []string {
+	if e == nil {
+		return nil
+	}
+	return e.steps
+}
+
+// explainZone records the UTC offset in effect at t and whether it comes from daylight saving time
+func (s *timeService) explainZone(e *Explainer, t time.Time) {
+	if e == nil {
+		return
+	}
+
+	abbreviation, offset := t.Zone()
+	if s.isDST(t, t.Location()) {
+		e.Step("Applied DST: UTC%s (%s)", formatOffset(offset), abbreviation)
+		return
+	}
+	e.Step("Applied standard time: UTC%s (%s)", formatOffset(offset), abbreviation)
+}
@@ -0,0 +1,107 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestExplainer(t *testing.T) {
+	t.Run("numbers steps", func(t *testing.T) {
+		e := newExplainer(true)
+		e.Step("Parsed %s", "x")
+		e.Step("Converted to Unix: %d", 42)
+
+		assert.Equal(t, []string{"1. Parsed x", "2. Converted to Unix: 42"}, e.Steps())
+	})
+
+	t.Run("disabled explainer ignores steps", func(t *testing.T) {
+		e := newExplainer(false)
+		e.Step("Parsed %s", "x")
+
+		assert.Nil(t, e)
+		assert.Nil(t, e.Steps())
+	})
+}
+
+func TestTimeService_Explain(t *testing.T) {
+	now := time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix", "2006-01-02"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}))
+	ctx := context.Background()
+
+	t.Run("parse_time", func(t *testing.T) {
+		result, err := service.ParseTime(ctx, ParseTimeInput{
+			TimeString: "2024-07-04",
+			Format:     "2006-01-02",
+			Timezone:   "America/New_York",
+			Explain:    true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"1. Parsed '2024-07-04' with format 2006-01-02 as 2024-07-04T00:00:00Z",
+			"2. Interpreted in America/New_York: 2024-07-04T00:00:00-04:00",
+			"3. Applied DST: UTC-04:00 (EDT)",
+			"4. Converted to Unix: 1720065600",
+		}, result.Explanation)
+	})
+
+	t.Run("get_time", func(t *testing.T) {
+		result, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "America/New_York", Explain: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"1. Read the current time: 2024-01-15T17:00:00Z",
+			"2. Converted to America/New_York: 2024-01-15T12:00:00-05:00",
+			"3. Applied standard time: UTC-05:00 (EST)",
+			"4. Formatted with RFC3339: 2024-01-15T12:00:00-05:00",
+			"5. Converted to Unix: 1705338000",
+		}, result.Explanation)
+	})
+
+	t.Run("format_time", func(t *testing.T) {
+		result, err := service.FormatTime(ctx, FormatTimeInput{OffsetFromNow: "1h", Format: "Unix", Timezone: "Asia/Tokyo", Explain: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"1. Added offset 1h0m0s to the current time 2024-01-15T17:00:00Z",
+			"2. Converted to Asia/Tokyo: 2024-01-16T03:00:00+09:00",
+			"3. Applied standard time: UTC+09:00 (JST)",
+			"4. Formatted with Unix: 1705341600",
+		}, result.Explanation)
+	})
+
+	t.Run("format_time with a Unix timestamp", func(t *testing.T) {
+		result, err := service.FormatTime(ctx, FormatTimeInput{Timestamp: "1705338000", Format: "2006-01-02", Explain: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"1. Interpreted timestamp 1705338000 as 2024-01-15T17:00:00Z",
+			"2. Converted to UTC: 2024-01-15T17:00:00Z",
+			"3. Applied standard time: UTC+00:00 (UTC)",
+			"4. Formatted with 2006-01-02: 2024-01-15",
+		}, result.Explanation)
+	})
+
+	t.Run("time_zone_offset_at", func(t *testing.T) {
+		result, err := service.GetTimezoneOffsetAt(ctx, TimezoneOffsetAtInput{Timezone: "Europe/London", At: "2024-07-01 12:00", Explain: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{
+			"1. Parsed '2024-07-01 12:00' in Europe/London as 2024-07-01T12:00:00+01:00",
+			"2. Applied DST: UTC+01:00 (BST)",
+			"3. Offset from UTC: 3600 seconds",
+		}, result.Explanation)
+	})
+
+	t.Run("omitted unless requested", func(t *testing.T) {
+		result, err := service.GetCurrentTime(ctx, GetTimeInput{})
+		require.NoError(t, err)
+		assert.Nil(t, result.Explanation)
+	})
+}
@@ -0,0 +1,447 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.uber.org/zap"
+)
+
+// EvaluateTimeExpression computes the time described by an arithmetic expression such as
+// "now + 2 weeks", "2024-03-15 - 10 days" or "3 days before next Tuesday"
+func (s *timeService) EvaluateTimeExpression(ctx context.Context, input TimeArithmeticInput) (TimeArithmeticResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeArithmeticResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	s.logger.Debug("Evaluating time expression",
+		zap.String("expression", input.Expression),
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeArithmeticResult{}, err
+	}
+
+	tokens, err := tokenizeExpression(input.Expression, loc)
+	if err != nil {
+		return TimeArithmeticResult{}, err
+	}
+
+	p := &exprParser{tokens: tokens, now: s.clock.Now().In(loc), loc: loc}
+	value, err := p.parse()
+	if err != nil {
+		return TimeArithmeticResult{}, parseErrorf(CodeInvalidExpression, "invalid expression %q: %w", input.Expression, err)
+	}
+
+	return TimeArithmeticResult{
+		ResultTime:       value.t.Format(time.RFC3339),
+		UnixTimestamp:    value.t.Unix(),
+		ParsedExpression: value.normalized,
+		Timezone:         loc.String(),
+		Warning:          warning,
+	}, nil
+}
+
+// Grammar:
+//
+//	expression := duration ("before" | "after") expression
+//	            | base (("+" | "-") duration)*
+//	base       := "now" | "today" | "tomorrow" | "yesterday"
+//	            | ["next" | "last" | "this"] weekday
+//	            | date [time-of-day]
+//	duration   := number unit
+
+type exprTokenKind int
+
+const (
+	tokenWord exprTokenKind = iota
+	tokenNumber
+	tokenOperator
+	tokenDate
+	tokenTimeOfDay
+)
+
+type exprToken struct {
+	kind   exprTokenKind
+	text   string
+	number int
+	t      time.Time
+}
+
+// numberWords lets durations be written out, e.g. "three days"
+var numberWords = map[string]int{
+	"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+	"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+}
+
+// exprUnits maps unit spellings to their canonical singular name
+var exprUnits = map[string]string{
+	"s": "second", "sec": "second", "secs": "second", "second": "second", "seconds": "second",
+	"m": "minute", "min": "minute", "mins": "minute", "minute": "minute", "minutes": "minute",
+	"h": "hour", "hr": "hour", "hrs": "hour", "hour": "hour", "hours": "hour",
+	"d": "day", "day": "day", "days": "day",
+	"w": "week", "wk": "week", "wks": "week", "week": "week", "weeks": "week",
+	"mo": "month", "month": "month", "months": "month",
+	"y": "year", "yr": "year", "yrs": "year", "year": "year", "years": "year",
+}
+
+// tokenizeExpression splits an expression on whitespace and classifies each chunk.
+// Operators may be attached to the following chunk ("+2h") and units to numbers ("10days").
+func tokenizeExpression(expression string, loc *time.Location) ([]exprToken, error) {
+	var tokens []exprToken
+
+	for _, chunk := range strings.Fields(expression) {
+		if chunk == "+" || chunk == "-" {
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: chunk})
+			continue
+		}
+
+		if t, err := parseFlexibleTime(chunk, loc); err == nil && !isAllDigits(chunk) {
+			tokens = append(tokens, exprToken{kind: tokenDate, text: chunk, t: t})
+			continue
+		}
+
+		if t, err := time.Parse("15:04:05", chunk); err == nil {
+			tokens = append(tokens, exprToken{kind: tokenTimeOfDay, text: chunk, t: t})
+			continue
+		}
+		if t, err := time.Parse("15:04", chunk); err == nil {
+			tokens = append(tokens, exprToken{kind: tokenTimeOfDay, text: chunk, t: t})
+			continue
+		}
+
+		if chunk[0] == '+' || chunk[0] == '-' {
+			tokens = append(tokens, exprToken{kind: tokenOperator, text: chunk[:1]})
+			chunk = chunk[1:]
+		}
+
+		// Split a number from an attached unit, e.g. "10days"
+		digits := strings.IndexFunc(chunk, func(r rune) bool { return !unicode.IsDigit(r) })
+		switch {
+		case digits == -1:
+			n, err := strconv.Atoi(chunk)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %s", chunk)
+			}
+			tokens = append(tokens, exprToken{kind: tokenNumber, text: chunk, number: n})
+		case digits > 0:
+			n, err := strconv.Atoi(chunk[:digits])
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %s", chunk[:digits])
+			}
+			tokens = append(tokens,
+				exprToken{kind: tokenNumber, text: chunk[:digits], number: n},
+				exprToken{kind: tokenWord, text: strings.ToLower(chunk[digits:])})
+		default:
+			tokens = append(tokens, exprToken{kind: tokenWord, text: strings.ToLower(chunk)})
+		}
+	}
+
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("expression cannot be empty")
+	}
+
+	return tokens, nil
+}
+
+// maxExprAmount bounds the amount of a duration so clock units cannot overflow time.Duration
+const maxExprAmount = 100000
+
+// exprValue is an evaluated (sub)expression and its normalized description
+type exprValue struct {
+	t          time.Time
+	normalized string
+}
+
+// exprDuration is a parsed calendar or clock duration
+type exprDuration struct {
+	amount int
+	unit   string
+}
+
+func (d exprDuration) String() string {
+	if d.amount == 1 {
+		return "1 " + d.unit
+	}
+	return fmt.Sprintf("%d %ss", d.amount, d.unit)
+}
+
+// addTo adds the duration (negated when sign is -1) to t. Calendar units keep the wall clock time
+// across DST changes; clock units are exact. Results outside years 1-9999 are rejected.
+func (d exprDuration) addTo(t time.Time, sign int) (time.Time, error) {
+	n := d.amount * sign
+
+	var result time.Time
+	switch d.unit {
+	case "second":
+		result = t.Add(time.Duration(n) * time.Second)
+	case "minute":
+		result = t.Add(time.Duration(n) * time.Minute)
+	case "hour":
+		result = t.Add(time.Duration(n) * time.Hour)
+	case "day":
+		result = t.AddDate(0, 0, n)
+	case "week":
+		result = t.AddDate(0, 0, 7*n)
+	case "month":
+		result = t.AddDate(0, n, 0)
+	default:
+		result = t.AddDate(n, 0, 0)
+	}
+
+	if result.Year() < 1 || result.Year() > 9999 {
+		return time.Time{}, fmt.Errorf("result year %d is outside 1-9999", result.Year())
+	}
+	return result, nil
+}
+
+// exprParser is a recursive-descent parser over the token stream
+type exprParser struct {
+	tokens []exprToken
+	pos    int
+	now    time.Time
+	loc    *time.Location
+}
+
+func (p *exprParser) parse() (exprValue, error) {
+	value, err := p.parseExpression()
+	if err != nil {
+		return exprValue{}, err
+	}
+
+	if tok, ok := p.peek(); ok {
+		return exprValue{}, fmt.Errorf("unexpected %q", tok.text)
+	}
+
+	return value, nil
+}
+
+func (p *exprParser) parseExpression() (exprValue, error) {
+	// duration ("before" | "after") expression
+	if p.startsDuration() {
+		duration, err := p.parseDuration()
+		if err != nil {
+			return exprValue{}, err
+		}
+
+		tok, ok := p.next()
+		if !ok || tok.kind != tokenWord || (tok.text != "before" && tok.text != "after") {
+			return exprValue{}, fmt.Errorf("expected 'before' or 'after' after %s", duration)
+		}
+
+		anchor, err := p.parseExpression()
+		if err != nil {
+			return exprValue{}, err
+		}
+
+		sign, operator := 1, "+"
+		if tok.text == "before" {
+			sign, operator = -1, "-"
+		}
+
+		t, err := duration.addTo(anchor.t, sign)
+		if err != nil {
+			return exprValue{}, err
+		}
+
+		return exprValue{
+			t:          t,
+			normalized: fmt.Sprintf("%s %s %s", anchor.normalized, operator, duration),
+		}, nil
+	}
+
+	value, err := p.parseBase()
+	if err != nil {
+		return exprValue{}, err
+	}
+
+	// base (("+" | "-") duration)*
+	for {
+		tok, ok := p.peek()
+		if !ok || tok.kind != tokenOperator {
+			return value, nil
+		}
+		p.pos++
+
+		duration, err := p.parseDuration()
+		if err != nil {
+			return exprValue{}, err
+		}
+
+		sign := 1
+		if tok.text == "-" {
+			sign = -1
+		}
+
+		t, err := duration.addTo(value.t, sign)
+		if err != nil {
+			return exprValue{}, err
+		}
+
+		value = exprValue{
+			t:          t,
+			normalized: fmt.Sprintf("%s %s %s", value.normalized, tok.text, duration),
+		}
+	}
+}
+
+func (p *exprParser) parseBase() (exprValue, error) {
+	tok, ok := p.next()
+	if !ok {
+		return exprValue{}, fmt.Errorf("expected a time")
+	}
+
+	midnight := time.Date(p.now.Year(), p.now.Month(), p.now.Day(), 0, 0, 0, 0, p.loc)
+
+	if tok.kind == tokenDate {
+		t := tok.t
+		// A date may be followed by a time of day
+		if next, ok := p.peek(); ok && next.kind == tokenTimeOfDay {
+			p.pos++
+			t = time.Date(t.Year(), t.Month(), t.Day(), next.t.Hour(), next.t.Minute(), next.t.Second(), 0, p.loc)
+		}
+		return p.resolved(t.Format(time.RFC3339), t), nil
+	}
+
+	if tok.kind != tokenWord {
+		return exprValue{}, fmt.Errorf("expected a time, got %q", tok.text)
+	}
+
+	switch tok.text {
+	case "now":
+		return p.resolved("now", p.now), nil
+	case "today":
+		return p.resolved("today", midnight), nil
+	case "tomorrow":
+		return p.resolved("tomorrow", midnight.AddDate(0, 0, 1)), nil
+	case "yesterday":
+		return p.resolved("yesterday", midnight.AddDate(0, 0, -1)), nil
+	case "next", "last", "this":
+		dayTok, ok := p.next()
+		if !ok {
+			return exprValue{}, fmt.Errorf("expected a weekday after %q", tok.text)
+		}
+		day, ok := parseWeekday(dayTok.text)
+		if !ok {
+			return exprValue{}, fmt.Errorf("expected a weekday after %q, got %q", tok.text, dayTok.text)
+		}
+		return p.resolved(tok.text+" "+day.String(), relativeWeekday(midnight, day, tok.text)), nil
+	}
+
+	if day, ok := parseWeekday(tok.text); ok {
+		return p.resolved(day.String(), relativeWeekday(midnight, day, "this")), nil
+	}
+
+	return exprValue{}, fmt.Errorf("unknown time %q", tok.text)
+}
+
+// resolved describes a base time together with the moment it resolved to
+func (p *exprParser) resolved(description string, t time.Time) exprValue {
+	if description == t.Format(time.RFC3339) {
+		return exprValue{t: t, normalized: description}
+	}
+	return exprValue{t: t, normalized: fmt.Sprintf("%s (%s)", description, t.Format(time.RFC3339))}
+}
+
+func (p *exprParser) parseDuration() (exprDuration, error) {
+	tok, ok := p.next()
+	if !ok {
+		return exprDuration{}, fmt.Errorf("expected a duration")
+	}
+
+	amount := tok.number
+	if tok.kind == tokenWord {
+		n, ok := numberWords[tok.text]
+		if !ok {
+			return exprDuration{}, fmt.Errorf("expected a number, got %q", tok.text)
+		}
+		amount = n
+	} else if tok.kind != tokenNumber {
+		return exprDuration{}, fmt.Errorf("expected a number, got %q", tok.text)
+	}
+	if amount > maxExprAmount {
+		return exprDuration{}, fmt.Errorf("amount %d is too large (max %d)", amount, maxExprAmount)
+	}
+
+	unitTok, ok := p.next()
+	if !ok {
+		return exprDuration{}, fmt.Errorf("expected a unit after %s", tok.text)
+	}
+
+	unit, ok := exprUnits[unitTok.text]
+	if !ok {
+		return exprDuration{}, fmt.Errorf("unknown unit %q", unitTok.text)
+	}
+
+	return exprDuration{amount: amount, unit: unit}, nil
+}
+
+// startsDuration reports whether the next tokens form a number followed by a unit
+func (p *exprParser) startsDuration() bool {
+	tok, ok := p.peek()
+	if !ok {
+		return false
+	}
+
+	if _, isWord := numberWords[tok.text]; tok.kind != tokenNumber && !(tok.kind == tokenWord && isWord) {
+		return false
+	}
+
+	if p.pos+1 >= len(p.tokens) {
+		return false
+	}
+	_, isUnit := exprUnits[p.tokens[p.pos+1].text]
+	return isUnit
+}
+
+func (p *exprParser) peek() (exprToken, bool) {
+	if p.pos >= len(p.tokens) {
+		return exprToken{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *exprParser) next() (exprToken, bool) {
+	tok, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return tok, ok
+}
+
+// relativeWeekday finds a weekday relative to the given midnight: "next" is strictly after it,
+// "last" strictly before it and "this" is the upcoming occurrence including today
+func relativeWeekday(midnight time.Time, day time.Weekday, relation string) time.Time {
+	diff := int(day) - int(midnight.Weekday())
+
+	switch relation {
+	case "next":
+		if diff <= 0 {
+			diff += 7
+		}
+	case "last":
+		if diff >= 0 {
+			diff -= 7
+		}
+	default:
+		if diff < 0 {
+			diff += 7
+		}
+	}
+
+	return midnight.AddDate(0, 0, diff)
+}
+
+func isAllDigits(value string) bool {
+	return strings.IndexFunc(value, func(r rune) bool { return !unicode.IsDigit(r) }) == -1
+}
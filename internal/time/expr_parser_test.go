@@ -0,0 +1,117 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_EvaluateTimeExpression(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	now := time.Date(2024, time.March, 13, 10, 0, 0, 0, time.UTC) // a Wednesday
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: now}))
+
+	tests := []struct {
+		name               string
+		input              TimeArithmeticInput
+		wantErr            bool
+		expectedTime       string
+		expectedNormalized string
+	}{
+		{
+			name:               "now plus weeks",
+			input:              TimeArithmeticInput{Expression: "now + 2 weeks"},
+			expectedTime:       "2024-03-27T10:00:00Z",
+			expectedNormalized: "now (2024-03-13T10:00:00Z) + 2 weeks",
+		},
+		{
+			name:               "date minus days",
+			input:              TimeArithmeticInput{Expression: "2024-03-15 - 10 days"},
+			expectedTime:       "2024-03-05T00:00:00Z",
+			expectedNormalized: "2024-03-15T00:00:00Z - 10 days",
+		},
+		{
+			name:               "next weekday plus hours",
+			input:              TimeArithmeticInput{Expression: "next Monday + 8 hours"},
+			expectedTime:       "2024-03-18T08:00:00Z",
+			expectedNormalized: "next Monday (2024-03-18T00:00:00Z) + 8 hours",
+		},
+		{
+			name:               "duration before expression",
+			input:              TimeArithmeticInput{Expression: "three days before next Tuesday"},
+			expectedTime:       "2024-03-16T00:00:00Z",
+			expectedNormalized: "next Tuesday (2024-03-19T00:00:00Z) - 3 days",
+		},
+		{
+			name:               "duration after expression",
+			input:              TimeArithmeticInput{Expression: "1 day after tomorrow"},
+			expectedTime:       "2024-03-15T00:00:00Z",
+			expectedNormalized: "tomorrow (2024-03-14T00:00:00Z) + 1 day",
+		},
+		{
+			name:               "attached operator and unit",
+			input:              TimeArithmeticInput{Expression: "tomorrow +90m -1h"},
+			expectedTime:       "2024-03-14T00:30:00Z",
+			expectedNormalized: "tomorrow (2024-03-14T00:00:00Z) + 90 minutes - 1 hour",
+		},
+		{
+			name:               "date and time in timezone",
+			input:              TimeArithmeticInput{Expression: "2024-03-05 09:30 + 1 month", Timezone: "America/New_York"},
+			expectedTime:       "2024-04-05T09:30:00-04:00",
+			expectedNormalized: "2024-03-05T09:30:00-05:00 + 1 month",
+		},
+		{
+			name:               "last weekday",
+			input:              TimeArithmeticInput{Expression: "last Wednesday"},
+			expectedTime:       "2024-03-06T00:00:00Z",
+			expectedNormalized: "last Wednesday (2024-03-06T00:00:00Z)",
+		},
+		{
+			name:               "bare weekday includes today",
+			input:              TimeArithmeticInput{Expression: "wed"},
+			expectedTime:       "2024-03-13T00:00:00Z",
+			expectedNormalized: "Wednesday (2024-03-13T00:00:00Z)",
+		},
+		{name: "empty", input: TimeArithmeticInput{Expression: "  "}, wantErr: true},
+		{name: "dangling operator", input: TimeArithmeticInput{Expression: "now +"}, wantErr: true},
+		{name: "unknown unit", input: TimeArithmeticInput{Expression: "now + 2 fortnights"}, wantErr: true},
+		{name: "unknown base", input: TimeArithmeticInput{Expression: "soon"}, wantErr: true},
+		{name: "trailing tokens", input: TimeArithmeticInput{Expression: "now now"}, wantErr: true},
+		{name: "missing before or after", input: TimeArithmeticInput{Expression: "3 days next Monday"}, wantErr: true},
+		{name: "invalid timezone", input: TimeArithmeticInput{Expression: "now", Timezone: "Invalid/Timezone"}, wantErr: true},
+		{name: "hours beyond a duration", input: TimeArithmeticInput{Expression: "now + 9999999999999 hours"}, wantErr: true},
+		{name: "max int seconds", input: TimeArithmeticInput{Expression: "now + 9223372036854775807 seconds"}, wantErr: true},
+		{name: "years beyond the cap", input: TimeArithmeticInput{Expression: "now + 99999999999 years"}, wantErr: true},
+		{name: "result after year 9999", input: TimeArithmeticInput{Expression: "now + 8000 years"}, wantErr: true},
+		{name: "result before year 1", input: TimeArithmeticInput{Expression: "3000 years before today"}, wantErr: true},
+		{
+			name:               "largest amount",
+			input:              TimeArithmeticInput{Expression: "now + 100000 hours"},
+			expectedTime:       "2035-08-10T02:00:00Z",
+			expectedNormalized: "now (2024-03-13T10:00:00Z) + 100000 hours",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.EvaluateTimeExpression(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTime, result.ResultTime)
+			assert.Equal(t, tt.expectedNormalized, result.ParsedExpression)
+
+			expected, err := time.Parse(time.RFC3339, tt.expectedTime)
+			require.NoError(t, err)
+			assert.Equal(t, expected.Unix(), result.UnixTimestamp)
+		})
+	}
+}
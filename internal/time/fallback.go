@@ -0,0 +1,60 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// WithTimezoneFallback makes the service use fallback instead of failing when a requested
+// timezone cannot be loaded. Results then carry a warning naming both timezones.
+// An empty fallback keeps invalid timezones an error.
+func WithTimezoneFallback(fallback string) Option {
+	return func(s *timeService) {
+		s.fallbackTimezone = fallback
+	}
+}
+
+// loadLocation loads a requested timezone, expanding abbreviations such as "EST". When it is
+// invalid and a fallback timezone is configured, the fallback location is returned together with
+// a warning for the caller's result. Out-of-range UTC offsets are always an error.
+func (s *timeService) loadLocation(timezone string) (*time.Location, string, error) {
+	timezone = s.expandTimezoneAlias(timezone)
+
+	loc, err := loadZone(timezone)
+	if err == nil {
+		return loc, "", nil
+	}
+	if errors.Is(err, ErrInvalidUTCOffset) {
+		return nil, "", err
+	}
+	if s.fallbackTimezone == "" {
+		return nil, "", timezoneErrorf(CodeInvalidTimezone, "invalid timezone %s: %w", timezone, err)
+	}
+
+	fallback, fallbackErr := time.LoadLocation(s.fallbackTimezone)
+	if fallbackErr != nil {
+		return nil, "", timezoneErrorf(CodeInvalidTimezone, "invalid timezone %s: %w", timezone, err)
+	}
+
+	s.logger.Warn("Invalid timezone, using fallback timezone",
+		zap.String("timezone", timezone),
+		zap.String("fallback_timezone", s.fallbackTimezone),
+		zap.Error(err))
+
+	return fallback, fmt.Sprintf("invalid timezone %q: used fallback timezone %s", timezone, s.fallbackTimezone), nil
+}
+
+// joinWarnings combines the non-empty warnings of a result that loaded several timezones
+func joinWarnings(warnings ...string) string {
+	nonEmpty := make([]string, 0, len(warnings))
+	for _, warning := range warnings {
+		if warning != "" {
+			nonEmpty = append(nonEmpty, warning)
+		}
+	}
+	return strings.Join(nonEmpty, "; ")
+}
@@ -0,0 +1,53 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTimeService_TimezoneFallback(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	core, logs := observer.New(zapcore.WarnLevel)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zap.New(core),
+		WithClock(FixedClock{Time: now}), WithTimezoneFallback("Europe/London"))
+	ctx := context.Background()
+
+	result, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "Europe/Londno"})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/London", result.Timezone)
+	assert.Equal(t, "2024-03-15T14:30:00Z", result.FormattedTime)
+	assert.Equal(t, `invalid timezone "Europe/Londno": used fallback timezone Europe/London`, result.Warning)
+
+	entries := logs.FilterMessage("Invalid timezone, using fallback timezone").All()
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Europe/Londno", entries[0].ContextMap()["timezone"])
+
+	valid, err := service.GetCurrentTime(ctx, GetTimeInput{Timezone: "Asia/Tokyo"})
+	require.NoError(t, err)
+	assert.Empty(t, valid.Warning)
+
+	info, err := service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "Nowhere/City"})
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/London", info.Name)
+	assert.NotEmpty(t, info.Warning)
+
+	overlap, err := service.FindWorkingHoursOverlap(ctx, TimeOverlapInput{TimezoneA: "Bad/A", TimezoneB: "Bad/B", ReferenceDate: "2024-03-15"})
+	require.NoError(t, err)
+	assert.Equal(t, `invalid timezone "Bad/A": used fallback timezone Europe/London; invalid timezone "Bad/B": used fallback timezone Europe/London`, overlap.Warning)
+}
+
+func TestTimeService_TimezoneFallback_Disabled(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	_, err := service.FormatTime(context.Background(), FormatTimeInput{Timestamp: "1710513000", Timezone: "Europe/Londno"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid timezone Europe/Londno")
+}
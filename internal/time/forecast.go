@@ -0,0 +1,121 @@
+package time
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Time series forecast limits
+const (
+	minForecastTimestamps = 3
+	maxForecastCount      = 20
+
+	// forecastTolerance is the largest relative deviation of an interval from the average interval
+	// for a series to still count as regular
+	forecastTolerance = 0.05
+)
+
+// ForecastTimeSeries projects the next timestamps of an evenly spaced series from its average
+// interval. Confidence falls linearly from 1 for a perfectly regular series to 0 for one whose
+// most deviant interval is at the tolerance; series beyond it are rejected.
+func (s *timeService) ForecastTimeSeries(ctx context.Context, input TimeSeriesForecastInput) (TimeSeriesForecastResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeSeriesForecastResult{}, err
+	}
+
+	if len(input.Timestamps) < minForecastTimestamps {
+		return TimeSeriesForecastResult{}, validationErrorf(CodeInvalidArgument, "at least %d timestamps are required, got %d", minForecastTimestamps, len(input.Timestamps))
+	}
+	if len(input.Timestamps) > maxStatsTimestamps {
+		return TimeSeriesForecastResult{}, validationErrorf(CodeLimitExceeded, "too many timestamps: %d (max %d)", len(input.Timestamps), maxStatsTimestamps)
+	}
+
+	count := input.Count
+	if count == 0 {
+		count = 1
+	}
+	if count < 1 || count > maxForecastCount {
+		return TimeSeriesForecastResult{}, validationErrorf(CodeInvalidArgument, "count must be between 1 and %d, got %d", maxForecastCount, input.Count)
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeSeriesForecastResult{}, err
+	}
+
+	format := input.Format
+	if format == "" {
+		format = s.defaultFormat
+	}
+	if !s.IsFormatSupported(format) {
+		return TimeSeriesForecastResult{}, formatErrorf(CodeUnsupportedFormat, "unsupported format: %s (supported: %v)", format, s.supportedFormats)
+	}
+
+	times := make([]time.Time, 0, len(input.Timestamps))
+	for i, value := range input.Timestamps {
+		if err := ctx.Err(); err != nil {
+			return TimeSeriesForecastResult{}, err
+		}
+
+		t, err := parseFlexibleTime(value, loc)
+		if err != nil {
+			return TimeSeriesForecastResult{}, parseErrorf(CodeInvalidTimestamp, "invalid timestamp at index %d: %w", i, err)
+		}
+		if i > 0 && !t.After(times[i-1]) {
+			return TimeSeriesForecastResult{}, validationErrorf(CodeInvalidArgument, "timestamps must be sorted ascending without duplicates: index %d is not after index %d", i, i-1)
+		}
+		times = append(times, t)
+	}
+
+	last := times[len(times)-1]
+	// Intervals are measured in float seconds, as series may span more than a time.Duration
+	interval := secondsBetween(times[0], last) / float64(len(times)-1)
+
+	var maxDeviation float64
+	for i := 1; i < len(times); i++ {
+		deviation := math.Abs(secondsBetween(times[i-1], times[i])-interval) / interval
+		maxDeviation = math.Max(maxDeviation, deviation)
+	}
+	if maxDeviation > forecastTolerance {
+		return TimeSeriesForecastResult{}, validationErrorf(CodeIrregularSeries, "irregular series: an interval deviates %.1f%% from the average of %gs (tolerance %.0f%%)",
+			maxDeviation*100, interval, forecastTolerance*100)
+	}
+
+	forecasted := make([]string, count)
+	for i := range forecasted {
+		next := addSeconds(last, interval*float64(i+1))
+		if next.Year() < 1 || next.Year() > 9999 {
+			return TimeSeriesForecastResult{}, validationErrorf(CodeInvalidArgument, "forecast %d falls in year %d, outside 1-9999", i+1, next.Year())
+		}
+
+		formatted, err := s.formatTimeInternal(next, format)
+		if err != nil {
+			return TimeSeriesForecastResult{}, err
+		}
+		forecasted[i] = formatted
+	}
+
+	result := TimeSeriesForecastResult{
+		IntervalSeconds: interval,
+		Confidence:      1 - maxDeviation/forecastTolerance,
+		Forecasted:      forecasted,
+		Timezone:        loc.String(),
+		Format:          format,
+		Warning:         warning,
+	}
+
+	s.logger.Debug("Forecast time series",
+		zap.Int("timestamps", len(times)),
+		zap.Int("count", count),
+		zap.Float64("interval_seconds", result.IntervalSeconds),
+		zap.Float64("confidence", result.Confidence))
+
+	return result, nil
+}
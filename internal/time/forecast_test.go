@@ -0,0 +1,134 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_ForecastTimeSeries(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name               string
+		input              TimeSeriesForecastInput
+		expectedInterval   float64
+		expectedConfidence float64
+		expectedForecast   []string
+		expectedErr        error
+	}{
+		{
+			name: "perfectly regular",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T01:00:00Z", "2024-03-01T02:00:00Z"},
+				Count:      2,
+			},
+			expectedInterval:   3600,
+			expectedConfidence: 1,
+			expectedForecast:   []string{"2024-03-01T03:00:00Z", "2024-03-01T04:00:00Z"},
+		},
+		{
+			name: "slightly irregular",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T00:01:39Z", "2024-03-01T00:03:20Z"},
+			},
+			expectedInterval:   100,
+			expectedConfidence: 0.8,
+			expectedForecast:   []string{"2024-03-01T00:05:00Z"},
+		},
+		{
+			name: "timezone and format",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01 09:00", "2024-03-02 09:00", "2024-03-03 09:00"},
+				Timezone:   "Europe/Paris",
+				Format:     "Unix",
+			},
+			expectedInterval:   86400,
+			expectedConfidence: 1,
+			expectedForecast:   []string{"1709539200"},
+		},
+		{
+			name: "span longer than a time.Duration",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"1000-01-01T00:00:00Z", "1400-01-01T00:00:00Z", "1800-01-01T00:00:00Z"},
+			},
+			expectedInterval:   146097 * 86400,
+			expectedConfidence: 1,
+			expectedForecast:   []string{"2200-01-01T00:00:00Z"},
+		},
+		{
+			name: "forecast past year 9999",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"9000-01-01T00:00:00Z", "9400-01-01T00:00:00Z", "9800-01-01T00:00:00Z"},
+			},
+			expectedErr: &ValidationError{Code: CodeInvalidArgument},
+		},
+		{
+			name:        "too few timestamps",
+			input:       TimeSeriesForecastInput{Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T01:00:00Z"}},
+			expectedErr: &ValidationError{Code: CodeInvalidArgument},
+		},
+		{
+			name: "irregular",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T01:00:00Z", "2024-03-01T03:00:00Z"},
+			},
+			expectedErr: &ValidationError{Code: CodeIrregularSeries},
+		},
+		{
+			name: "unsorted",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T02:00:00Z", "2024-03-01T01:00:00Z", "2024-03-01T00:00:00Z"},
+			},
+			expectedErr: &ValidationError{Code: CodeInvalidArgument},
+		},
+		{
+			name: "duplicates",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T00:00:00Z", "2024-03-01T00:00:00Z"},
+			},
+			expectedErr: &ValidationError{Code: CodeInvalidArgument},
+		},
+		{
+			name: "count too large",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T01:00:00Z", "2024-03-01T02:00:00Z"},
+				Count:      21,
+			},
+			expectedErr: &ValidationError{Code: CodeInvalidArgument},
+		},
+		{
+			name: "invalid timestamp",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "soon", "2024-03-01T02:00:00Z"},
+			},
+			expectedErr: &ParseError{Code: CodeInvalidTimestamp},
+		},
+		{
+			name: "unsupported format",
+			input: TimeSeriesForecastInput{
+				Timestamps: []string{"2024-03-01T00:00:00Z", "2024-03-01T01:00:00Z", "2024-03-01T02:00:00Z"},
+				Format:     "Klingon",
+			},
+			expectedErr: &FormatError{Code: CodeUnsupportedFormat},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.ForecastTimeSeries(context.Background(), tt.input)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expectedInterval, result.IntervalSeconds, 1e-9)
+			assert.InDelta(t, tt.expectedConfidence, result.Confidence, 1e-9)
+			assert.Equal(t, tt.expectedForecast, result.Forecasted)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package time
+
+import (
+	"context"
+	"time"
+)
+
+// customLayoutExample is the Go reference layout used to illustrate the Layout format
+const customLayoutExample = "2006-01-02 15:04:05"
+
+// formatDescriptions documents the built-in format types
+var formatDescriptions = map[FormatType]string{
+	FormatRFC3339:     "RFC 3339 date and time with timezone offset, second precision",
+	FormatRFC3339Nano: "RFC 3339 date and time with timezone offset and nanosecond precision",
+	FormatUnix:        "Seconds since the Unix epoch",
+	FormatUnixMilli:   "Milliseconds since the Unix epoch",
+	FormatUnixMicro:   "Microseconds since the Unix epoch",
+	FormatUnixNano:    "Nanoseconds since the Unix epoch",
+	FormatLayout:      "Custom Go reference layout configured in time.supported_formats; the example uses " + customLayoutExample,
+	FormatTai64N:      "External TAI64N label used by daemontools and qmail: '@', TAI seconds and nanoseconds in hex",
+}
+
+// inputOnlyFormats lists formats that can be parsed but not produced. Every built-in format
+// currently round-trips, so the set is empty.
+var inputOnlyFormats = map[FormatType]bool{}
+
+// ListFormats describes every supported format with an example of the current UTC time
+func (s *timeService) ListFormats(ctx context.Context) ListFormatsResult {
+	now := s.clock.Now().UTC()
+
+	names := s.GetSupportedFormats()
+	formats := make([]FormatDescription, 0, len(names))
+	for _, name := range names {
+		formats = append(formats, s.describeFormat(name, now))
+	}
+
+	return ListFormatsResult{Formats: formats}
+}
+
+// describeFormat builds the description of a single format; unknown names are treated as Go layouts
+func (s *timeService) describeFormat(name string, now time.Time) FormatDescription {
+	format := FormatType(name)
+
+	description, ok := formatDescriptions[format]
+	if p, isPlugin := s.pluginFor(name); isPlugin {
+		description = "Provided by the " + p.Name() + " plugin"
+	} else if !ok {
+		description = "Custom Go time layout"
+	}
+
+	example := now.Format(customLayoutExample)
+	if format != FormatLayout {
+		example, _ = s.formatTimeInternal(now, name)
+	}
+
+	return FormatDescription{
+		Name:        name,
+		Description: description,
+		Example:     example,
+		IsInputOnly: inputOnlyFormats[format],
+	}
+}
@@ -0,0 +1,37 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_ListFormats(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Date(2024, time.March, 1, 12, 30, 0, 0, time.UTC)
+	formats := []string{"RFC3339", "Unix", "UnixMilli", "Layout", "Jan 2 15:04"}
+	service := NewTimeService("UTC", "RFC3339", formats, logger, WithClock(FixedClock{Time: fixed}))
+
+	result := service.ListFormats(context.Background())
+
+	expected := map[string]string{
+		"RFC3339":     "2024-03-01T12:30:00Z",
+		"Unix":        "1709296200",
+		"UnixMilli":   "1709296200000",
+		"Layout":      "2024-03-01 12:30:00",
+		"Jan 2 15:04": "Mar 1 12:30",
+	}
+
+	assert.Len(t, result.Formats, len(formats))
+	for i, format := range result.Formats {
+		assert.Equal(t, formats[i], format.Name)
+		assert.Equal(t, expected[format.Name], format.Example, format.Name)
+		assert.NotEmpty(t, format.Description)
+		assert.False(t, format.IsInputOnly)
+	}
+
+	assert.Equal(t, "Custom Go time layout", result.Formats[4].Description)
+}
@@ -0,0 +1,138 @@
+package time
+
+import (
+	_ "embed"
+	"strings"
+)
+
+// countryTab is the IANA iso3166.tab file (country.tab) naming every country code used in zone.tab
+//
+//go:embed data/iso3166.tab
+var countryTab string
+
+var countryNames = parseCountryTab(countryTab)
+
+// areaRegions maps the area prefix of a zone name to a continental region
+var areaRegions = map[string]string{
+	"Africa":     "Africa",
+	"America":    "North America",
+	"Antarctica": "Antarctica",
+	"Asia":       "Asia",
+	"Australia":  "Oceania",
+	"Europe":     "Europe",
+	"Pacific":    "Oceania",
+}
+
+// countryRegionOverrides sets the region of countries whose principal zone is in an ocean area
+// (Atlantic, Indian, Arctic) or in South America, which shares the America area with North America
+var countryRegionOverrides = map[string]string{
+	"AR": "South America", "BM": "North America", "BO": "South America", "BR": "South America",
+	"CC": "Oceania", "CL": "South America", "CO": "South America", "CV": "Africa", "CX": "Oceania",
+	"EC": "South America", "FK": "South America", "FO": "Europe", "GF": "South America",
+	"GS": "South America", "GY": "South America", "IO": "Africa", "IS": "Europe", "KM": "Africa",
+	"MG": "Africa", "MU": "Africa", "MV": "Asia", "PE": "South America", "PY": "South America",
+	"RE": "Africa", "SC": "Africa", "SH": "Africa", "SJ": "Europe", "SR": "South America",
+	"TF": "Africa", "UY": "South America", "VE": "South America", "YT": "Africa",
+}
+
+// countryCapitalZones are the zones named after the capital of the country or territory they
+// belong to. Unlike capitalZones, which only lists major capitals, it covers every zone in zone.tab.
+var countryCapitalZones = map[string]bool{
+	"Africa/Accra": true, "Africa/Addis_Ababa": true, "Africa/Algiers": true, "Africa/Asmara": true,
+	"Africa/Bamako": true, "Africa/Bangui": true, "Africa/Banjul": true, "Africa/Bissau": true,
+	"Africa/Brazzaville": true, "Africa/Cairo": true, "Africa/Conakry": true, "Africa/Dakar": true,
+	"Africa/Djibouti": true, "Africa/El_Aaiun": true, "Africa/Freetown": true,
+	"Africa/Gaborone": true, "Africa/Harare": true, "Africa/Juba": true, "Africa/Kampala": true,
+	"Africa/Khartoum": true, "Africa/Kigali": true, "Africa/Kinshasa": true,
+	"Africa/Libreville": true, "Africa/Lome": true, "Africa/Luanda": true, "Africa/Lusaka": true,
+	"Africa/Malabo": true, "Africa/Maputo": true, "Africa/Maseru": true, "Africa/Mbabane": true,
+	"Africa/Mogadishu": true, "Africa/Monrovia": true, "Africa/Nairobi": true,
+	"Africa/Ndjamena": true, "Africa/Niamey": true, "Africa/Nouakchott": true,
+	"Africa/Ouagadougou": true, "Africa/Porto-Novo": true, "Africa/Sao_Tome": true,
+	"Africa/Tripoli": true, "Africa/Tunis": true, "Africa/Windhoek": true,
+	"America/Argentina/Buenos_Aires": true, "America/Asuncion": true, "America/Bogota": true,
+	"America/Caracas": true, "America/Cayenne": true, "America/Guatemala": true,
+	"America/Havana": true, "America/Kralendijk": true, "America/La_Paz": true, "America/Lima": true,
+	"America/Managua": true, "America/Marigot": true, "America/Mexico_City": true,
+	"America/Montevideo": true, "America/Nassau": true, "America/Nuuk": true, "America/Panama": true,
+	"America/Paramaribo": true, "America/Port-au-Prince": true, "America/Port_of_Spain": true,
+	"America/Santiago": true, "America/Santo_Domingo": true, "America/Tegucigalpa": true,
+	"Arctic/Longyearbyen": true, "Asia/Amman": true, "Asia/Ashgabat": true, "Asia/Baghdad": true,
+	"Asia/Baku": true, "Asia/Bangkok": true, "Asia/Beirut": true, "Asia/Bishkek": true,
+	"Asia/Damascus": true, "Asia/Dhaka": true, "Asia/Dili": true, "Asia/Dushanbe": true,
+	"Asia/Jakarta": true, "Asia/Jerusalem": true, "Asia/Kabul": true, "Asia/Kathmandu": true,
+	"Asia/Kuala_Lumpur": true, "Asia/Kuwait": true, "Asia/Manila": true, "Asia/Muscat": true,
+	"Asia/Nicosia": true, "Asia/Phnom_Penh": true, "Asia/Pyongyang": true, "Asia/Riyadh": true,
+	"Asia/Seoul": true, "Asia/Singapore": true, "Asia/Taipei": true, "Asia/Tashkent": true,
+	"Asia/Tbilisi": true, "Asia/Tehran": true, "Asia/Thimphu": true, "Asia/Tokyo": true,
+	"Asia/Ulaanbaatar": true, "Asia/Vientiane": true, "Asia/Yerevan": true,
+	"Atlantic/Reykjavik": true, "Atlantic/Stanley": true, "Europe/Amsterdam": true,
+	"Europe/Athens": true, "Europe/Belgrade": true, "Europe/Berlin": true, "Europe/Bratislava": true,
+	"Europe/Brussels": true, "Europe/Bucharest": true, "Europe/Budapest": true,
+	"Europe/Chisinau": true, "Europe/Copenhagen": true, "Europe/Dublin": true,
+	"Europe/Gibraltar": true, "Europe/Helsinki": true, "Europe/Kyiv": true, "Europe/Lisbon": true,
+	"Europe/Ljubljana": true, "Europe/London": true, "Europe/Luxembourg": true, "Europe/Madrid": true,
+	"Europe/Mariehamn": true, "Europe/Minsk": true, "Europe/Monaco": true, "Europe/Moscow": true,
+	"Europe/Oslo": true, "Europe/Paris": true, "Europe/Podgorica": true, "Europe/Prague": true,
+	"Europe/Riga": true, "Europe/Rome": true, "Europe/San_Marino": true, "Europe/Sarajevo": true,
+	"Europe/Skopje": true, "Europe/Sofia": true, "Europe/Stockholm": true, "Europe/Tallinn": true,
+	"Europe/Tirane": true, "Europe/Vaduz": true, "Europe/Vatican": true, "Europe/Vienna": true,
+	"Europe/Vilnius": true, "Europe/Warsaw": true, "Europe/Zagreb": true, "Indian/Antananarivo": true,
+	"Pacific/Apia": true, "Pacific/Funafuti": true, "Pacific/Majuro": true, "Pacific/Noumea": true,
+	"Pacific/Pago_Pago": true, "Pacific/Port_Moresby": true, "Pacific/Saipan": true,
+	"Pacific/Tarawa": true,
+}
+
+// parseCountryTab parses the tab-separated iso3166.tab content into a country code to name map
+func parseCountryTab(content string) map[string]string {
+	names := make(map[string]string)
+
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		code, name, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		names[code] = name
+	}
+
+	return names
+}
+
+// timezoneGeoMetadata returns the country and region of a zone listed in zone.tab, or nil for
+// zones that belong to no country such as UTC or Etc/GMT+5
+func timezoneGeoMetadata(name string) *TimezoneGeoMetadata {
+	for _, entry := range zoneEntries {
+		if entry.name != name {
+			continue
+		}
+
+		return &TimezoneGeoMetadata{
+			CountryCode: entry.countryCode,
+			CountryName: countryNames[entry.countryCode],
+			Region:      countryRegion(entry.countryCode),
+			Capital:     countryCapitalZones[name],
+		}
+	}
+
+	return nil
+}
+
+// countryRegion returns the region of a country, derived from the area of its first zone in zone.tab
+func countryRegion(countryCode string) string {
+	if region, ok := countryRegionOverrides[countryCode]; ok {
+		return region
+	}
+
+	for _, entry := range zoneEntries {
+		if entry.countryCode == countryCode {
+			area, _, _ := strings.Cut(entry.name, "/")
+			return areaRegions[area]
+		}
+	}
+
+	return ""
+}
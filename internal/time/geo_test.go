@@ -0,0 +1,55 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_timezoneGeoMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		timezone string
+		expected *TimezoneGeoMetadata
+	}{
+		{"capital", "Europe/Paris", &TimezoneGeoMetadata{CountryCode: "FR", CountryName: "France", Region: "Europe", Capital: true}},
+		{"not a capital", "America/New_York", &TimezoneGeoMetadata{CountryCode: "US", CountryName: "United States", Region: "North America"}},
+		{"outlying zone uses the country's region", "Pacific/Honolulu", &TimezoneGeoMetadata{CountryCode: "US", CountryName: "United States", Region: "North America"}},
+		{"south america", "America/Sao_Paulo", &TimezoneGeoMetadata{CountryCode: "BR", CountryName: "Brazil", Region: "South America"}},
+		{"ocean area", "Atlantic/Reykjavik", &TimezoneGeoMetadata{CountryCode: "IS", CountryName: "Iceland", Region: "Europe", Capital: true}},
+		{"oceania", "Australia/Sydney", &TimezoneGeoMetadata{CountryCode: "AU", CountryName: "Australia", Region: "Oceania"}},
+		{"no country", "UTC", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, timezoneGeoMetadata(tt.timezone))
+		})
+	}
+}
+
+func Test_countryRegion_EveryCountry(t *testing.T) {
+	for _, entry := range zoneEntries {
+		assert.NotEmpty(t, countryRegion(entry.countryCode), "no region for %s (%s)", entry.countryCode, entry.name)
+		assert.NotEmpty(t, countryNames[entry.countryCode], "no name for %s", entry.countryCode)
+	}
+}
+
+func TestTimeService_GetTimezoneInfo_IncludeGeo(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "Asia/Tokyo", IncludeGeo: true})
+	require.NoError(t, err)
+	require.NotNil(t, info.GeoMetadata)
+	assert.Equal(t, "JP", info.GeoMetadata.CountryCode)
+	assert.Equal(t, "Japan", info.GeoMetadata.CountryName)
+	assert.Equal(t, "Asia", info.GeoMetadata.Region)
+	assert.True(t, info.GeoMetadata.Capital)
+
+	info, err = service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "Asia/Tokyo"})
+	require.NoError(t, err)
+	assert.Nil(t, info.GeoMetadata)
+}
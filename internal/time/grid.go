@@ -0,0 +1,116 @@
+package time
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Time grid limits
+const (
+	maxGridTimezones = 10
+	maxGridRows      = 1000
+)
+
+// GenerateTimeGrid lists the time in several timezones at regular intervals, one row per step
+func (s *timeService) GenerateTimeGrid(ctx context.Context, input TimeGridInput) (TimeGridResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeGridResult{}, err
+	}
+
+	if len(input.Timezones) == 0 {
+		return TimeGridResult{}, validationErrorf(CodeMissingArgument, "timezones cannot be empty")
+	}
+	if len(input.Timezones) > maxGridTimezones {
+		return TimeGridResult{}, validationErrorf(CodeLimitExceeded, "too many timezones: %d (max %d)", len(input.Timezones), maxGridTimezones)
+	}
+
+	locations := make([]*time.Location, len(input.Timezones))
+	warnings := make([]string, len(input.Timezones))
+	for i, timezone := range input.Timezones {
+		loc, warning, err := s.loadLocation(timezone)
+		if err != nil {
+			return TimeGridResult{}, err
+		}
+		locations[i], warnings[i] = loc, warning
+	}
+
+	format := input.Format
+	if format == "" {
+		format = s.defaultFormat
+	}
+	if !s.IsFormatSupported(format) {
+		return TimeGridResult{}, formatErrorf(CodeUnsupportedFormat, "unsupported format: %s (supported: %v)", format, s.supportedFormats)
+	}
+
+	interval := time.Hour
+	if input.Interval != "" {
+		var err error
+		interval, err = time.ParseDuration(input.Interval)
+		if err != nil {
+			return TimeGridResult{}, parseErrorf(CodeInvalidDuration, "invalid interval %q: %w", input.Interval, err)
+		}
+		if interval <= 0 {
+			return TimeGridResult{}, validationErrorf(CodeInvalidArgument, "invalid interval %q: must be positive", input.Interval)
+		}
+	}
+
+	// Default to the week starting at the current hour
+	start := s.clock.Now().UTC().Truncate(time.Hour)
+	if input.StartTime != "" {
+		var err error
+		start, err = parseFlexibleTime(input.StartTime, time.UTC)
+		if err != nil {
+			return TimeGridResult{}, parseErrorf(CodeInvalidTimestamp, "invalid start_time: %w", err)
+		}
+	}
+
+	end := start.AddDate(0, 0, 7)
+	if input.EndTime != "" {
+		var err error
+		end, err = parseFlexibleTime(input.EndTime, time.UTC)
+		if err != nil {
+			return TimeGridResult{}, parseErrorf(CodeInvalidTimestamp, "invalid end_time: %w", err)
+		}
+	}
+
+	if err := ValidateDateRange(start, end, true); err != nil {
+		return TimeGridResult{}, err
+	}
+	if steps := end.Sub(start) / interval; steps >= maxGridRows {
+		return TimeGridResult{}, validationErrorf(CodeLimitExceeded, "too many rows: %s to %s every %s exceeds %d rows", start.Format(time.RFC3339), end.Format(time.RFC3339), interval, maxGridRows)
+	}
+
+	s.logger.Debug("Generating time grid",
+		zap.Strings("timezones", input.Timezones),
+		zap.Time("start", start),
+		zap.Time("end", end),
+		zap.Duration("interval", interval),
+		zap.String("format", format))
+
+	var rows []TimeGridRow
+	for at := start; !at.After(end); at = at.Add(interval) {
+		if err := ctx.Err(); err != nil {
+			return TimeGridResult{}, err
+		}
+
+		cells := make([]string, len(locations))
+		for i, loc := range locations {
+			cells[i] = renderFormat(at.In(loc), format)
+		}
+
+		rows = append(rows, TimeGridRow{
+			UTCTime: at.UTC().Format(time.RFC3339),
+			Cells:   cells,
+		})
+	}
+
+	return TimeGridResult{
+		Headers:  input.Timezones,
+		Interval: interval.String(),
+		Format:   format,
+		Rows:     rows,
+		Warning:  joinWarnings(warnings...),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GenerateTimeGrid(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 34, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "15:04 Mon"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	t.Run("explicit range", func(t *testing.T) {
+		result, err := service.GenerateTimeGrid(context.Background(), TimeGridInput{
+			Timezones: []string{"America/New_York", "Asia/Tokyo"},
+			StartTime: "2024-03-15T12:00:00Z",
+			EndTime:   "2024-03-15T14:00:00Z",
+			Interval:  "1h",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"America/New_York", "Asia/Tokyo"}, result.Headers)
+		require.Len(t, result.Rows, 3)
+		assert.Equal(t, "2024-03-15T12:00:00Z", result.Rows[0].UTCTime)
+		assert.Equal(t, []string{"2024-03-15T08:00:00-04:00", "2024-03-15T21:00:00+09:00"}, result.Rows[0].Cells)
+		assert.Equal(t, "2024-03-15T14:00:00Z", result.Rows[2].UTCTime)
+	})
+
+	t.Run("defaults to an hourly week from the current hour", func(t *testing.T) {
+		result, err := service.GenerateTimeGrid(context.Background(), TimeGridInput{
+			Timezones: []string{"Europe/London"},
+			Format:    "15:04 Mon",
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Rows, 7*24+1)
+		assert.Equal(t, "2024-03-15T12:00:00Z", result.Rows[0].UTCTime)
+		assert.Equal(t, []string{"12:00 Fri"}, result.Rows[0].Cells)
+		// London switches to BST on March 31, after the week ends
+		assert.Equal(t, "2024-03-22T12:00:00Z", result.Rows[len(result.Rows)-1].UTCTime)
+	})
+}
+
+func TestTimeService_GenerateTimeGrid_Errors(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name     string
+		input    TimeGridInput
+		expected string
+	}{
+		{"no timezones", TimeGridInput{}, "timezones cannot be empty"},
+		{"too many timezones", TimeGridInput{Timezones: make([]string, maxGridTimezones+1)}, "too many timezones"},
+		{"invalid timezone", TimeGridInput{Timezones: []string{"Mars/Olympus"}}, "invalid timezone"},
+		{"unsupported format", TimeGridInput{Timezones: []string{"UTC"}, Format: "15:04"}, "unsupported format"},
+		{"invalid interval", TimeGridInput{Timezones: []string{"UTC"}, Interval: "-1h"}, "must be positive"},
+		{"end before start", TimeGridInput{Timezones: []string{"UTC"}, StartTime: "2024-03-15", EndTime: "2024-03-14"}, "is before start"},
+		{"too many rows", TimeGridInput{Timezones: []string{"UTC"}, Interval: "1m"}, "too many rows"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.GenerateTimeGrid(context.Background(), tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
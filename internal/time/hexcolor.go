@@ -0,0 +1,26 @@
+package time
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// hexColorTime expresses the wall clock time of t as a color, scaling the hour (0-23) to the
+// red channel and the minute and second (0-59) to the green and blue channels, e.g. "#FF0000"
+// for 23:00:00
+func hexColorTime(t time.Time) string {
+	red := math.Round(float64(t.Hour()) * 255 / 23)
+	green := math.Round(float64(t.Minute()) * 255 / 59)
+	blue := math.Round(float64(t.Second()) * 255 / 59)
+	return fmt.Sprintf("#%02X%02X%02X", int(red), int(green), int(blue))
+}
+
+// hslColorTime expresses the wall clock time of t as an HSL color: the hour sets the hue
+// around the color wheel, the minute the saturation and the second the lightness
+func hslColorTime(t time.Time) string {
+	hue := float64(t.Hour()) * 360 / 24
+	saturation := float64(t.Minute()) * 100 / 59
+	lightness := float64(t.Second()) * 100 / 59
+	return fmt.Sprintf("hsl(%.0f, %.0f%%, %.0f%%)", hue, saturation, lightness)
+}
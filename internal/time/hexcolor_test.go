@@ -0,0 +1,50 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestHexColorTime(t *testing.T) {
+	tests := []struct {
+		name        string
+		clock       string
+		expectedHex string
+		expectedHSL string
+	}{
+		{"midnight", "00:00:00", "#000000", "hsl(0, 0%, 0%)"},
+		{"end of day", "23:59:59", "#FFFFFF", "hsl(345, 100%, 100%)"},
+		{"afternoon", "14:30:15", "#9B8241", "hsl(210, 51%, 25%)"},
+		{"single digit channels", "01:01:01", "#0B0404", "hsl(15, 2%, 2%)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, err := time.Parse("15:04:05", tt.clock)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedHex, hexColorTime(clock))
+			assert.Equal(t, tt.expectedHSL, hslColorTime(clock))
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_HexColor(t *testing.T) {
+	now := time.Date(2024, 3, 15, 23, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	// The color follows the wall clock of the requested timezone
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "Asia/Tokyo", IncludeHexColor: true})
+	require.NoError(t, err)
+	assert.Equal(t, "#590000", result.HexColorTime)
+	assert.Equal(t, "hsl(120, 0%, 0%)", result.HSLColor)
+
+	without, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Empty(t, without.HexColorTime)
+	assert.Empty(t, without.HSLColor)
+}
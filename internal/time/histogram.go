@@ -0,0 +1,181 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Histogram limits
+const (
+	maxHistogramTimestamps = 10000
+	maxHistogramBuckets    = 10000
+)
+
+// histogramBucketer aligns timestamps to bucket boundaries
+type histogramBucketer struct {
+	// start returns the start of the bucket containing t
+	start func(t time.Time) time.Time
+	// next returns the start of the bucket following the one starting at start
+	next func(start time.Time) time.Time
+	// label returns a short label for the bucket starting at start
+	label func(start time.Time) string
+}
+
+// BucketTimestamps bins timestamps into contiguous time buckets
+func (s *timeService) BucketTimestamps(ctx context.Context, input TimeHistogramInput) (TimeHistogramResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeHistogramResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	bucketSize := input.BucketSize
+	if bucketSize == "" {
+		bucketSize = "day"
+	}
+
+	if len(input.Timestamps) == 0 {
+		return TimeHistogramResult{}, validationErrorf(CodeMissingArgument, "timestamps cannot be empty")
+	}
+	if len(input.Timestamps) > maxHistogramTimestamps {
+		return TimeHistogramResult{}, validationErrorf(CodeLimitExceeded, "too many timestamps: %d (max %d)", len(input.Timestamps), maxHistogramTimestamps)
+	}
+
+	s.logger.Debug("Bucketing timestamps",
+		zap.Int("count", len(input.Timestamps)),
+		zap.String("bucket_size", bucketSize),
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeHistogramResult{}, err
+	}
+
+	bucketer, err := newHistogramBucketer(bucketSize)
+	if err != nil {
+		return TimeHistogramResult{}, err
+	}
+
+	counts := make(map[int64]int)
+	var first, last time.Time
+	for i, value := range input.Timestamps {
+		if err := ctx.Err(); err != nil {
+			return TimeHistogramResult{}, err
+		}
+
+		t, err := parseFlexibleTime(value, loc)
+		if err != nil {
+			return TimeHistogramResult{}, parseErrorf(CodeInvalidTimestamp, "invalid timestamp at index %d: %w", i, err)
+		}
+
+		start := bucketer.start(t)
+		counts[start.UnixNano()]++
+
+		if i == 0 || start.Before(first) {
+			first = start
+		}
+		if i == 0 || start.After(last) {
+			last = start
+		}
+	}
+
+	var buckets []HistogramBucket
+	for start := first; !start.After(last); start = bucketer.next(start) {
+		if err := ctx.Err(); err != nil {
+			return TimeHistogramResult{}, err
+		}
+		if len(buckets) == maxHistogramBuckets {
+			return TimeHistogramResult{}, validationErrorf(CodeLimitExceeded, "too many buckets: the timestamps span more than %d buckets of %s", maxHistogramBuckets, bucketSize)
+		}
+
+		end := bucketer.next(start)
+
+		startStr, err := s.formatTimeInternal(start, input.DateFormat)
+		if err != nil {
+			return TimeHistogramResult{}, err
+		}
+		endStr, err := s.formatTimeInternal(end, input.DateFormat)
+		if err != nil {
+			return TimeHistogramResult{}, err
+		}
+
+		buckets = append(buckets, HistogramBucket{
+			Start:       startStr,
+			End:         endStr,
+			Count:       counts[start.UnixNano()],
+			BucketLabel: bucketer.label(start),
+		})
+	}
+
+	return TimeHistogramResult{
+		BucketSize: bucketSize,
+		Timezone:   loc.String(),
+		Total:      len(input.Timestamps),
+		Buckets:    buckets,
+		Warning:    warning,
+	}, nil
+}
+
+// newHistogramBucketer returns the bucketer for a calendar unit ("day", "week", "month") or a Go duration.
+// Durations that evenly divide a day are aligned to local midnight, longer ones to the Unix epoch.
+func newHistogramBucketer(bucketSize string) (histogramBucketer, error) {
+	switch bucketSize {
+	case "day":
+		return histogramBucketer{
+			start: func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()) },
+			next:  func(start time.Time) time.Time { return start.AddDate(0, 0, 1) },
+			label: func(start time.Time) string { return start.Format("2006-01-02") },
+		}, nil
+	case "week":
+		return histogramBucketer{
+			start: func(t time.Time) time.Time {
+				midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+				return midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+			},
+			next: func(start time.Time) time.Time { return start.AddDate(0, 0, 7) },
+			label: func(start time.Time) string {
+				year, week := start.ISOWeek()
+				return fmt.Sprintf("%d-W%02d", year, week)
+			},
+		}, nil
+	case "month":
+		return histogramBucketer{
+			start: func(t time.Time) time.Time { return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()) },
+			next:  func(start time.Time) time.Time { return start.AddDate(0, 1, 0) },
+			label: func(start time.Time) string { return start.Format("2006-01") },
+		}, nil
+	}
+
+	d, err := time.ParseDuration(bucketSize)
+	if err != nil {
+		return histogramBucketer{}, parseErrorf(CodeInvalidDuration, "invalid bucket_size %q: expected a Go duration, 'day', 'week' or 'month'", bucketSize)
+	}
+	if d <= 0 {
+		return histogramBucketer{}, validationErrorf(CodeInvalidArgument, "invalid bucket_size %q: must be positive", bucketSize)
+	}
+
+	start := func(t time.Time) time.Time {
+		if d > 24*time.Hour || (24*time.Hour)%d != 0 {
+			return t.Truncate(d)
+		}
+		midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		return midnight.Add(t.Sub(midnight) / d * d)
+	}
+
+	label := "2006-01-02 15:04"
+	if d%time.Minute != 0 {
+		label = "2006-01-02 15:04:05"
+	}
+
+	return histogramBucketer{
+		start: start,
+		next:  func(bucketStart time.Time) time.Time { return bucketStart.Add(d) },
+		label: func(bucketStart time.Time) string { return bucketStart.Format(label) },
+	}, nil
+}
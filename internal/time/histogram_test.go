@@ -0,0 +1,121 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_BucketTimestamps(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "2006-01-02 15:04:05"}, logger)
+
+	tests := []struct {
+		name           string
+		input          TimeHistogramInput
+		wantErr        bool
+		expectedLabels []string
+		expectedCounts []int
+		expectedStart  string
+	}{
+		{
+			name: "daily buckets include empty days",
+			input: TimeHistogramInput{
+				Timestamps: []string{"2024-03-01T10:00:00Z", "2024-03-01T23:59:59Z", "2024-03-03T00:00:00Z"},
+			},
+			expectedLabels: []string{"2024-03-01", "2024-03-02", "2024-03-03"},
+			expectedCounts: []int{2, 0, 1},
+			expectedStart:  "2024-03-01T00:00:00Z",
+		},
+		{
+			name: "hourly buckets aligned to the timezone",
+			input: TimeHistogramInput{
+				Timestamps: []string{"2024-03-01 09:15", "2024-03-01 09:45", "2024-03-01 10:05"},
+				BucketSize: "1h",
+				Timezone:   "Asia/Kolkata",
+			},
+			expectedLabels: []string{"2024-03-01 09:00", "2024-03-01 10:00"},
+			expectedCounts: []int{2, 1},
+			expectedStart:  "2024-03-01T09:00:00+05:30",
+		},
+		{
+			name: "weekly buckets start on Monday",
+			input: TimeHistogramInput{
+				Timestamps: []string{"2024-03-13", "2024-03-17", "2024-03-18"},
+				BucketSize: "week",
+			},
+			expectedLabels: []string{"2024-W11", "2024-W12"},
+			expectedCounts: []int{2, 1},
+			expectedStart:  "2024-03-11T00:00:00Z",
+		},
+		{
+			name: "monthly buckets with a custom date format",
+			input: TimeHistogramInput{
+				Timestamps: []string{"1706745600", "2024-03-31 12:00"},
+				BucketSize: "month",
+				DateFormat: "2006-01-02 15:04:05",
+			},
+			expectedLabels: []string{"2024-02", "2024-03"},
+			expectedCounts: []int{1, 1},
+			expectedStart:  "2024-02-01 00:00:00",
+		},
+		{
+			name:    "empty timestamps",
+			input:   TimeHistogramInput{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid bucket size",
+			input:   TimeHistogramInput{Timestamps: []string{"2024-03-01"}, BucketSize: "fortnight"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timestamp",
+			input:   TimeHistogramInput{Timestamps: []string{"2024-03-01", "not a time"}},
+			wantErr: true,
+		},
+		{
+			name:    "too many buckets",
+			input:   TimeHistogramInput{Timestamps: []string{"2024-01-01", "2024-12-31"}, BucketSize: "1m"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.BucketTimestamps(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.input.Timestamps), result.Total)
+			require.Len(t, result.Buckets, len(tt.expectedLabels))
+			assert.Equal(t, tt.expectedStart, result.Buckets[0].Start)
+			for i, bucket := range result.Buckets {
+				assert.Equal(t, tt.expectedLabels[i], bucket.BucketLabel)
+				assert.Equal(t, tt.expectedCounts[i], bucket.Count)
+			}
+		})
+	}
+}
+
+func TestTimeService_BucketTimestamps_TooManyTimestamps(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	timestamps := make([]string, maxHistogramTimestamps+1)
+	for i := range timestamps {
+		timestamps[i] = fmt.Sprint(1700000000 + i)
+	}
+
+	_, err := service.BucketTimestamps(context.Background(), TimeHistogramInput{Timestamps: timestamps})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "too many timestamps")
+}
@@ -0,0 +1,214 @@
+package time
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	// Bundled so zones load even on hosts without a timezone database
+	_ "time/tzdata"
+
+	"go.uber.org/zap"
+)
+
+// legacyZoneNames maps deprecated IANA names to the zone they now link to, from the backward links
+// of tzdata 2025b. Links that are still the main zone of a country (such as Europe/Bratislava) are
+// current names, not legacy ones, and are left out.
+var legacyZoneNames = map[string]string{
+	"Africa/Asmera": "Africa/Nairobi", "Africa/Timbuktu": "Africa/Abidjan",
+	"America/Argentina/ComodRivadavia": "America/Argentina/Catamarca", "America/Atka": "America/Adak",
+	"America/Buenos_Aires": "America/Argentina/Buenos_Aires",
+	"America/Catamarca":    "America/Argentina/Catamarca", "America/Coral_Harbour": "America/Panama",
+	"America/Cordoba": "America/Argentina/Cordoba", "America/Ensenada": "America/Tijuana",
+	"America/Fort_Wayne": "America/Indiana/Indianapolis", "America/Godthab": "America/Nuuk",
+	"America/Indianapolis": "America/Indiana/Indianapolis",
+	"America/Jujuy":        "America/Argentina/Jujuy", "America/Knox_IN": "America/Indiana/Knox",
+	"America/Louisville": "America/Kentucky/Louisville",
+	"America/Mendoza":    "America/Argentina/Mendoza", "America/Montreal": "America/Toronto",
+	"America/Nipigon": "America/Toronto", "America/Pangnirtung": "America/Iqaluit",
+	"America/Porto_Acre": "America/Rio_Branco", "America/Rainy_River": "America/Winnipeg",
+	"America/Rosario": "America/Argentina/Cordoba", "America/Santa_Isabel": "America/Tijuana",
+	"America/Shiprock": "America/Denver", "America/Thunder_Bay": "America/Toronto",
+	"America/Virgin": "America/Puerto_Rico", "America/Yellowknife": "America/Edmonton",
+	"Antarctica/South_Pole": "Pacific/Auckland", "Asia/Ashkhabad": "Asia/Ashgabat",
+	"Asia/Calcutta": "Asia/Kolkata", "Asia/Choibalsan": "Asia/Ulaanbaatar",
+	"Asia/Chongqing": "Asia/Shanghai", "Asia/Chungking": "Asia/Shanghai", "Asia/Dacca": "Asia/Dhaka",
+	"Asia/Harbin": "Asia/Shanghai", "Asia/Istanbul": "Europe/Istanbul", "Asia/Kashgar": "Asia/Urumqi",
+	"Asia/Katmandu": "Asia/Kathmandu", "Asia/Macao": "Asia/Macau", "Asia/Rangoon": "Asia/Yangon",
+	"Asia/Saigon": "Asia/Ho_Chi_Minh", "Asia/Tel_Aviv": "Asia/Jerusalem",
+	"Asia/Thimbu": "Asia/Thimphu", "Asia/Ujung_Pandang": "Asia/Makassar",
+	"Asia/Ulan_Bator": "Asia/Ulaanbaatar", "Atlantic/Faeroe": "Atlantic/Faroe",
+	"Atlantic/Jan_Mayen": "Europe/Berlin", "Australia/ACT": "Australia/Sydney",
+	"Australia/Canberra": "Australia/Sydney", "Australia/Currie": "Australia/Hobart",
+	"Australia/LHI": "Australia/Lord_Howe", "Australia/NSW": "Australia/Sydney",
+	"Australia/North": "Australia/Darwin", "Australia/Queensland": "Australia/Brisbane",
+	"Australia/South": "Australia/Adelaide", "Australia/Tasmania": "Australia/Hobart",
+	"Australia/Victoria": "Australia/Melbourne", "Australia/West": "Australia/Perth",
+	"Australia/Yancowinna": "Australia/Broken_Hill", "Brazil/Acre": "America/Rio_Branco",
+	"Brazil/DeNoronha": "America/Noronha", "Brazil/East": "America/Sao_Paulo",
+	"Brazil/West": "America/Manaus", "Canada/Atlantic": "America/Halifax",
+	"Canada/Central": "America/Winnipeg", "Canada/Eastern": "America/Toronto",
+	"Canada/Mountain": "America/Edmonton", "Canada/Newfoundland": "America/St_Johns",
+	"Canada/Pacific": "America/Vancouver", "Canada/Saskatchewan": "America/Regina",
+	"Canada/Yukon": "America/Whitehorse", "Chile/Continental": "America/Santiago",
+	"Chile/EasterIsland": "Pacific/Easter", "Cuba": "America/Havana", "Egypt": "Africa/Cairo",
+	"Eire": "Europe/Dublin", "Etc/GMT+0": "Etc/GMT", "Etc/GMT-0": "Etc/GMT", "Etc/GMT0": "Etc/GMT",
+	"Etc/Greenwich": "Etc/GMT", "Etc/UCT": "Etc/UTC", "Etc/Universal": "Etc/UTC",
+	"Etc/Zulu": "Etc/UTC", "Europe/Belfast": "Europe/London", "Europe/Kiev": "Europe/Kyiv",
+	"Europe/Nicosia": "Asia/Nicosia", "Europe/Tiraspol": "Europe/Chisinau",
+	"Europe/Uzhgorod": "Europe/Kyiv", "Europe/Zaporozhye": "Europe/Kyiv", "GB": "Europe/London",
+	"GB-Eire": "Europe/London", "GMT": "Etc/GMT", "GMT+0": "Etc/GMT", "GMT-0": "Etc/GMT",
+	"GMT0": "Etc/GMT", "Greenwich": "Etc/GMT", "Hongkong": "Asia/Hong_Kong",
+	"Iceland": "Africa/Abidjan", "Iran": "Asia/Tehran", "Israel": "Asia/Jerusalem",
+	"Jamaica": "America/Jamaica", "Japan": "Asia/Tokyo", "Kwajalein": "Pacific/Kwajalein",
+	"Libya": "Africa/Tripoli", "Mexico/BajaNorte": "America/Tijuana",
+	"Mexico/BajaSur": "America/Mazatlan", "Mexico/General": "America/Mexico_City",
+	"NZ": "Pacific/Auckland", "NZ-CHAT": "Pacific/Chatham", "Navajo": "America/Denver",
+	"PRC": "Asia/Shanghai", "Pacific/Enderbury": "Pacific/Kanton",
+	"Pacific/Johnston": "Pacific/Honolulu", "Pacific/Ponape": "Pacific/Guadalcanal",
+	"Pacific/Samoa": "Pacific/Pago_Pago", "Pacific/Truk": "Pacific/Port_Moresby",
+	"Pacific/Yap": "Pacific/Port_Moresby", "Poland": "Europe/Warsaw", "Portugal": "Europe/Lisbon",
+	"ROC": "Asia/Taipei", "ROK": "Asia/Seoul", "Singapore": "Asia/Singapore",
+	"Turkey": "Europe/Istanbul", "UCT": "Etc/UTC", "US/Alaska": "America/Anchorage",
+	"US/Aleutian": "America/Adak", "US/Arizona": "America/Phoenix", "US/Central": "America/Chicago",
+	"US/East-Indiana": "America/Indiana/Indianapolis", "US/Eastern": "America/New_York",
+	"US/Hawaii": "Pacific/Honolulu", "US/Indiana-Starke": "America/Indiana/Knox",
+	"US/Michigan": "America/Detroit", "US/Mountain": "America/Denver",
+	"US/Pacific": "America/Los_Angeles", "US/Samoa": "Pacific/Pago_Pago", "UTC": "Etc/UTC",
+	"Universal": "Etc/UTC", "W-SU": "Europe/Moscow", "Zulu": "Etc/UTC",
+}
+
+// zoneCountryChanges lists the ISO 3166 country code changes of zones whose country was split,
+// merged or renamed
+var zoneCountryChanges = map[string][]string{
+	"Africa/Asmara":         {"ET → ER (1993): Eritrea became independent from Ethiopia"},
+	"Africa/Juba":           {"SD → SS (2011): South Sudan became independent from Sudan"},
+	"America/Curacao":       {"AN → CW (2010): the Netherlands Antilles were dissolved"},
+	"America/Kralendijk":    {"AN → BQ (2010): the Netherlands Antilles were dissolved"},
+	"America/Lower_Princes": {"AN → SX (2010): the Netherlands Antilles were dissolved"},
+	"Asia/Almaty":           {"SU → KZ (1991): dissolution of the Soviet Union"},
+	"Asia/Ashgabat":         {"SU → TM (1991): dissolution of the Soviet Union"},
+	"Asia/Baku":             {"SU → AZ (1991): dissolution of the Soviet Union"},
+	"Asia/Bishkek":          {"SU → KG (1991): dissolution of the Soviet Union"},
+	"Asia/Dili":             {"TP → TL (2002): East Timor became Timor-Leste"},
+	"Asia/Dushanbe":         {"SU → TJ (1991): dissolution of the Soviet Union"},
+	"Asia/Ho_Chi_Minh":      {"VD → VN (1976): reunification of Vietnam"},
+	"Asia/Tashkent":         {"SU → UZ (1991): dissolution of the Soviet Union"},
+	"Asia/Tbilisi":          {"SU → GE (1991): dissolution of the Soviet Union"},
+	"Asia/Yerevan":          {"SU → AM (1991): dissolution of the Soviet Union"},
+	"Europe/Belgrade":       {"YU → CS (2003): Yugoslavia became Serbia and Montenegro", "CS → RS (2006): Montenegro became independent"},
+	"Europe/Berlin":         {"DD → DE (1990): German reunification"},
+	"Europe/Bratislava":     {"CS → SK (1993): dissolution of Czechoslovakia"},
+	"Europe/Chisinau":       {"SU → MD (1991): dissolution of the Soviet Union"},
+	"Europe/Kyiv":           {"SU → UA (1991): dissolution of the Soviet Union"},
+	"Europe/Ljubljana":      {"YU → SI (1991): breakup of Yugoslavia"},
+	"Europe/Minsk":          {"SU → BY (1991): dissolution of the Soviet Union"},
+	"Europe/Moscow":         {"SU → RU (1991): dissolution of the Soviet Union"},
+	"Europe/Podgorica":      {"YU → CS (2003): Yugoslavia became Serbia and Montenegro", "CS → ME (2006): Montenegro became independent"},
+	"Europe/Prague":         {"CS → CZ (1993): dissolution of Czechoslovakia"},
+	"Europe/Riga":           {"SU → LV (1991): dissolution of the Soviet Union"},
+	"Europe/Sarajevo":       {"YU → BA (1992): breakup of Yugoslavia"},
+	"Europe/Skopje":         {"YU → MK (1991): breakup of Yugoslavia"},
+	"Europe/Tallinn":        {"SU → EE (1991): dissolution of the Soviet Union"},
+	"Europe/Vilnius":        {"SU → LT (1991): dissolution of the Soviet Union"},
+	"Europe/Zagreb":         {"YU → HR (1991): breakup of Yugoslavia"},
+}
+
+// zoneinfoDirs are the system timezone database locations Go's time package searches
+var zoneinfoDirs = []string{"/usr/share/zoneinfo/", "/usr/share/lib/zoneinfo/", "/usr/lib/locale/TZ/"}
+
+var (
+	tzdataVersionOnce  sync.Once
+	tzdataVersionValue string
+)
+
+// GetTimezoneHistory returns the current name of a timezone, its deprecated aliases and the
+// country code changes it went through
+func (s *timeService) GetTimezoneHistory(ctx context.Context, input TimezoneHistoryInput) (TimezoneHistoryResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimezoneHistoryResult{}, err
+	}
+
+	timezone := strings.TrimSpace(input.Timezone)
+	if timezone == "" {
+		return TimezoneHistoryResult{}, validationErrorf(CodeMissingArgument, "timezone is required")
+	}
+
+	canonical := timezone
+	if current, ok := legacyZoneNames[timezone]; ok {
+		canonical = current
+	}
+	if _, err := loadZone(canonical); err != nil || isUTCOffset(canonical) {
+		return TimezoneHistoryResult{}, timezoneErrorf(CodeInvalidTimezone, "unknown timezone %s", timezone)
+	}
+
+	legacyNames := []string{}
+	for legacy, current := range legacyZoneNames {
+		if current == canonical {
+			legacyNames = append(legacyNames, legacy)
+		}
+	}
+	sort.Strings(legacyNames)
+
+	countryChanges := zoneCountryChanges[canonical]
+	if countryChanges == nil {
+		countryChanges = []string{}
+	}
+
+	s.logger.Debug("Looked up timezone history",
+		zap.String("timezone", timezone),
+		zap.String("canonical_name", canonical),
+		zap.Int("legacy_names", len(legacyNames)))
+
+	return TimezoneHistoryResult{
+		Timezone:       timezone,
+		CanonicalName:  canonical,
+		IsLegacyName:   canonical != timezone,
+		LegacyNames:    legacyNames,
+		CountryChanges: countryChanges,
+		TzdataVersion:  tzdataVersion(),
+	}, nil
+}
+
+// tzdataVersion returns the version of the timezone database in use: the system one named by
+// $ZONEINFO or found in the standard locations, otherwise the copy bundled with the Go release
+func tzdataVersion() string {
+	tzdataVersionOnce.Do(func() {
+		dirs := zoneinfoDirs
+		if dir := os.Getenv("ZONEINFO"); dir != "" {
+			dirs = append([]string{dir}, dirs...)
+		}
+
+		for _, dir := range dirs {
+			if version := readTzdataVersion(filepath.Join(dir, "tzdata.zi")); version != "" {
+				tzdataVersionValue = version
+				return
+			}
+		}
+		tzdataVersionValue = "bundled with " + runtime.Version()
+	})
+
+	return tzdataVersionValue
+}
+
+// readTzdataVersion reads the "# version" header of a tzdata.zi file, or "" when it is missing
+func readTzdataVersion(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	if scanner.Scan() {
+		if version, ok := strings.CutPrefix(scanner.Text(), "# version "); ok {
+			return strings.TrimSpace(version)
+		}
+	}
+	return ""
+}
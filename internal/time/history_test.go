@@ -0,0 +1,112 @@
+package time
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetTimezoneHistory(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name                   string
+		timezone               string
+		expectedCanonical      string
+		expectedLegacy         bool
+		expectedLegacyNames    []string
+		expectedCountryChanges []string
+		expectError            bool
+	}{
+		{
+			name:                   "legacy name",
+			timezone:               "Asia/Calcutta",
+			expectedCanonical:      "Asia/Kolkata",
+			expectedLegacy:         true,
+			expectedLegacyNames:    []string{"Asia/Calcutta"},
+			expectedCountryChanges: []string{},
+		},
+		{
+			name:                   "current name with several aliases and a country change",
+			timezone:               "Europe/Kyiv",
+			expectedCanonical:      "Europe/Kyiv",
+			expectedLegacyNames:    []string{"Europe/Kiev", "Europe/Uzhgorod", "Europe/Zaporozhye"},
+			expectedCountryChanges: []string{"SU → UA (1991): dissolution of the Soviet Union"},
+		},
+		{
+			name:                   "current name of a country that no longer exists",
+			timezone:               "Europe/Belgrade",
+			expectedCanonical:      "Europe/Belgrade",
+			expectedLegacyNames:    []string{},
+			expectedCountryChanges: []string{"YU → CS (2003): Yugoslavia became Serbia and Montenegro", "CS → RS (2006): Montenegro became independent"},
+		},
+		{
+			name:                   "old US zone name",
+			timezone:               "US/Eastern",
+			expectedCanonical:      "America/New_York",
+			expectedLegacy:         true,
+			expectedLegacyNames:    []string{"US/Eastern"},
+			expectedCountryChanges: []string{},
+		},
+		{
+			name:        "unknown timezone",
+			timezone:    "Mars/Olympus",
+			expectError: true,
+		},
+		{
+			name:        "UTC offset",
+			timezone:    "+05:30",
+			expectError: true,
+		},
+		{
+			name:        "empty timezone",
+			timezone:    "",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetTimezoneHistory(context.Background(), TimezoneHistoryInput{Timezone: tt.timezone})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCanonical, result.CanonicalName)
+			assert.Equal(t, tt.expectedLegacy, result.IsLegacyName)
+			assert.Equal(t, tt.expectedLegacyNames, result.LegacyNames)
+			assert.Equal(t, tt.expectedCountryChanges, result.CountryChanges)
+			assert.NotEmpty(t, result.TzdataVersion)
+		})
+	}
+}
+
+func Test_legacyZoneNames(t *testing.T) {
+	current := make(map[string]bool, len(zoneEntries))
+	for _, entry := range zoneEntries {
+		current[entry.name] = true
+	}
+
+	for legacy, canonical := range legacyZoneNames {
+		assert.False(t, current[legacy], "%s is a current zone name", legacy)
+		_, ok := legacyZoneNames[canonical]
+		assert.False(t, ok, "%s links to another legacy name %s", legacy, canonical)
+	}
+}
+
+func Test_readTzdataVersion(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "tzdata.zi")
+	require.NoError(t, os.WriteFile(path, []byte("# version 2024a\n# ddeps\n"), 0o644))
+	assert.Equal(t, "2024a", readTzdataVersion(path))
+
+	assert.Equal(t, "", readTzdataVersion(filepath.Join(dir, "missing.zi")))
+}
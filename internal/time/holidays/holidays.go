@@ -0,0 +1,175 @@
+// Package holidays provides public holiday data for business day calculations
+package holidays
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// holidaysJSON lists the nationwide public holidays of each supported country, compiled from the
+// government source named for the country. Regional holidays are left out: UK covers England and
+// Wales, IN the three national holidays, and CN the statutory days without make-up workdays.
+//
+//go:embed holidays.json
+var holidaysJSON []byte
+
+// Errors returned for lookups the embedded data does not cover
+var (
+	ErrUnknownCountry = errors.New("unknown country")
+	ErrYearNotCovered = errors.New("year not covered")
+)
+
+// countryAliases maps alternative country codes to the ones used in the data
+var countryAliases = map[string]string{
+	"GB": "UK",
+}
+
+// HolidayEntry is a public holiday on a calendar date
+type HolidayEntry struct {
+	Date string `json:"date" jsonschema:"Date of the holiday (YYYY-MM-DD)"`
+	Name string `json:"name" jsonschema:"Name of the holiday, in the local language followed by English where they differ"`
+}
+
+// countryCalendar is the holiday data of a single country
+type countryCalendar struct {
+	Name     string         `json:"name"`
+	Source   string         `json:"source"`
+	Holidays []HolidayEntry `json:"holidays"`
+}
+
+// calendarData is the layout of the embedded holiday data
+type calendarData struct {
+	FirstYear int                        `json:"first_year"`
+	LastYear  int                        `json:"last_year"`
+	Countries map[string]countryCalendar `json:"countries"`
+}
+
+// WorkdayCalendar holds the public holidays of every supported country for a range of years
+type WorkdayCalendar struct {
+	firstYear int
+	lastYear  int
+	countries map[string]countryCalendar
+
+	// byDate indexes holiday names by country and date
+	byDate map[string]map[string]string
+}
+
+// defaultCalendar is parsed from the embedded data at startup
+var defaultCalendar = mustParseCalendar(holidaysJSON)
+
+// mustParseCalendar parses and indexes calendar data, panicking on malformed data since it is
+// embedded at build time
+func mustParseCalendar(data []byte) *WorkdayCalendar {
+	calendar, err := parseCalendar(data)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded holiday data: %v", err))
+	}
+	return calendar
+}
+
+// parseCalendar decodes calendar data and indexes it by date
+func parseCalendar(data []byte) (*WorkdayCalendar, error) {
+	var decoded calendarData
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, err
+	}
+
+	calendar := &WorkdayCalendar{
+		firstYear: decoded.FirstYear,
+		lastYear:  decoded.LastYear,
+		countries: decoded.Countries,
+		byDate:    make(map[string]map[string]string, len(decoded.Countries)),
+	}
+	for code, country := range decoded.Countries {
+		dates := make(map[string]string, len(country.Holidays))
+		for _, holiday := range country.Holidays {
+			if _, err := time.Parse("2006-01-02", holiday.Date); err != nil {
+				return nil, fmt.Errorf("%s holiday %q: %w", code, holiday.Name, err)
+			}
+			dates[holiday.Date] = holiday.Name
+		}
+		calendar.byDate[code] = dates
+	}
+
+	return calendar, nil
+}
+
+// Default returns the calendar built from the embedded holiday data
+func Default() *WorkdayCalendar {
+	return defaultCalendar
+}
+
+// Years returns the first and last year the calendar covers
+func (c *WorkdayCalendar) Years() (first, last int) {
+	return c.firstYear, c.lastYear
+}
+
+// Countries returns the supported country codes, sorted
+func (c *WorkdayCalendar) Countries() []string {
+	codes := make([]string, 0, len(c.countries))
+	for code := range c.countries {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// GetHolidays returns the public holidays of a country in a year, sorted by date
+func (c *WorkdayCalendar) GetHolidays(country string, year int) ([]HolidayEntry, error) {
+	code, err := c.lookup(country, year)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%04d-", year)
+	var holidays []HolidayEntry
+	for _, holiday := range c.countries[code].Holidays {
+		if strings.HasPrefix(holiday.Date, prefix) {
+			holidays = append(holidays, holiday)
+		}
+	}
+	return holidays, nil
+}
+
+// IsHoliday reports whether the calendar date of date, in its location, is a public holiday in the
+// country, and the holiday's name if so
+func (c *WorkdayCalendar) IsHoliday(country string, date time.Time) (bool, string, error) {
+	code, err := c.lookup(country, date.Year())
+	if err != nil {
+		return false, "", err
+	}
+
+	name, ok := c.byDate[code][date.Format("2006-01-02")]
+	return ok, name, nil
+}
+
+// lookup normalizes a country code and checks the calendar covers the year
+func (c *WorkdayCalendar) lookup(country string, year int) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(country))
+	if alias, ok := countryAliases[code]; ok {
+		code = alias
+	}
+
+	if _, ok := c.countries[code]; !ok {
+		return "", fmt.Errorf("%w %q (supported: %s)", ErrUnknownCountry, country, strings.Join(c.Countries(), ", "))
+	}
+	if year < c.firstYear || year > c.lastYear {
+		return "", fmt.Errorf("%w: %d (holiday data covers %d-%d)", ErrYearNotCovered, year, c.firstYear, c.lastYear)
+	}
+	return code, nil
+}
+
+// GetHolidays returns the public holidays of a country in a year from the embedded data
+func GetHolidays(country string, year int) ([]HolidayEntry, error) {
+	return defaultCalendar.GetHolidays(country, year)
+}
+
+// IsHoliday reports whether a date is a public holiday in a country according to the embedded data
+func IsHoliday(country string, date time.Time) (bool, string, error) {
+	return defaultCalendar.IsHoliday(country, date)
+}
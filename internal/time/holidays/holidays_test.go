@@ -0,0 +1,170 @@
+package holidays
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultCalendar(t *testing.T) {
+	assert.Equal(t, []string{"AU", "BR", "CA", "CN", "DE", "FR", "IN", "JP", "UK", "US"}, Default().Countries())
+
+	first, last := Default().Years()
+	assert.Equal(t, 2020, first)
+	assert.Equal(t, 2030, last)
+
+	// Every country has holidays in every covered year, listed in date order
+	for _, country := range Default().Countries() {
+		for year := first; year <= last; year++ {
+			holidays, err := GetHolidays(country, year)
+			require.NoError(t, err)
+			require.NotEmpty(t, holidays, "%s %d", country, year)
+			for i := 1; i < len(holidays); i++ {
+				assert.Less(t, holidays[i-1].Date, holidays[i].Date, "%s %d", country, year)
+			}
+		}
+	}
+}
+
+func TestGetHolidays(t *testing.T) {
+	tests := []struct {
+		name          string
+		country       string
+		year          int
+		expectedCount int
+		expectedFirst HolidayEntry
+		expectedError error
+	}{
+		{
+			name:          "US federal holidays with observed days",
+			country:       "US",
+			year:          2022,
+			expectedCount: 13,
+			expectedFirst: HolidayEntry{Date: "2022-01-01", Name: "New Year's Day"},
+		},
+		{
+			name:          "lowercase country code",
+			country:       "de",
+			year:          2024,
+			expectedCount: 9,
+			expectedFirst: HolidayEntry{Date: "2024-01-01", Name: "Neujahr (New Year's Day)"},
+		},
+		{
+			name:          "GB is an alias of UK",
+			country:       "GB",
+			year:          2023,
+			expectedCount: 10,
+			expectedFirst: HolidayEntry{Date: "2023-01-01", Name: "New Year's Day"},
+		},
+		{
+			name:          "unknown country",
+			country:       "XX",
+			year:          2024,
+			expectedError: ErrUnknownCountry,
+		},
+		{
+			name:          "year not covered",
+			country:       "US",
+			year:          2031,
+			expectedError: ErrYearNotCovered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holidays, err := GetHolidays(tt.country, tt.year)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, holidays, tt.expectedCount)
+			assert.Equal(t, tt.expectedFirst, holidays[0])
+		})
+	}
+}
+
+func TestIsHoliday(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		country         string
+		date            time.Time
+		expectedHoliday bool
+		expectedName    string
+		expectError     bool
+	}{
+		{
+			name:            "Thanksgiving",
+			country:         "US",
+			date:            time.Date(2024, 11, 28, 15, 0, 0, 0, time.UTC),
+			expectedHoliday: true,
+			expectedName:    "Thanksgiving Day",
+		},
+		{
+			name:            "UK substitute day",
+			country:         "UK",
+			date:            time.Date(2022, 12, 27, 0, 0, 0, 0, time.UTC),
+			expectedHoliday: true,
+			expectedName:    "Christmas Day (substitute day)",
+		},
+		{
+			name:            "Easter based holiday",
+			country:         "BR",
+			date:            time.Date(2025, 4, 18, 0, 0, 0, 0, time.UTC),
+			expectedHoliday: true,
+			expectedName:    "Paixão de Cristo (Good Friday)",
+		},
+		{
+			name:            "Japanese citizens' holiday",
+			country:         "JP",
+			date:            time.Date(2026, 9, 22, 9, 0, 0, 0, tokyo),
+			expectedHoliday: true,
+			expectedName:    "国民の休日 (Citizens' Holiday)",
+		},
+		{
+			name:            "date is taken in its own location",
+			country:         "JP",
+			date:            time.Date(2026, 9, 23, 20, 0, 0, 0, time.UTC).In(tokyo), // 24 September in Tokyo
+			expectedHoliday: false,
+		},
+		{
+			name:            "Spring Festival",
+			country:         "CN",
+			date:            time.Date(2024, 2, 11, 0, 0, 0, 0, time.UTC),
+			expectedHoliday: true,
+			expectedName:    "春节 (Spring Festival)",
+		},
+		{
+			name:            "regular working day",
+			country:         "FR",
+			date:            time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+			expectedHoliday: false,
+		},
+		{
+			name:        "unknown country",
+			country:     "Atlantis",
+			date:        time.Date(2024, 3, 12, 0, 0, 0, 0, time.UTC),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			holiday, name, err := IsHoliday(tt.country, tt.date)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedHoliday, holiday)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package time
+
+import (
+	"time"
+)
+
+const (
+	// julianDateUnixEpoch is the Julian Date of the Unix epoch (1970-01-01T00:00:00Z)
+	julianDateUnixEpoch = 2440587.5
+
+	// modifiedJulianDateOffset is subtracted from a Julian Date to obtain the Modified Julian Date
+	modifiedJulianDateOffset = 2400000.5
+
+	secondsPerDay = 86400
+)
+
+// julianDate returns the Julian Date for t.
+// The computation uses the UTC time scale, which differs from TT by about a minute.
+func julianDate(t time.Time) float64 {
+	seconds := float64(t.Unix()) + float64(t.Nanosecond())/float64(time.Second)
+	return seconds/secondsPerDay + julianDateUnixEpoch
+}
+
+// modifiedJulianDate returns the Modified Julian Date for t
+func modifiedJulianDate(t time.Time) float64 {
+	return julianDate(t) - modifiedJulianDateOffset
+}
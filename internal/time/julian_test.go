@@ -0,0 +1,49 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_julianDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		time        time.Time
+		expectedJD  float64
+		expectedMJD float64
+	}{
+		{
+			name:        "J2000.0 epoch",
+			time:        time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC),
+			expectedJD:  2451545.0,
+			expectedMJD: 51544.5,
+		},
+		{
+			name:        "Unix epoch",
+			time:        time.Unix(0, 0),
+			expectedJD:  2440587.5,
+			expectedMJD: 40587.0,
+		},
+		{
+			name:        "MJD epoch",
+			time:        time.Date(1858, 11, 17, 0, 0, 0, 0, time.UTC),
+			expectedJD:  2400000.5,
+			expectedMJD: 0,
+		},
+		{
+			name:        "timezone does not change the instant",
+			time:        time.Date(2000, 1, 1, 7, 0, 0, 0, time.FixedZone("EST", -5*3600)),
+			expectedJD:  2451545.0,
+			expectedMJD: 51544.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedJD, julianDate(tt.time))
+			assert.Equal(t, tt.expectedMJD, modifiedJulianDate(tt.time))
+		})
+	}
+}
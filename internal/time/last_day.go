@@ -0,0 +1,56 @@
+package time
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// LastDayOfMonth returns the last day of a month, defaulting to the current month in the timezone
+func (s *timeService) LastDayOfMonth(ctx context.Context, input LastDayOfMonthInput) (LastDayOfMonthResult, error) {
+	if err := ctx.Err(); err != nil {
+		return LastDayOfMonthResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return LastDayOfMonthResult{}, err
+	}
+
+	now := s.clock.Now().In(loc)
+	year, month := input.Year, time.Month(input.Month)
+	if year == 0 {
+		year = now.Year()
+	}
+	if month == 0 {
+		month = now.Month()
+	}
+	if year < 1 || year > 9999 {
+		return LastDayOfMonthResult{}, validationErrorf(CodeInvalidArgument, "year must be between 1 and 9999, got %d", year)
+	}
+	if month < time.January || month > time.December {
+		return LastDayOfMonthResult{}, validationErrorf(CodeInvalidArgument, "month must be between 1 and 12, got %d", input.Month)
+	}
+
+	days := daysIn(month, year)
+	lastDay := time.Date(year, month, days, 0, 0, 0, 0, loc)
+
+	s.logger.Debug("Computed last day of month",
+		zap.Int("year", year),
+		zap.Int("month", int(month)),
+		zap.Int("days_in_month", days))
+
+	return LastDayOfMonthResult{
+		Date:        lastDay.Format("2006-01-02"),
+		DayOfWeek:   lastDay.Weekday().String(),
+		DayNumber:   days,
+		DaysInMonth: days,
+		Timezone:    loc.String(),
+		Warning:     warning,
+	}, nil
+}
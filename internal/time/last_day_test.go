@@ -0,0 +1,55 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_LastDayOfMonth(t *testing.T) {
+	// 2024-03-31 23:30 UTC is already April in Tokyo
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name              string
+		input             LastDayOfMonthInput
+		expectedDate      string
+		expectedDayOfWeek string
+		expectedDays      int
+		expectError       bool
+	}{
+		{name: "leap February", input: LastDayOfMonthInput{Year: 2024, Month: 2}, expectedDate: "2024-02-29", expectedDayOfWeek: "Thursday", expectedDays: 29},
+		{name: "common February", input: LastDayOfMonthInput{Year: 2023, Month: 2}, expectedDate: "2023-02-28", expectedDayOfWeek: "Tuesday", expectedDays: 28},
+		{name: "century February", input: LastDayOfMonthInput{Year: 1900, Month: 2}, expectedDate: "1900-02-28", expectedDayOfWeek: "Wednesday", expectedDays: 28},
+		{name: "December", input: LastDayOfMonthInput{Year: 2024, Month: 12}, expectedDate: "2024-12-31", expectedDayOfWeek: "Tuesday", expectedDays: 31},
+		{name: "thirty day month", input: LastDayOfMonthInput{Year: 2024, Month: 4}, expectedDate: "2024-04-30", expectedDayOfWeek: "Tuesday", expectedDays: 30},
+		{name: "current month", input: LastDayOfMonthInput{}, expectedDate: "2024-03-31", expectedDayOfWeek: "Sunday", expectedDays: 31},
+		{name: "current month in timezone", input: LastDayOfMonthInput{Timezone: "Asia/Tokyo"}, expectedDate: "2024-04-30", expectedDayOfWeek: "Tuesday", expectedDays: 30},
+		{name: "month only", input: LastDayOfMonthInput{Month: 2}, expectedDate: "2024-02-29", expectedDayOfWeek: "Thursday", expectedDays: 29},
+		{name: "month too large", input: LastDayOfMonthInput{Year: 2024, Month: 13}, expectError: true},
+		{name: "negative month", input: LastDayOfMonthInput{Year: 2024, Month: -1}, expectError: true},
+		{name: "year too large", input: LastDayOfMonthInput{Year: 10000, Month: 1}, expectError: true},
+		{name: "invalid timezone", input: LastDayOfMonthInput{Timezone: "Mars/Olympus"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.LastDayOfMonth(context.Background(), tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDate, result.Date)
+			assert.Equal(t, tt.expectedDayOfWeek, result.DayOfWeek)
+			assert.Equal(t, tt.expectedDays, result.DayNumber)
+			assert.Equal(t, tt.expectedDays, result.DaysInMonth)
+		})
+	}
+}
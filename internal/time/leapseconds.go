@@ -0,0 +1,68 @@
+package time
+
+import "time"
+
+// leapSecond records the TAI-UTC difference in effect from a UTC instant onwards
+type leapSecond struct {
+	effective   time.Time
+	taiMinusUTC int64
+}
+
+// leapSecondTable lists every change of TAI-UTC since leap seconds were introduced in 1972, as
+// published by the IETF in leap-seconds.list (https://data.iana.org/time-zones/tzdb/leap-seconds.list).
+// It must be extended when the IERS announces a new leap second.
+var leapSecondTable = []leapSecond{
+	{time.Date(1972, time.January, 1, 0, 0, 0, 0, time.UTC), 10},
+	{time.Date(1972, time.July, 1, 0, 0, 0, 0, time.UTC), 11},
+	{time.Date(1973, time.January, 1, 0, 0, 0, 0, time.UTC), 12},
+	{time.Date(1974, time.January, 1, 0, 0, 0, 0, time.UTC), 13},
+	{time.Date(1975, time.January, 1, 0, 0, 0, 0, time.UTC), 14},
+	{time.Date(1976, time.January, 1, 0, 0, 0, 0, time.UTC), 15},
+	{time.Date(1977, time.January, 1, 0, 0, 0, 0, time.UTC), 16},
+	{time.Date(1978, time.January, 1, 0, 0, 0, 0, time.UTC), 17},
+	{time.Date(1979, time.January, 1, 0, 0, 0, 0, time.UTC), 18},
+	{time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC), 19},
+	{time.Date(1981, time.July, 1, 0, 0, 0, 0, time.UTC), 20},
+	{time.Date(1982, time.July, 1, 0, 0, 0, 0, time.UTC), 21},
+	{time.Date(1983, time.July, 1, 0, 0, 0, 0, time.UTC), 22},
+	{time.Date(1985, time.July, 1, 0, 0, 0, 0, time.UTC), 23},
+	{time.Date(1988, time.January, 1, 0, 0, 0, 0, time.UTC), 24},
+	{time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC), 25},
+	{time.Date(1991, time.January, 1, 0, 0, 0, 0, time.UTC), 26},
+	{time.Date(1992, time.July, 1, 0, 0, 0, 0, time.UTC), 27},
+	{time.Date(1993, time.July, 1, 0, 0, 0, 0, time.UTC), 28},
+	{time.Date(1994, time.July, 1, 0, 0, 0, 0, time.UTC), 29},
+	{time.Date(1996, time.January, 1, 0, 0, 0, 0, time.UTC), 30},
+	{time.Date(1997, time.July, 1, 0, 0, 0, 0, time.UTC), 31},
+	{time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC), 32},
+	{time.Date(2006, time.January, 1, 0, 0, 0, 0, time.UTC), 33},
+	{time.Date(2009, time.January, 1, 0, 0, 0, 0, time.UTC), 34},
+	{time.Date(2012, time.July, 1, 0, 0, 0, 0, time.UTC), 35},
+	{time.Date(2015, time.July, 1, 0, 0, 0, 0, time.UTC), 36},
+	{time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), 37},
+}
+
+// taiMinusUTC returns the TAI-UTC difference in seconds at a UTC instant. Instants before 1972
+// use the initial 10 seconds.
+func taiMinusUTC(t time.Time) int64 {
+	offset := leapSecondTable[0].taiMinusUTC
+	for _, leap := range leapSecondTable {
+		if t.Before(leap.effective) {
+			break
+		}
+		offset = leap.taiMinusUTC
+	}
+	return offset
+}
+
+// utcFromTAISeconds converts seconds on the TAI scale (relative to the Unix epoch) back to UTC
+func utcFromTAISeconds(taiSeconds int64) int64 {
+	offset := leapSecondTable[0].taiMinusUTC
+	for _, leap := range leapSecondTable {
+		if taiSeconds < leap.effective.Unix()+leap.taiMinusUTC {
+			break
+		}
+		offset = leap.taiMinusUTC
+	}
+	return taiSeconds - offset
+}
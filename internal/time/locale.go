@@ -0,0 +1,145 @@
+package time
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// localeNames holds the translated weekday (Sunday first) and month (January first) names
+type localeNames struct {
+	weekdays      [7]string
+	weekdaysShort [7]string
+	months        [12]string
+	monthsShort   [12]string
+}
+
+// localeTables contains the supported locales, keyed by lowercase language tag
+var localeTables = map[string]localeNames{
+	"fr": {
+		weekdays:      [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		weekdaysShort: [7]string{"dim.", "lun.", "mar.", "mer.", "jeu.", "ven.", "sam."},
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin",
+			"juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		monthsShort: [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin",
+			"juil.", "août", "sept.", "oct.", "nov.", "déc."},
+	},
+	"de": {
+		weekdays:      [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		weekdaysShort: [7]string{"So.", "Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa."},
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni",
+			"Juli", "August", "September", "Oktober", "November", "Dezember"},
+		monthsShort: [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni",
+			"Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+	},
+	"es": {
+		weekdays:      [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		weekdaysShort: [7]string{"dom", "lun", "mar", "mié", "jue", "vie", "sáb"},
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio",
+			"julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		monthsShort: [12]string{"ene", "feb", "mar", "abr", "may", "jun",
+			"jul", "ago", "sept", "oct", "nov", "dic"},
+	},
+	"pt-br": {
+		weekdays: [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira",
+			"quinta-feira", "sexta-feira", "sábado"},
+		weekdaysShort: [7]string{"dom.", "seg.", "ter.", "qua.", "qui.", "sex.", "sáb."},
+		months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho",
+			"julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		monthsShort: [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.",
+			"jul.", "ago.", "set.", "out.", "nov.", "dez."},
+	},
+	"ja": {
+		weekdays:      [7]string{"日曜日", "月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日"},
+		weekdaysShort: [7]string{"日", "月", "火", "水", "木", "金", "土"},
+		months: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月"},
+		monthsShort: [12]string{"1月", "2月", "3月", "4月", "5月", "6月",
+			"7月", "8月", "9月", "10月", "11月", "12月"},
+	},
+}
+
+// lookupLocale resolves a BCP 47 style tag (e.g. "fr-FR", "pt_BR", "ja") to its name tables.
+// The full tag is tried before the bare language.
+func lookupLocale(locale string) (localeNames, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+
+	if names, ok := localeTables[tag]; ok {
+		return names, true
+	}
+
+	language, _, _ := strings.Cut(tag, "-")
+	if language == "pt" {
+		// Brazilian Portuguese is the only Portuguese variant available
+		return localeTables["pt-br"], true
+	}
+
+	names, ok := localeTables[language]
+	return names, ok
+}
+
+// isEnglishLocale reports whether the locale uses Go's built-in English names
+func isEnglishLocale(locale string) bool {
+	language, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(locale, "_", "-")), "-")
+	return language == "en"
+}
+
+// localizeNames replaces the English weekday and month names of t in a formatted string.
+// Full names take precedence over abbreviations, so "Monday" never becomes "lun.day".
+func localizeNames(formatted string, t time.Time, names localeNames) string {
+	weekday := t.Weekday()
+	month := t.Month()
+
+	replacer := strings.NewReplacer(
+		weekday.String(), names.weekdays[weekday],
+		month.String(), names.months[month-1],
+		weekday.String()[:3], names.weekdaysShort[weekday],
+		month.String()[:3], names.monthsShort[month-1],
+	)
+
+	return replacer.Replace(formatted)
+}
+
+// quarterOrdinals are the English ordinals of the four calendar quarters
+var quarterOrdinals = [4]string{"1st", "2nd", "3rd", "4th"}
+
+// setDateNames fills the English weekday, month and quarter names of a get_time result
+func setDateNames(result *GetTimeResult, t time.Time) {
+	quarter := (int(t.Month()) - 1) / 3
+
+	result.DayFull = t.Weekday().String()
+	result.DayAbbreviation = result.DayFull[:3]
+	result.MonthFull = t.Month().String()
+	result.MonthAbbreviation = result.MonthFull[:3]
+	result.MonthNumber = int(t.Month())
+	result.QuarterLabel = fmt.Sprintf("Q%d", quarter+1)
+	result.QuarterOrdinal = quarterOrdinals[quarter]
+}
+
+// applyLocale fills the localized name fields of a get_time result and translates the formatted time.
+// Unsupported locales fall back to English.
+func (s *timeService) applyLocale(result *GetTimeResult, t time.Time, locale string) {
+	names, ok := lookupLocale(locale)
+	if !ok {
+		if !isEnglishLocale(locale) {
+			s.logger.Warn("Unsupported locale, falling back to English",
+				zap.String("locale", locale))
+		}
+
+		result.Locale = "en"
+		result.Weekday = t.Weekday().String()
+		result.Month = t.Month().String()
+		return
+	}
+
+	result.Locale = locale
+	result.Weekday = names.weekdays[t.Weekday()]
+	result.Month = names.months[t.Month()-1]
+	result.DayFull = result.Weekday
+	result.DayAbbreviation = names.weekdaysShort[t.Weekday()]
+	result.MonthFull = result.Month
+	result.MonthAbbreviation = names.monthsShort[t.Month()-1]
+	result.FormattedTime = localizeNames(result.FormattedTime, t, names)
+}
@@ -0,0 +1,195 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetCurrentTime_Locale(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC) // a Monday
+	layout := "Monday, 2 January 2006 (Mon, Jan)"
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", layout}, logger, WithClock(FixedClock{Time: fixed}))
+
+	tests := []struct {
+		name            string
+		locale          string
+		format          string
+		expectedLocale  string
+		expectedWeekday string
+		expectedMonth   string
+		expectedTime    string
+	}{
+		{
+			name:            "french",
+			locale:          "fr-FR",
+			format:          layout,
+			expectedLocale:  "fr-FR",
+			expectedWeekday: "lundi",
+			expectedMonth:   "mars",
+			expectedTime:    "lundi, 4 mars 2024 (lun., mars)",
+		},
+		{
+			name:            "german",
+			locale:          "de-DE",
+			format:          layout,
+			expectedLocale:  "de-DE",
+			expectedWeekday: "Montag",
+			expectedMonth:   "März",
+			expectedTime:    "Montag, 4 März 2024 (Mo., März)",
+		},
+		{
+			name:            "spanish bare language",
+			locale:          "es",
+			format:          layout,
+			expectedLocale:  "es",
+			expectedWeekday: "lunes",
+			expectedMonth:   "marzo",
+			expectedTime:    "lunes, 4 marzo 2024 (lun, mar)",
+		},
+		{
+			name:            "brazilian portuguese with underscore",
+			locale:          "pt_BR",
+			format:          layout,
+			expectedLocale:  "pt_BR",
+			expectedWeekday: "segunda-feira",
+			expectedMonth:   "março",
+			expectedTime:    "segunda-feira, 4 março 2024 (seg., mar.)",
+		},
+		{
+			name:            "japanese",
+			locale:          "ja-JP",
+			format:          layout,
+			expectedLocale:  "ja-JP",
+			expectedWeekday: "月曜日",
+			expectedMonth:   "3月",
+			expectedTime:    "月曜日, 4 3月 2024 (月, 3月)",
+		},
+		{
+			name:            "numeric format is unchanged",
+			locale:          "fr-FR",
+			format:          "RFC3339",
+			expectedLocale:  "fr-FR",
+			expectedWeekday: "lundi",
+			expectedMonth:   "mars",
+			expectedTime:    "2024-03-04T09:30:00Z",
+		},
+		{
+			name:            "unsupported locale falls back to english",
+			locale:          "xx-YY",
+			format:          layout,
+			expectedLocale:  "en",
+			expectedWeekday: "Monday",
+			expectedMonth:   "March",
+			expectedTime:    "Monday, 4 March 2024 (Mon, Mar)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Locale: tt.locale, Format: tt.format})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedLocale, result.Locale)
+			assert.Equal(t, tt.expectedWeekday, result.Weekday)
+			assert.Equal(t, tt.expectedMonth, result.Month)
+			assert.Equal(t, tt.expectedTime, result.FormattedTime)
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_NoLocale(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Empty(t, result.Locale)
+	assert.Empty(t, result.Weekday)
+	assert.Empty(t, result.Month)
+}
+
+func TestTimeService_GetCurrentTime_DateNames(t *testing.T) {
+	tests := []struct {
+		name                      string
+		now                       time.Time
+		locale                    string
+		expectedDayAbbreviation   string
+		expectedDayFull           string
+		expectedMonthAbbreviation string
+		expectedMonthFull         string
+		expectedMonthNumber       int
+		expectedQuarterLabel      string
+		expectedQuarterOrdinal    string
+	}{
+		{
+			name:                      "english by default",
+			now:                       time.Date(2024, time.March, 4, 9, 30, 0, 0, time.UTC),
+			expectedDayAbbreviation:   "Mon",
+			expectedDayFull:           "Monday",
+			expectedMonthAbbreviation: "Mar",
+			expectedMonthFull:         "March",
+			expectedMonthNumber:       3,
+			expectedQuarterLabel:      "Q1",
+			expectedQuarterOrdinal:    "1st",
+		},
+		{
+			name:                      "second quarter",
+			now:                       time.Date(2024, time.June, 30, 9, 30, 0, 0, time.UTC),
+			expectedDayAbbreviation:   "Sun",
+			expectedDayFull:           "Sunday",
+			expectedMonthAbbreviation: "Jun",
+			expectedMonthFull:         "June",
+			expectedMonthNumber:       6,
+			expectedQuarterLabel:      "Q2",
+			expectedQuarterOrdinal:    "2nd",
+		},
+		{
+			name:                      "localized names",
+			now:                       time.Date(2024, time.September, 12, 9, 30, 0, 0, time.UTC),
+			locale:                    "fr-FR",
+			expectedDayAbbreviation:   "jeu.",
+			expectedDayFull:           "jeudi",
+			expectedMonthAbbreviation: "sept.",
+			expectedMonthFull:         "septembre",
+			expectedMonthNumber:       9,
+			expectedQuarterLabel:      "Q3",
+			expectedQuarterOrdinal:    "3rd",
+		},
+		{
+			name:                      "unsupported locale falls back to english",
+			now:                       time.Date(2024, time.December, 31, 9, 30, 0, 0, time.UTC),
+			locale:                    "xx-XX",
+			expectedDayAbbreviation:   "Tue",
+			expectedDayFull:           "Tuesday",
+			expectedMonthAbbreviation: "Dec",
+			expectedMonthFull:         "December",
+			expectedMonthNumber:       12,
+			expectedQuarterLabel:      "Q4",
+			expectedQuarterOrdinal:    "4th",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+				WithClock(FixedClock{Time: tt.now}))
+
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Locale: tt.locale})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedDayAbbreviation, result.DayAbbreviation)
+			assert.Equal(t, tt.expectedDayFull, result.DayFull)
+			assert.Equal(t, tt.expectedMonthAbbreviation, result.MonthAbbreviation)
+			assert.Equal(t, tt.expectedMonthFull, result.MonthFull)
+			assert.Equal(t, tt.expectedMonthNumber, result.MonthNumber)
+			assert.Equal(t, tt.expectedQuarterLabel, result.QuarterLabel)
+			assert.Equal(t, tt.expectedQuarterOrdinal, result.QuarterOrdinal)
+		})
+	}
+}
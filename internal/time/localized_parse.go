@@ -0,0 +1,172 @@
+package time
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// localizedMonthNames maps a language code to its lowercase month names (full, abbreviated, and
+// without diacritics) and their month numbers. It is derived from localeTables so parsing accepts
+// exactly the names formatting produces.
+var localizedMonthNames = buildLocalizedMonthNames()
+
+// localizedIgnoredWords maps a language code to lowercase words that carry no date information,
+// such as prepositions ("15 de marzo de 2024") and weekday names
+var localizedIgnoredWords = buildLocalizedIgnoredWords()
+
+// localizedConnectors are the prepositions and articles dropped from localized dates
+var localizedConnectors = map[string][]string{
+	"fr": {"le", "du"},
+	"de": {"den", "am"},
+	"es": {"de", "del", "el"},
+	"pt": {"de", "do", "em"},
+}
+
+// diacriticFolder removes the accents found in localized month names, so "fevrier" matches "février"
+var diacriticFolder = strings.NewReplacer(
+	"é", "e", "è", "e", "ê", "e", "û", "u", "ú", "u", "ü", "u",
+	"ä", "a", "á", "a", "â", "a", "ç", "c", "í", "i", "ó", "o", "ô", "o",
+)
+
+// localizedOrdinal matches day ordinals such as the French "1er" or the Spanish and Portuguese "1º"
+var localizedOrdinal = regexp.MustCompile(`^(\d{1,2})(?:er|re|º|°|ª|\.)$`)
+
+// localizedClock matches the French "14h30" notation for times of day
+var localizedClock = regexp.MustCompile(`^(\d{1,2})h(\d{2})?$`)
+
+// buildLocalizedMonthNames indexes the month names of every locale by language code
+func buildLocalizedMonthNames() map[string]map[string]int {
+	tables := make(map[string]map[string]int, len(localeTables))
+	for tag, names := range localeTables {
+		language, _, _ := strings.Cut(tag, "-")
+		months := make(map[string]int)
+		for i := 0; i < 12; i++ {
+			for _, name := range []string{names.months[i], names.monthsShort[i]} {
+				name = strings.ToLower(name)
+				months[name] = i + 1
+				months[strings.TrimSuffix(name, ".")] = i + 1
+				months[diacriticFolder.Replace(strings.TrimSuffix(name, "."))] = i + 1
+			}
+		}
+		tables[language] = months
+	}
+	return tables
+}
+
+// buildLocalizedIgnoredWords collects the connectors and weekday names of every locale
+func buildLocalizedIgnoredWords() map[string]map[string]bool {
+	tables := make(map[string]map[string]bool, len(localeTables))
+	for tag, names := range localeTables {
+		language, _, _ := strings.Cut(tag, "-")
+		words := make(map[string]bool)
+		for _, word := range localizedConnectors[language] {
+			words[word] = true
+		}
+		for i := 0; i < 7; i++ {
+			for _, name := range []string{names.weekdays[i], names.weekdaysShort[i]} {
+				name = strings.ToLower(name)
+				words[name] = true
+				words[strings.TrimSuffix(name, ".")] = true
+				words[diacriticFolder.Replace(strings.TrimSuffix(name, "."))] = true
+			}
+		}
+		tables[language] = words
+	}
+	return tables
+}
+
+// normalizeLocalizedDate rewrites a localized date such as "15 mars 2024", "15 de marzo de 2024"
+// or "2024年3月15日 14:30" as ISO 8601, replacing the month name with its number. It returns the
+// normalized string and the Go layout that parses it.
+func normalizeLocalizedDate(value, language string) (string, string, error) {
+	lang, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(language, "_", "-")), "-")
+	months, ok := localizedMonthNames[lang]
+	if !ok {
+		return "", "", fmt.Errorf("unsupported language %s", language)
+	}
+	ignored := localizedIgnoredWords[lang]
+
+	// Japanese dates have no spaces: split after the year and month markers, keeping "3月" intact
+	value = strings.NewReplacer("年", " ", "月", "月 ", "日", " ", ",", " ").Replace(strings.ToLower(value))
+
+	year, month, day, ambiguousMonth := 0, 0, 0, 0
+	clock := ""
+	for _, token := range strings.Fields(value) {
+		if m := localizedOrdinal.FindStringSubmatch(token); m != nil {
+			token = m[1]
+		}
+		if m := localizedClock.FindStringSubmatch(token); m != nil && lang == "fr" {
+			minutes := m[2]
+			if minutes == "" {
+				minutes = "00"
+			}
+			token = m[1] + ":" + minutes
+		}
+
+		number, isMonth := lookupMonthName(months, token)
+		isIgnored := ignored[token] || ignored[strings.TrimSuffix(token, ".")]
+
+		switch {
+		case isMonth && isIgnored:
+			// Spanish "mar" is both martes and marzo: only a month if no other month name appears
+			ambiguousMonth = number
+		case isMonth:
+			if month != 0 {
+				return "", "", fmt.Errorf("more than one month name in %q", value)
+			}
+			month = number
+		case isIgnored:
+			continue
+		case strings.Contains(token, ":"):
+			clock = token
+		case isDigits(token) && len(token) == 4 && year == 0:
+			year, _ = strconv.Atoi(token)
+		case isDigits(token) && len(token) <= 2 && day == 0:
+			day, _ = strconv.Atoi(token)
+		default:
+			return "", "", fmt.Errorf("unrecognized word %q", token)
+		}
+	}
+
+	if month == 0 {
+		month = ambiguousMonth
+	}
+	if year == 0 || month == 0 || day == 0 {
+		return "", "", fmt.Errorf("expected a day, a month name and a year")
+	}
+
+	normalized := fmt.Sprintf("%04d-%02d-%02d", year, month, day)
+	switch strings.Count(clock, ":") {
+	case 0:
+		return normalized, "2006-01-02", nil
+	case 1:
+		return normalized + " " + clock, "2006-01-02 15:04", nil
+	default:
+		return normalized + " " + clock, "2006-01-02 15:04:05", nil
+	}
+}
+
+// lookupMonthName finds a month name token, with or without its abbreviation dot or diacritics
+func lookupMonthName(months map[string]int, token string) (int, bool) {
+	for _, candidate := range []string{token, strings.TrimSuffix(token, "."), diacriticFolder.Replace(strings.TrimSuffix(token, "."))} {
+		if number, ok := months[candidate]; ok {
+			return number, true
+		}
+	}
+	return 0, false
+}
+
+// isDigits reports whether s is a non-empty string of ASCII digits
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
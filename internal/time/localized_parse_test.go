@@ -0,0 +1,81 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestNormalizeLocalizedDate(t *testing.T) {
+	tests := []struct {
+		name           string
+		value          string
+		language       string
+		expected       string
+		expectedLayout string
+		wantErr        bool
+	}{
+		{name: "french", value: "15 mars 2024", language: "fr", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "french ordinal", value: "1er janvier 2024", language: "fr", expected: "2024-01-01", expectedLayout: "2006-01-02"},
+		{name: "french weekday and time", value: "vendredi 15 mars 2024 14h30", language: "fr", expected: "2024-03-15 14:30", expectedLayout: "2006-01-02 15:04"},
+		{name: "french without accents", value: "3 fevrier 2024", language: "fr-FR", expected: "2024-02-03", expectedLayout: "2006-01-02"},
+		{name: "spanish", value: "15 de marzo de 2024", language: "es", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "spanish abbreviated weekday", value: "mar 15 de marzo de 2024", language: "es", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "spanish ambiguous abbreviation", value: "15 mar 2024", language: "es", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "german", value: "15. März 2024 09:05:30", language: "de", expected: "2024-03-15 09:05:30", expectedLayout: "2006-01-02 15:04:05"},
+		{name: "portuguese", value: "15 de março de 2024", language: "pt", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "portuguese ordinal", value: "1º de maio de 2024", language: "pt-BR", expected: "2024-05-01", expectedLayout: "2006-01-02"},
+		{name: "japanese", value: "2024年3月15日", language: "ja", expected: "2024-03-15", expectedLayout: "2006-01-02"},
+		{name: "japanese with time", value: "2024年12月1日 08:00", language: "ja", expected: "2024-12-01 08:00", expectedLayout: "2006-01-02 15:04"},
+		{name: "unsupported language", value: "15 March 2024", language: "xx", wantErr: true},
+		{name: "missing month", value: "15 2024", language: "fr", wantErr: true},
+		{name: "unknown word", value: "15 marzo 2024", language: "fr", wantErr: true},
+		{name: "two months", value: "15 mars avril 2024", language: "fr", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			normalized, layout, err := normalizeLocalizedDate(tt.value, tt.language)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, normalized)
+			assert.Equal(t, tt.expectedLayout, layout)
+		})
+	}
+}
+
+func TestTimeService_ParseTime_Language(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	t.Run("localized date in timezone", func(t *testing.T) {
+		result, err := service.ParseTime(context.Background(), ParseTimeInput{
+			TimeString: "15 de marzo de 2024",
+			Language:   "es",
+			Timezone:   "Europe/Madrid",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, time.Date(2024, 3, 15, 0, 0, 0, 0, time.FixedZone("CET", 3600)).Unix(), result.UnixTimestamp)
+		assert.Equal(t, "2024-03-15T00:00:00+01:00", result.RFC3339)
+	})
+
+	t.Run("invalid day", func(t *testing.T) {
+		_, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "31 février 2024", Language: "fr"})
+		assert.Error(t, err)
+	})
+
+	t.Run("format and language", func(t *testing.T) {
+		_, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "15 mars 2024", Language: "fr", Format: "RFC3339"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot both be set")
+	})
+}
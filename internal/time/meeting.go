@@ -0,0 +1,222 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultMeetingDurationMinutes = 60
+	defaultMeetingSearchDays      = 5
+	maxMeetingSearchDays          = 14
+	maxMeetingParticipants        = 20
+	maxMeetingSuggestions         = 5
+
+	// meetingSlotStep is how far the meeting window slides between candidate slots
+	meetingSlotStep = 30 * time.Minute
+)
+
+// meetingParticipant is a participant with their timezone loaded and defaults applied
+type meetingParticipant struct {
+	timezone  string
+	location  *time.Location
+	workStart int
+	workEnd   int
+	weight    float64
+}
+
+// FindOptimalMeetingTime slides a meeting-sized window over the search period and ranks the
+// slots by the share of participant weight that is within working hours for the whole meeting
+func (s *timeService) FindOptimalMeetingTime(ctx context.Context, input OptimalMeetingTimeInput) (OptimalMeetingTimeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return OptimalMeetingTimeResult{}, err
+	}
+
+	if len(input.Participants) == 0 {
+		return OptimalMeetingTimeResult{}, validationErrorf(CodeMissingArgument, "participants are required")
+	}
+	if len(input.Participants) > maxMeetingParticipants {
+		return OptimalMeetingTimeResult{}, validationErrorf(CodeLimitExceeded, "too many participants: %d (max %d)", len(input.Participants), maxMeetingParticipants)
+	}
+
+	duration := defaultMeetingDurationMinutes
+	if input.DurationMinutes != 0 {
+		duration = input.DurationMinutes
+	}
+	if duration < 0 || duration > 24*60 {
+		return OptimalMeetingTimeResult{}, validationErrorf(CodeInvalidArgument, "invalid duration_minutes %d: must be between 1 and 1440", duration)
+	}
+
+	searchDays := defaultMeetingSearchDays
+	if input.SearchDays != 0 {
+		searchDays = input.SearchDays
+	}
+	if searchDays < 0 || searchDays > maxMeetingSearchDays {
+		return OptimalMeetingTimeResult{}, validationErrorf(CodeInvalidArgument, "invalid search_days %d: must be between 1 and %d", searchDays, maxMeetingSearchDays)
+	}
+
+	participants, warnings, err := s.loadMeetingParticipants(input.Participants)
+	if err != nil {
+		return OptimalMeetingTimeResult{}, err
+	}
+
+	// The search starts at midnight UTC of the reference date, today by default
+	searchStart := truncateToDate(s.clock.Now().UTC())
+	if input.ReferenceDate != "" {
+		searchStart, err = time.ParseInLocation("2006-01-02", input.ReferenceDate, time.UTC)
+		if err != nil {
+			return OptimalMeetingTimeResult{}, parseErrorf(CodeInvalidTimestamp, "invalid reference_date %s (expected YYYY-MM-DD): %w", input.ReferenceDate, err)
+		}
+	}
+	searchEnd := searchStart.AddDate(0, 0, searchDays)
+	meetingLength := time.Duration(duration) * time.Minute
+
+	s.logger.Debug("Finding optimal meeting time",
+		zap.Int("participants", len(participants)),
+		zap.Int("duration_minutes", duration),
+		zap.Int("search_days", searchDays),
+		zap.String("reference_date", searchStart.Format("2006-01-02")))
+
+	// Participants are available on their working hours from Monday to Friday
+	workDays, _ := parseWorkDays(nil)
+
+	totalWeight := 0.0
+	for _, participant := range participants {
+		totalWeight += participant.weight
+	}
+
+	var candidates []MeetingSuggestion
+	for start := searchStart; !start.Add(meetingLength).After(searchEnd); start = start.Add(meetingSlotStep) {
+		if err := ctx.Err(); err != nil {
+			return OptimalMeetingTimeResult{}, err
+		}
+
+		suggestion := scoreMeetingSlot(participants, workDays, start, start.Add(meetingLength), totalWeight)
+		if suggestion.AvailableParticipants > 0 {
+			candidates = append(candidates, suggestion)
+		}
+	}
+
+	return OptimalMeetingTimeResult{
+		Suggestions:     rankMeetingSuggestions(candidates),
+		ReferenceDate:   searchStart.Format("2006-01-02"),
+		DurationMinutes: duration,
+		SearchDays:      searchDays,
+		Warning:         joinWarnings(warnings...),
+	}, nil
+}
+
+// loadMeetingParticipants validates the participants and applies the default work hours and weight
+func (s *timeService) loadMeetingParticipants(locations []ParticipantLocation) ([]meetingParticipant, []string, error) {
+	participants := make([]meetingParticipant, 0, len(locations))
+	var warnings []string
+
+	for i, location := range locations {
+		if location.Timezone == "" {
+			return nil, nil, validationErrorf(CodeMissingArgument, "participant %d: timezone is required", i)
+		}
+
+		loc, warning, err := s.loadLocation(location.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("participant %d: %w", i, err)
+		}
+		warnings = append(warnings, warning)
+
+		workStart, workEnd := location.WorkStart, location.WorkEnd
+		if workStart == 0 && workEnd == 0 {
+			workStart, workEnd = defaultWorkStartHour, defaultWorkEndHour
+		}
+		if workStart < 0 || workEnd > 24 || workStart >= workEnd {
+			return nil, nil, validationErrorf(CodeInvalidArgument, "participant %d: invalid working hours %d-%d: start must be before end, within 0-24", i, workStart, workEnd)
+		}
+
+		weight := location.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		if weight < 0 {
+			return nil, nil, validationErrorf(CodeInvalidArgument, "participant %d: weight must not be negative", i)
+		}
+
+		participants = append(participants, meetingParticipant{
+			timezone:  location.Timezone,
+			location:  loc,
+			workStart: workStart,
+			workEnd:   workEnd,
+			weight:    weight,
+		})
+	}
+
+	return participants, warnings, nil
+}
+
+// scoreMeetingSlot reports which participants are working for the whole slot and their share of
+// the total weight
+func scoreMeetingSlot(participants []meetingParticipant, workDays map[time.Weekday]bool, start, end time.Time, totalWeight float64) MeetingSuggestion {
+	suggestion := MeetingSuggestion{
+		StartUTC:   start.Format(time.RFC3339),
+		EndUTC:     end.Format(time.RFC3339),
+		LocalTimes: make([]MeetingLocalTime, 0, len(participants)),
+	}
+
+	coveredWeight := 0.0
+	for _, participant := range participants {
+		localStart := start.In(participant.location)
+		localEnd := end.In(participant.location)
+
+		available := false
+		if workDays[localStart.Weekday()] {
+			workStart, workEnd := workWindow(localStart, participant.workStart, participant.workEnd)
+			available = !localStart.Before(workStart) && !localEnd.After(workEnd)
+		}
+		if available {
+			coveredWeight += participant.weight
+			suggestion.AvailableParticipants++
+		}
+
+		suggestion.LocalTimes = append(suggestion.LocalTimes, MeetingLocalTime{
+			Timezone:        participant.timezone,
+			Start:           localStart.Format(time.RFC3339),
+			End:             localEnd.Format(time.RFC3339),
+			WithinWorkHours: available,
+		})
+	}
+
+	if totalWeight > 0 {
+		suggestion.CoverageScore = coveredWeight / totalWeight
+	}
+	return suggestion
+}
+
+// rankMeetingSuggestions orders slots by coverage score, earliest first among equal scores, and
+// keeps the best slots that do not overlap an already chosen one
+func rankMeetingSuggestions(candidates []MeetingSuggestion) []MeetingSuggestion {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].CoverageScore > candidates[j].CoverageScore
+	})
+
+	suggestions := make([]MeetingSuggestion, 0, maxMeetingSuggestions)
+	for _, candidate := range candidates {
+		if len(suggestions) == maxMeetingSuggestions {
+			break
+		}
+
+		overlaps := false
+		for _, chosen := range suggestions {
+			// RFC3339 UTC strings order the same way as the times they represent
+			if candidate.StartUTC < chosen.EndUTC && chosen.StartUTC < candidate.EndUTC {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			suggestions = append(suggestions, candidate)
+		}
+	}
+
+	return suggestions
+}
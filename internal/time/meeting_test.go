@@ -0,0 +1,167 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_FindOptimalMeetingTime(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger,
+		WithClock(FixedClock{Time: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name              string
+		input             OptimalMeetingTimeInput
+		wantErr           bool
+		expectedStarts    []string
+		expectedScores    []float64
+		expectedAvailable []int
+	}{
+		{
+			name: "new york and london share three hours",
+			input: OptimalMeetingTimeInput{
+				Participants: []ParticipantLocation{
+					{Timezone: "America/New_York"},
+					{Timezone: "Europe/London"},
+				},
+				SearchDays: 1,
+			},
+			expectedStarts:    []string{"2024-03-04T14:00:00Z", "2024-03-04T15:00:00Z", "2024-03-04T16:00:00Z", "2024-03-04T09:00:00Z", "2024-03-04T10:00:00Z"},
+			expectedScores:    []float64{1, 1, 1, 0.5, 0.5},
+			expectedAvailable: []int{2, 2, 2, 1, 1},
+		},
+		{
+			name: "weights favor the heavier participant",
+			input: OptimalMeetingTimeInput{
+				Participants: []ParticipantLocation{
+					{Timezone: "Asia/Tokyo", Weight: 1},
+					{Timezone: "America/New_York", Weight: 3},
+				},
+				DurationMinutes: 120,
+				SearchDays:      1,
+				ReferenceDate:   "2024-03-05",
+			},
+			expectedStarts:    []string{"2024-03-05T14:00:00Z", "2024-03-05T16:00:00Z", "2024-03-05T18:00:00Z", "2024-03-05T20:00:00Z", "2024-03-05T00:00:00Z"},
+			expectedScores:    []float64{0.75, 0.75, 0.75, 0.75, 0.25},
+			expectedAvailable: []int{1, 1, 1, 1, 1},
+		},
+		{
+			name: "custom work hours",
+			input: OptimalMeetingTimeInput{
+				Participants: []ParticipantLocation{
+					{Timezone: "Europe/Paris", WorkStart: 7, WorkEnd: 9},
+					{Timezone: "Asia/Kolkata", WorkStart: 11, WorkEnd: 13},
+				},
+				DurationMinutes: 30,
+				SearchDays:      1,
+			},
+			expectedStarts:    []string{"2024-03-04T06:00:00Z", "2024-03-04T06:30:00Z", "2024-03-04T07:00:00Z", "2024-03-04T05:30:00Z", "2024-03-04T07:30:00Z"},
+			expectedScores:    []float64{1, 1, 1, 0.5, 0.5},
+			expectedAvailable: []int{2, 2, 2, 1, 1},
+		},
+		{
+			name: "weekend has no suggestions",
+			input: OptimalMeetingTimeInput{
+				Participants:  []ParticipantLocation{{Timezone: "Europe/Berlin"}},
+				SearchDays:    1,
+				ReferenceDate: "2024-03-09",
+			},
+			expectedStarts: []string{},
+		},
+		{
+			name:    "no participants",
+			input:   OptimalMeetingTimeInput{},
+			wantErr: true,
+		},
+		{
+			name:    "missing timezone",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{WorkStart: 9, WorkEnd: 17}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "Mars/Olympus"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid work hours",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "UTC", WorkStart: 18, WorkEnd: 9}}},
+			wantErr: true,
+		},
+		{
+			name:    "negative weight",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "UTC", Weight: -1}}},
+			wantErr: true,
+		},
+		{
+			name:    "too many search days",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "UTC"}}, SearchDays: 30},
+			wantErr: true,
+		},
+		{
+			name:    "negative duration",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "UTC"}}, DurationMinutes: -30},
+			wantErr: true,
+		},
+		{
+			name:    "invalid reference date",
+			input:   OptimalMeetingTimeInput{Participants: []ParticipantLocation{{Timezone: "UTC"}}, ReferenceDate: "04/03/2024"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.FindOptimalMeetingTime(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			starts := make([]string, 0, len(result.Suggestions))
+			scores := make([]float64, 0, len(result.Suggestions))
+			available := make([]int, 0, len(result.Suggestions))
+			for _, suggestion := range result.Suggestions {
+				starts = append(starts, suggestion.StartUTC)
+				scores = append(scores, suggestion.CoverageScore)
+				available = append(available, suggestion.AvailableParticipants)
+				assert.Len(t, suggestion.LocalTimes, len(tt.input.Participants))
+			}
+			assert.Equal(t, tt.expectedStarts, starts)
+			if len(tt.expectedScores) > 0 {
+				assert.InDeltaSlice(t, tt.expectedScores, scores, 1e-9)
+				assert.Equal(t, tt.expectedAvailable, available)
+			}
+		})
+	}
+}
+
+func TestTimeService_FindOptimalMeetingTime_Defaults(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)}))
+
+	result, err := service.FindOptimalMeetingTime(context.Background(), OptimalMeetingTimeInput{
+		Participants: []ParticipantLocation{{Timezone: "America/New_York"}, {Timezone: "Europe/London"}},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-04", result.ReferenceDate)
+	assert.Equal(t, 60, result.DurationMinutes)
+	assert.Equal(t, 5, result.SearchDays)
+	require.NotEmpty(t, result.Suggestions)
+
+	best := result.Suggestions[0]
+	assert.Equal(t, "2024-03-04T15:00:00Z", best.EndUTC)
+	assert.Equal(t, []MeetingLocalTime{
+		{Timezone: "America/New_York", Start: "2024-03-04T09:00:00-05:00", End: "2024-03-04T10:00:00-05:00", WithinWorkHours: true},
+		{Timezone: "Europe/London", Start: "2024-03-04T14:00:00Z", End: "2024-03-04T15:00:00Z", WithinWorkHours: true},
+	}, best.LocalTimes)
+}
@@ -0,0 +1,46 @@
+//go:build !production
+
+package time
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// MockModeAvailable reports whether this binary was built with mock mode support. Builds
+// with the "production" tag leave mock mode out entirely.
+const MockModeAvailable = true
+
+// MockNow is the current time reported by every tool in mock mode
+var MockNow = time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+// mockTimeService returns canned responses so LLM test suites get the same answers on every
+// run. Operations that depend on the current time run against a clock frozen at MockNow;
+// parsing always resolves to MockNow regardless of the input.
+type mockTimeService struct {
+	TimeService
+}
+
+// NewMockTimeService creates a TimeService for mock mode
+func NewMockTimeService(defaultTimezone, defaultFormat string, supportedFormats []string, logger *zap.Logger, opts ...Option) (TimeService, error) {
+	opts = append(opts, WithClock(FixedClock{Time: MockNow}))
+	return &mockTimeService{
+		TimeService: NewTimeService(defaultTimezone, defaultFormat, supportedFormats, logger, opts...),
+	}, nil
+}
+
+// ParseTime always returns MockNow in UTC
+func (s *mockTimeService) ParseTime(ctx context.Context, input ParseTimeInput) (ParseTimeResult, error) {
+	if err := ctx.Err(); err != nil {
+		return ParseTimeResult{}, err
+	}
+
+	return ParseTimeResult{
+		UnixTimestamp: MockNow.Unix(),
+		RFC3339:       MockNow.Format(time.RFC3339),
+		UTCTime:       MockNow.UTC().Format(time.RFC3339),
+		Timezone:      MockNow.Location().String(),
+	}, nil
+}
@@ -0,0 +1,18 @@
+//go:build production
+
+package time
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// MockModeAvailable reports whether this binary was built with mock mode support. Builds
+// with the "production" tag leave mock mode out entirely.
+const MockModeAvailable = false
+
+// NewMockTimeService always fails in production builds
+func NewMockTimeService(defaultTimezone, defaultFormat string, supportedFormats []string, logger *zap.Logger, opts ...Option) (TimeService, error) {
+	return nil, errors.New("mock mode is not available in production builds")
+}
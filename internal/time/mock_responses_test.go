@@ -0,0 +1,54 @@
+//go:build !production
+
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestMockTimeService(t *testing.T) {
+	service, err := NewMockTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	t.Run("get_time returns the canned time", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-15T12:00:00Z", result.FormattedTime)
+		assert.Equal(t, MockNow.Unix(), result.UnixTimestamp)
+	})
+
+	t.Run("get_time honours the requested timezone", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "Asia/Tokyo"})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-15T21:00:00+09:00", result.FormattedTime)
+	})
+
+	t.Run("parse_time always resolves to the canned time", func(t *testing.T) {
+		for _, input := range []string{"2023-12-25T15:30:45Z", "1703518245", "not a time"} {
+			result, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: input})
+			require.NoError(t, err)
+			assert.Equal(t, MockNow.Unix(), result.UnixTimestamp)
+			assert.Equal(t, "2024-01-15T12:00:00Z", result.RFC3339)
+		}
+	})
+
+	t.Run("relative operations use the frozen clock", func(t *testing.T) {
+		result, err := service.FormatTime(context.Background(), FormatTimeInput{OffsetFromNow: "1h", Format: "RFC3339"})
+		require.NoError(t, err)
+		assert.Equal(t, MockNow.Add(time.Hour).Format(time.RFC3339), result.FormattedTime)
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := service.ParseTime(ctx, ParseTimeInput{TimeString: "now"})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
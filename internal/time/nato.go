@@ -0,0 +1,32 @@
+package time
+
+import "time"
+
+// natoLocalLetter is the NATO designator for local time, used when the offset has no letter
+const natoLocalLetter = "J"
+
+// natoTimezoneLetters maps whole-hour UTC offsets to NATO time zone letters: Zulu for UTC,
+// Alfa through Mike (skipping Juliett) east of Greenwich and November through Yankee west of it
+var natoTimezoneLetters = map[int]string{
+	0: "Z",
+	1: "A", 2: "B", 3: "C", 4: "D", 5: "E", 6: "F", 7: "G", 8: "H", 9: "I", 10: "K", 11: "L", 12: "M",
+	-1: "N", -2: "O", -3: "P", -4: "Q", -5: "R", -6: "S", -7: "T", -8: "U", -9: "V", -10: "W", -11: "X", -12: "Y",
+}
+
+// natoTimezoneLetter returns the NATO letter for a UTC offset in seconds. Offsets that are not
+// a whole number of hours between -12 and +12 have no letter and are reported as local time (J).
+func natoTimezoneLetter(offsetSeconds int) string {
+	if offsetSeconds%3600 != 0 {
+		return natoLocalLetter
+	}
+	if letter, ok := natoTimezoneLetters[offsetSeconds/3600]; ok {
+		return letter
+	}
+	return natoLocalLetter
+}
+
+// natoTime formats t as military time followed by its NATO zone letter, e.g. "1500R"
+func natoTime(t time.Time) string {
+	_, offset := t.Zone()
+	return t.Format("1504") + natoTimezoneLetter(offset)
+}
@@ -0,0 +1,70 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_natoTimezoneLetter(t *testing.T) {
+	tests := []struct {
+		name     string
+		hours    float64
+		expected string
+	}{
+		{"UTC is Zulu", 0, "Z"},
+		{"UTC+1 is Alfa", 1, "A"},
+		{"UTC+9 is India", 9, "I"},
+		{"UTC+10 skips Juliett", 10, "K"},
+		{"UTC+12 is Mike", 12, "M"},
+		{"UTC-1 is November", -1, "N"},
+		{"UTC-5 is Romeo", -5, "R"},
+		{"UTC-12 is Yankee", -12, "Y"},
+		{"half-hour offset is local", 5.5, "J"},
+		{"UTC+14 has no letter", 14, "J"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, natoTimezoneLetter(int(tt.hours*3600)))
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_IncludeNATO(t *testing.T) {
+	now := time.Date(2024, 3, 15, 20, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	tests := []struct {
+		timezone     string
+		expectedZone string
+		expectedTime string
+	}{
+		{"UTC", "Z", "2000Z"},
+		{"America/New_York", "Q", "1600Q"}, // EDT, UTC-4
+		{"America/Chicago", "R", "1500R"},  // CDT, UTC-5
+		{"Asia/Tokyo", "I", "0500I"},
+		{"Asia/Kolkata", "J", "0130J"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: tt.timezone, IncludeNATO: true})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedZone, result.NATOTimezone)
+			assert.Equal(t, tt.expectedTime, result.NATOTime)
+		})
+	}
+
+	t.Run("omitted by default", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+		require.NoError(t, err)
+		assert.Empty(t, result.NATOTimezone)
+		assert.Empty(t, result.NATOTime)
+	})
+}
@@ -0,0 +1,70 @@
+package time
+
+import (
+	"fmt"
+	"net"
+	"sync/atomic"
+	"time"
+
+	"github.com/beevik/ntp"
+	"go.uber.org/zap"
+)
+
+// NTPTimeSource queries an NTP server on every call to Now, correcting the local clock by the
+// measured offset. When the server cannot be reached it falls back to the system clock, and
+// IsAuthoritative reports false until a query succeeds again.
+type NTPTimeSource struct {
+	server        string
+	timeout       time.Duration
+	logger        *zap.Logger
+	authoritative atomic.Bool
+}
+
+// NewNTPTimeSource creates a time source backed by an NTP server, given as "host" or
+// "host:port". The timeout bounds each query.
+func NewNTPTimeSource(server string, timeout time.Duration, logger *zap.Logger) *NTPTimeSource {
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	return &NTPTimeSource{server: server, timeout: timeout, logger: logger}
+}
+
+// Now returns the NTP-corrected current time, or the system time when the query fails
+func (s *NTPTimeSource) Now() time.Time {
+	offset, err := s.queryOffset()
+	if err != nil {
+		s.authoritative.Store(false)
+		s.logger.Warn("NTP query failed, falling back to the system clock",
+			zap.String("server", s.server),
+			zap.Error(err))
+		return time.Now()
+	}
+
+	s.authoritative.Store(true)
+	return time.Now().Add(offset)
+}
+
+// IsAuthoritative reports whether the most recent NTP query succeeded
+func (s *NTPTimeSource) IsAuthoritative() bool {
+	return s.authoritative.Load()
+}
+
+// Name returns "ntp"
+func (s *NTPTimeSource) Name() string {
+	return "ntp"
+}
+
+// queryOffset queries the NTP server and returns the offset of its clock from the local clock
+func (s *NTPTimeSource) queryOffset() (time.Duration, error) {
+	resp, err := ntp.QueryWithOptions(s.server, ntp.QueryOptions{Timeout: s.timeout})
+	if err != nil {
+		return 0, fmt.Errorf("failed to query NTP server: %w", err)
+	}
+
+	// Validate rejects unsynchronized servers and kiss-of-death responses
+	if err := resp.Validate(); err != nil {
+		return 0, fmt.Errorf("invalid NTP response: %w", err)
+	}
+
+	return resp.ClockOffset, nil
+}
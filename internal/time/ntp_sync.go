@@ -0,0 +1,162 @@
+package time
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/beevik/ntp"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// defaultNTPSyncTimeout bounds each server query when no timeout is given
+	defaultNTPSyncTimeout = 2 * time.Second
+
+	// maxNTPSyncTimeout is the longest timeout a caller may request
+	maxNTPSyncTimeout = 10 * time.Second
+
+	// maxNTPSyncServers limits how many servers one check may query
+	maxNTPSyncServers = 10
+
+	// ntpSyncTolerance is the largest disagreement between servers still considered synchronized
+	ntpSyncTolerance = 100 * time.Millisecond
+)
+
+// DefaultNTPSyncServers are the servers check_ntp_sync may query unless WithNTPSyncServers
+// configures others
+var DefaultNTPSyncServers = []string{"0.pool.ntp.org", "1.pool.ntp.org", "2.pool.ntp.org"}
+
+// WithNTPSyncServers sets the NTP servers CheckNTPSync may query. They are queried when the input
+// names no servers, and servers named in the input must be among them so callers cannot make the
+// service send UDP packets to arbitrary hosts. An empty list keeps DefaultNTPSyncServers.
+func WithNTPSyncServers(servers []string) Option {
+	return func(s *timeService) {
+		if len(servers) > 0 {
+			s.ntpSyncServers = servers
+		}
+	}
+}
+
+// ntpServerKey normalizes an NTP server address for comparison, defaulting the port to 123
+func ntpServerKey(server string) string {
+	server = strings.ToLower(strings.TrimSpace(server))
+	if _, _, err := net.SplitHostPort(server); err != nil {
+		server = net.JoinHostPort(server, "123")
+	}
+	return server
+}
+
+// CheckNTPSync queries several NTP servers in parallel and reports how far the local clock is from
+// their consensus and how much they disagree. Unreachable servers are reported per response
+// instead of failing the check.
+func (s *timeService) CheckNTPSync(ctx context.Context, input NTPSyncCheckInput) (NTPSyncCheckResult, error) {
+	if err := ctx.Err(); err != nil {
+		return NTPSyncCheckResult{}, err
+	}
+
+	servers := input.Servers
+	if len(servers) == 0 {
+		servers = s.ntpSyncServers
+	}
+	if len(servers) > maxNTPSyncServers {
+		return NTPSyncCheckResult{}, validationErrorf(CodeLimitExceeded, "too many servers: %d (maximum: %d)", len(servers), maxNTPSyncServers)
+	}
+
+	allowed := make(map[string]bool, len(s.ntpSyncServers))
+	for _, server := range s.ntpSyncServers {
+		allowed[ntpServerKey(server)] = true
+	}
+	for _, server := range servers {
+		if strings.TrimSpace(server) == "" {
+			return NTPSyncCheckResult{}, validationErrorf(CodeInvalidArgument, "servers cannot contain an empty address")
+		}
+		if !allowed[ntpServerKey(server)] {
+			return NTPSyncCheckResult{}, validationErrorf(CodeInvalidArgument, "server %s is not an allowed NTP server (allowed: %v)", strings.TrimSpace(server), s.ntpSyncServers)
+		}
+	}
+
+	timeout := defaultNTPSyncTimeout
+	if input.TimeoutMs != 0 {
+		timeout = time.Duration(input.TimeoutMs) * time.Millisecond
+		if timeout < 0 || timeout > maxNTPSyncTimeout {
+			return NTPSyncCheckResult{}, validationErrorf(CodeInvalidArgument, "timeout_ms must be between 1 and %d, got %d", maxNTPSyncTimeout.Milliseconds(), input.TimeoutMs)
+		}
+	}
+
+	responses := make([]NTPResponse, len(servers))
+
+	var g errgroup.Group
+	for i, server := range servers {
+		g.Go(func() error {
+			responses[i] = queryNTPServer(strings.TrimSpace(server), timeout)
+			return nil
+		})
+	}
+
+	// Per-server errors are captured in the responses, so the group never fails
+	_ = g.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return NTPSyncCheckResult{}, err
+	}
+
+	var offsets []float64
+	for _, response := range responses {
+		if response.Error == "" {
+			offsets = append(offsets, response.OffsetMs)
+		}
+	}
+
+	result := NTPSyncCheckResult{Responses: responses}
+	if len(offsets) > 0 {
+		sort.Float64s(offsets)
+		result.ConsensusOffsetMs = medianOf(offsets)
+		result.MaxDisagreementMs = offsets[len(offsets)-1] - offsets[0]
+		result.IsSynchronized = result.MaxDisagreementMs <= float64(ntpSyncTolerance.Milliseconds())
+	}
+
+	s.logger.Debug("Checked NTP synchronization",
+		zap.Int("servers", len(servers)),
+		zap.Int("responses", len(offsets)),
+		zap.Float64("consensus_offset_ms", result.ConsensusOffsetMs),
+		zap.Float64("max_disagreement_ms", result.MaxDisagreementMs))
+
+	return result, nil
+}
+
+// queryNTPServer measures the offset of the local clock from one NTP server
+func queryNTPServer(server string, timeout time.Duration) NTPResponse {
+	response := NTPResponse{Server: server}
+
+	resp, err := ntp.QueryWithOptions(server, ntp.QueryOptions{Timeout: timeout})
+	if err == nil {
+		err = resp.Validate()
+	}
+	if err != nil {
+		response.Error = err.Error()
+		return response
+	}
+
+	response.OffsetMs = durationMs(resp.ClockOffset)
+	response.RTTMs = durationMs(resp.RTT)
+	response.Stratum = int(resp.Stratum)
+	return response
+}
+
+// durationMs converts a duration to fractional milliseconds
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// medianOf returns the median of sorted, non-empty values
+func medianOf(sorted []float64) float64 {
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
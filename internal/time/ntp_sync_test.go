@@ -0,0 +1,165 @@
+package time
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+const (
+	// fakeNTPEpochOffset is the number of seconds between the NTP epoch (1900) and the Unix epoch
+	fakeNTPEpochOffset = 2208988800
+
+	// fakeNTPPacketSize is the size of an NTP packet without extensions
+	fakeNTPPacketSize = 48
+)
+
+// startFakeNTPv4Server answers NTPv4 queries with the local time shifted by offset, echoing the
+// client's transmit timestamp as the origin timestamp as full NTP clients require
+func startFakeNTPv4Server(t *testing.T, offset time.Duration) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	putTimestamp := func(b []byte, ts time.Time) {
+		binary.BigEndian.PutUint32(b, uint32(ts.Unix()+fakeNTPEpochOffset))
+		binary.BigEndian.PutUint32(b[4:], uint32((int64(ts.Nanosecond())<<32)/int64(time.Second)))
+	}
+
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			if n < fakeNTPPacketSize {
+				continue
+			}
+
+			response := make([]byte, fakeNTPPacketSize)
+			response[0] = 0x24 // LI=0, VN=4, Mode=4 (server)
+			response[1] = 2    // stratum 2
+			serverTime := time.Now().Add(offset)
+			putTimestamp(response[16:], serverTime) // reference
+			copy(response[24:32], buf[40:48])       // origin
+			putTimestamp(response[32:], serverTime) // receive
+			putTimestamp(response[40:], serverTime) // transmit
+			conn.WriteTo(response, addr)
+		}
+	}()
+
+	return conn.LocalAddr().String()
+}
+
+// unreachableNTPServer returns the address of a UDP socket that never answers
+func unreachableNTPServer(t *testing.T) string {
+	t.Helper()
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return conn.LocalAddr().String()
+}
+
+// ntpSyncService creates a time service allowed to query the given servers
+func ntpSyncService(t *testing.T, servers ...string) TimeService {
+	return NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithNTPSyncServers(servers))
+}
+
+func TestTimeService_CheckNTPSync(t *testing.T) {
+	t.Run("servers in agreement", func(t *testing.T) {
+		servers := []string{startFakeNTPv4Server(t, time.Second), startFakeNTPv4Server(t, time.Second+20*time.Millisecond)}
+		result, err := ntpSyncService(t, servers...).CheckNTPSync(context.Background(), NTPSyncCheckInput{
+			Servers:   servers,
+			TimeoutMs: 1000,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Responses, 2)
+		for _, response := range result.Responses {
+			assert.Empty(t, response.Error)
+			assert.Equal(t, 2, response.Stratum)
+			assert.GreaterOrEqual(t, response.RTTMs, 0.0)
+		}
+		assert.InDelta(t, 1000, result.Responses[0].OffsetMs, 50)
+		assert.InDelta(t, 1010, result.ConsensusOffsetMs, 50)
+		assert.InDelta(t, 20, result.MaxDisagreementMs, 15)
+		assert.True(t, result.IsSynchronized)
+	})
+
+	t.Run("servers in disagreement with one unreachable", func(t *testing.T) {
+		unreachable := unreachableNTPServer(t)
+		servers := []string{startFakeNTPv4Server(t, 0), unreachable, startFakeNTPv4Server(t, 500*time.Millisecond)}
+		result, err := ntpSyncService(t, servers...).CheckNTPSync(context.Background(), NTPSyncCheckInput{
+			Servers:   servers,
+			TimeoutMs: 200,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, result.Responses, 3)
+		assert.Equal(t, unreachable, result.Responses[1].Server)
+		assert.NotEmpty(t, result.Responses[1].Error)
+		assert.InDelta(t, 250, result.ConsensusOffsetMs, 50)
+		assert.InDelta(t, 500, result.MaxDisagreementMs, 50)
+		assert.False(t, result.IsSynchronized)
+	})
+
+	t.Run("configured servers by default", func(t *testing.T) {
+		server := startFakeNTPv4Server(t, 0)
+		result, err := ntpSyncService(t, server).CheckNTPSync(context.Background(), NTPSyncCheckInput{TimeoutMs: 1000})
+		require.NoError(t, err)
+
+		require.Len(t, result.Responses, 1)
+		assert.Equal(t, server, result.Responses[0].Server)
+		assert.Empty(t, result.Responses[0].Error)
+	})
+
+	t.Run("no server answers", func(t *testing.T) {
+		unreachable := unreachableNTPServer(t)
+		result, err := ntpSyncService(t, unreachable).CheckNTPSync(context.Background(), NTPSyncCheckInput{
+			Servers:   []string{unreachable},
+			TimeoutMs: 100,
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, result.Responses[0].Error)
+		assert.False(t, result.IsSynchronized)
+	})
+
+	service := ntpSyncService(t, "127.0.0.1", "time.example.com:4123")
+
+	tests := []struct {
+		name        string
+		input       NTPSyncCheckInput
+		expectedErr error
+	}{
+		{name: "too many servers", input: NTPSyncCheckInput{Servers: make([]string, 11)}, expectedErr: &ValidationError{Code: CodeLimitExceeded}},
+		{name: "empty server", input: NTPSyncCheckInput{Servers: []string{" "}}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+		{name: "server not allowed", input: NTPSyncCheckInput{Servers: []string{"10.0.0.1"}}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+		{name: "allowed host on another port", input: NTPSyncCheckInput{Servers: []string{"127.0.0.1:6379"}}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+		{name: "allowed port on another host", input: NTPSyncCheckInput{Servers: []string{"time.example.com"}}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+		{name: "timeout too long", input: NTPSyncCheckInput{Servers: []string{"127.0.0.1"}, TimeoutMs: 60000}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+		{name: "negative timeout", input: NTPSyncCheckInput{Servers: []string{"127.0.0.1"}, TimeoutMs: -1}, expectedErr: &ValidationError{Code: CodeInvalidArgument}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CheckNTPSync(context.Background(), tt.input)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func Test_medianOf(t *testing.T) {
+	assert.Equal(t, 2.0, medianOf([]float64{1, 2, 10}))
+	assert.Equal(t, 1.5, medianOf([]float64{1, 2}))
+	assert.Equal(t, 7.0, medianOf([]float64{7}))
+}
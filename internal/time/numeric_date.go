@@ -0,0 +1,84 @@
+package time
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultNumericDateLocale is assumed for numeric dates when parse_time is given no locale
+const defaultNumericDateLocale = "en-US"
+
+// numericDatePattern matches dates written as three numbers with the year last, such as
+// 01/02/2024, 1.2.2024 or 01-02-2024
+var numericDatePattern = regexp.MustCompile(`^(\d{1,2})([/.-])(\d{1,2})([/.-])(\d{4})$`)
+
+// monthFirstLocales lists the locales writing numeric dates month first. Every other locale puts
+// the day first.
+var monthFirstLocales = map[string]bool{
+	"en":    true,
+	"en-us": true,
+	"en-ph": true,
+	"en-fm": true,
+	"en-mh": true,
+}
+
+// numericDate is a numeric date resolved according to a locale
+type numericDate struct {
+	time time.Time
+
+	// ambiguity explains the ordering assumed when both day and month could be either number
+	ambiguity string
+}
+
+// isNumericDate reports whether value is a numeric date with the year last
+func isNumericDate(value string) bool {
+	return numericDatePattern.MatchString(strings.TrimSpace(value))
+}
+
+// isMonthFirstLocale reports whether a locale writes numeric dates month first
+func isMonthFirstLocale(locale string) bool {
+	return monthFirstLocales[strings.ToLower(strings.ReplaceAll(locale, "_", "-"))]
+}
+
+// parseNumericDate parses a numeric date with the year last, reading the first number as the month
+// for month-first locales and as the day otherwise. Dates where both readings are valid carry an
+// ambiguity explanation.
+func parseNumericDate(value, locale string) (numericDate, error) {
+	if locale == "" {
+		locale = defaultNumericDateLocale
+	}
+
+	match := numericDatePattern.FindStringSubmatch(strings.TrimSpace(value))
+	if match == nil || match[2] != match[4] {
+		return numericDate{}, parseErrorf(CodeInvalidTimestamp, "%s is not a numeric date such as 01/02/2024", value)
+	}
+
+	first, _ := strconv.Atoi(match[1])
+	second, _ := strconv.Atoi(match[3])
+	year, _ := strconv.Atoi(match[5])
+
+	ordering := "Day/Month"
+	day, month := first, second
+	if isMonthFirstLocale(locale) {
+		ordering = "Month/Day"
+		day, month = second, first
+	}
+
+	if month < 1 || month > 12 || day < 1 || day > daysIn(time.Month(month), year) {
+		return numericDate{}, parseErrorf(CodeInvalidTimestamp, "%s is not a valid %s date for locale '%s'", value, strings.ToLower(ordering), locale)
+	}
+
+	result := numericDate{time: time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)}
+	if first != second && first <= 12 && second <= 12 {
+		result.ambiguity = fmt.Sprintf("%s ordering assumed; day=%d, month=%d for locale '%s'", ordering, day, month, locale)
+	}
+	return result, nil
+}
+
+// daysIn returns the number of days in a month of a year
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,83 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_parseNumericDate(t *testing.T) {
+	tests := []struct {
+		name              string
+		value             string
+		locale            string
+		expectedDate      string
+		expectedAmbiguity string
+		expectError       bool
+	}{
+		{
+			name:              "ambiguous month first by default",
+			value:             "01/02/2024",
+			expectedDate:      "2024-01-02",
+			expectedAmbiguity: "Month/Day ordering assumed; day=2, month=1 for locale 'en-US'",
+		},
+		{
+			name:              "ambiguous day first",
+			value:             "01/02/2024",
+			locale:            "en-GB",
+			expectedDate:      "2024-02-01",
+			expectedAmbiguity: "Day/Month ordering assumed; day=1, month=2 for locale 'en-GB'",
+		},
+		{
+			name:              "dotted day first",
+			value:             "3.4.2024",
+			locale:            "de_DE",
+			expectedDate:      "2024-04-03",
+			expectedAmbiguity: "Day/Month ordering assumed; day=3, month=4 for locale 'de_DE'",
+		},
+		{name: "day above twelve", value: "01/25/2024", locale: "en-US", expectedDate: "2024-01-25"},
+		{name: "day above twelve day first", value: "25-01-2024", locale: "fr-FR", expectedDate: "2024-01-25"},
+		{name: "same day and month", value: "05/05/2024", expectedDate: "2024-05-05"},
+		{name: "invalid month first", value: "25/01/2024", locale: "en-US", expectError: true},
+		{name: "invalid day", value: "02/30/2024", expectError: true},
+		{name: "mixed separators", value: "01/02-2024", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := parseNumericDate(tt.value, tt.locale)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDate, date.time.Format("2006-01-02"))
+			assert.Equal(t, tt.expectedAmbiguity, date.ambiguity)
+		})
+	}
+}
+
+func TestTimeService_ParseTime_AmbiguityWarning(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)}))
+
+	result, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "01/02/2024", Timezone: "Europe/Paris"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-02T00:00:00+01:00", result.RFC3339)
+	assert.Equal(t, "Month/Day ordering assumed; day=2, month=1 for locale 'en-US'", result.AmbiguityWarning)
+
+	result, err = service.ParseTime(context.Background(), ParseTimeInput{TimeString: "2024-01-02T00:00:00Z"})
+	require.NoError(t, err)
+	assert.Empty(t, result.AmbiguityWarning)
+
+	// An explicit format leaves nothing for the parser to assume
+	result, err = service.ParseTime(context.Background(), ParseTimeInput{TimeString: "01/02/2024", Format: "02/01/2006"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-01T00:00:00Z", result.RFC3339)
+	assert.Empty(t, result.AmbiguityWarning)
+}
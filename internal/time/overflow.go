@@ -0,0 +1,35 @@
+package time
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrTimestampOverflow is returned when a time cannot be represented in the requested format,
+// such as nanoseconds since the epoch after 2262
+var ErrTimestampOverflow = errors.New("timestamp_overflow")
+
+// Bounds of the times whose Unix nanosecond value fits in an int64
+var (
+	minUnixNanoTime = time.Unix(0, math.MinInt64).UTC()
+	maxUnixNanoTime = time.Unix(0, math.MaxInt64).UTC()
+)
+
+// maxPortableYear is the last year most other systems can represent, e.g. in four-digit years
+const maxPortableYear = 9999
+
+// checkTimestampRange rejects times that overflow the nanosecond formats and warns about times
+// after year 9999, which other systems may not handle
+func checkTimestampRange(t time.Time, format string) (string, error) {
+	if FormatType(format) == FormatUnixNano && (t.Before(minUnixNanoTime) || t.After(maxUnixNanoTime)) {
+		return "", formatErrorf(CodeTimestampOverflow, "%w: %s is outside the UnixNano range %s to %s", ErrTimestampOverflow,
+			t.UTC().Format(time.RFC3339), minUnixNanoTime.Format(time.RFC3339Nano), maxUnixNanoTime.Format(time.RFC3339Nano))
+	}
+
+	if t.UTC().Year() > maxPortableYear {
+		return fmt.Sprintf("timestamp is after year %d, which other systems may not support", maxPortableYear), nil
+	}
+	return "", nil
+}
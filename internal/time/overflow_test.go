@@ -0,0 +1,73 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_FormatTime_TimestampRange(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "UnixNano"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name            string
+		input           FormatTimeInput
+		expectOverflow  bool
+		expectedTime    string
+		expectedWarning string
+	}{
+		{
+			name:         "latest UnixNano second",
+			input:        FormatTimeInput{Timestamp: int64(9223372036), Format: "UnixNano"},
+			expectedTime: "9223372036000000000",
+		},
+		{
+			name:           "after 2262 as UnixNano",
+			input:          FormatTimeInput{Timestamp: int64(9300000000), Format: "UnixNano"},
+			expectOverflow: true,
+		},
+		{
+			name:           "before 1677 as UnixNano",
+			input:          FormatTimeInput{Timestamp: int64(-9300000000), Format: "UnixNano"},
+			expectOverflow: true,
+		},
+		{
+			name:         "after 2262 in another format",
+			input:        FormatTimeInput{Timestamp: int64(9300000000), Format: "RFC3339"},
+			expectedTime: "2264-09-14T21:20:00Z",
+		},
+		{
+			name:            "after year 9999",
+			input:           FormatTimeInput{Timestamp: "253402300800", Format: "RFC3339"},
+			expectedTime:    "10000-01-01T00:00:00Z",
+			expectedWarning: "timestamp is after year 9999, which other systems may not support",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.FormatTime(context.Background(), tt.input)
+
+			if tt.expectOverflow {
+				assert.ErrorIs(t, err, ErrTimestampOverflow)
+				assert.ErrorContains(t, err, "timestamp_overflow")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTime, result.FormattedTime)
+			assert.Equal(t, tt.expectedWarning, result.Warning)
+		})
+	}
+}
+
+func TestTimeService_FormatTime_DefaultFormatOverflow(t *testing.T) {
+	service := NewTimeService("UTC", "UnixNano", []string{"UnixNano"}, zaptest.NewLogger(t))
+
+	_, err := service.FormatTime(context.Background(), FormatTimeInput{Timestamp: int64(9300000000)})
+
+	assert.ErrorIs(t, err, ErrTimestampOverflow)
+}
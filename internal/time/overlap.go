@@ -0,0 +1,171 @@
+package time
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultWorkStartHour = 9
+	defaultWorkEndHour   = 17
+)
+
+var defaultWorkDays = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+// FindWorkingHoursOverlap finds when the working hours of two timezones overlap on a reference date
+func (s *timeService) FindWorkingHoursOverlap(ctx context.Context, input TimeOverlapInput) (TimeOverlapResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeOverlapResult{}, err
+	}
+
+	if input.TimezoneA == "" || input.TimezoneB == "" {
+		return TimeOverlapResult{}, validationErrorf(CodeMissingArgument, "timezone_a and timezone_b are required")
+	}
+
+	locA, warningA, err := s.loadLocation(input.TimezoneA)
+	if err != nil {
+		return TimeOverlapResult{}, err
+	}
+
+	locB, warningB, err := s.loadLocation(input.TimezoneB)
+	if err != nil {
+		return TimeOverlapResult{}, err
+	}
+
+	startHour := defaultWorkStartHour
+	if input.WorkStartHour != nil {
+		startHour = *input.WorkStartHour
+	}
+
+	endHour := defaultWorkEndHour
+	if input.WorkEndHour != nil {
+		endHour = *input.WorkEndHour
+	}
+
+	if startHour < 0 || endHour > 24 || startHour >= endHour {
+		return TimeOverlapResult{}, validationErrorf(CodeInvalidArgument, "invalid working hours %d-%d: start must be before end, within 0-24", startHour, endHour)
+	}
+
+	workDays, err := parseWorkDays(input.WorkDays)
+	if err != nil {
+		return TimeOverlapResult{}, err
+	}
+
+	// Use provided reference date or today in timezone A
+	date := s.clock.Now().In(locA)
+	if input.ReferenceDate != "" {
+		date, err = time.ParseInLocation("2006-01-02", input.ReferenceDate, locA)
+		if err != nil {
+			return TimeOverlapResult{}, parseErrorf(CodeInvalidTimestamp, "invalid reference_date %s (expected YYYY-MM-DD): %w", input.ReferenceDate, err)
+		}
+	}
+
+	s.logger.Debug("Finding working hours overlap",
+		zap.String("timezone_a", input.TimezoneA),
+		zap.String("timezone_b", input.TimezoneB),
+		zap.Int("work_start_hour", startHour),
+		zap.Int("work_end_hour", endHour),
+		zap.String("reference_date", date.Format("2006-01-02")))
+
+	result := TimeOverlapResult{
+		TimezoneA:     input.TimezoneA,
+		TimezoneB:     input.TimezoneB,
+		ReferenceDate: date.Format("2006-01-02"),
+		Warning:       joinWarnings(warningA, warningB),
+	}
+
+	if !workDays[date.Weekday()] {
+		return result, nil
+	}
+
+	startA, endA := workWindow(date, startHour, endHour)
+
+	// Timezone B's working day that overlaps may fall on the previous or next calendar day
+	var bestStart, bestEnd time.Time
+	dateInB := startA.In(locB)
+	for _, dayOffset := range []int{-1, 0, 1} {
+		day := time.Date(dateInB.Year(), dateInB.Month(), dateInB.Day()+dayOffset, 0, 0, 0, 0, locB)
+		if !workDays[day.Weekday()] {
+			continue
+		}
+
+		startB, endB := workWindow(day, startHour, endHour)
+		overlapStart := later(startA, startB)
+		overlapEnd := earlier(endA, endB)
+
+		if overlapEnd.After(overlapStart) && overlapEnd.Sub(overlapStart) > bestEnd.Sub(bestStart) {
+			bestStart, bestEnd = overlapStart, overlapEnd
+		}
+	}
+
+	if bestEnd.After(bestStart) {
+		result.HasOverlap = true
+		result.OverlapStartA = bestStart.In(locA).Format(time.RFC3339)
+		result.OverlapEndA = bestEnd.In(locA).Format(time.RFC3339)
+		result.OverlapStartB = bestStart.In(locB).Format(time.RFC3339)
+		result.OverlapEndB = bestEnd.In(locB).Format(time.RFC3339)
+		result.OverlapHours = bestEnd.Sub(bestStart).Hours()
+	}
+
+	return result, nil
+}
+
+// workWindow returns the start and end of the working hours on the given date
+func workWindow(date time.Time, startHour, endHour int) (time.Time, time.Time) {
+	start := time.Date(date.Year(), date.Month(), date.Day(), startHour, 0, 0, 0, date.Location())
+	end := time.Date(date.Year(), date.Month(), date.Day(), endHour, 0, 0, 0, date.Location())
+	return start, end
+}
+
+// parseWorkDays parses weekday names ("Mon", "monday") into a lookup set, defaulting to Monday-Friday
+func parseWorkDays(days []string) (map[time.Weekday]bool, error) {
+	set := make(map[time.Weekday]bool)
+
+	if len(days) == 0 {
+		for _, day := range defaultWorkDays {
+			set[day] = true
+		}
+		return set, nil
+	}
+
+	for _, name := range days {
+		day, ok := parseWeekday(name)
+		if !ok {
+			return nil, validationErrorf(CodeInvalidArgument, "invalid work day: %s", name)
+		}
+		set[day] = true
+	}
+
+	return set, nil
+}
+
+// parseWeekday parses a full or three-letter English weekday name, case-insensitively
+func parseWeekday(name string) (time.Weekday, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		full := strings.ToLower(day.String())
+		if name == full || name == full[:3] {
+			return day, true
+		}
+	}
+
+	return time.Sunday, false
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
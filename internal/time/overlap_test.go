@@ -0,0 +1,147 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestTimeService_FindWorkingHoursOverlap(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name     string
+		input    TimeOverlapInput
+		wantErr  bool
+		expected TimeOverlapResult
+	}{
+		{
+			name: "new york and london",
+			input: TimeOverlapInput{
+				TimezoneA:     "America/New_York",
+				TimezoneB:     "Europe/London",
+				ReferenceDate: "2024-03-04",
+			},
+			expected: TimeOverlapResult{
+				TimezoneA:     "America/New_York",
+				TimezoneB:     "Europe/London",
+				ReferenceDate: "2024-03-04",
+				HasOverlap:    true,
+				OverlapStartA: "2024-03-04T09:00:00-05:00",
+				OverlapEndA:   "2024-03-04T12:00:00-05:00",
+				OverlapStartB: "2024-03-04T14:00:00Z",
+				OverlapEndB:   "2024-03-04T17:00:00Z",
+				OverlapHours:  3,
+			},
+		},
+		{
+			name: "tokyo and los angeles cross the date line",
+			input: TimeOverlapInput{
+				TimezoneA:     "Asia/Tokyo",
+				TimezoneB:     "America/Los_Angeles",
+				WorkStartHour: intPtr(8),
+				WorkEndHour:   intPtr(18),
+				ReferenceDate: "2024-03-05",
+			},
+			expected: TimeOverlapResult{
+				TimezoneA:     "Asia/Tokyo",
+				TimezoneB:     "America/Los_Angeles",
+				ReferenceDate: "2024-03-05",
+				HasOverlap:    true,
+				OverlapStartA: "2024-03-05T08:00:00+09:00",
+				OverlapEndA:   "2024-03-05T11:00:00+09:00",
+				OverlapStartB: "2024-03-04T15:00:00-08:00",
+				OverlapEndB:   "2024-03-04T18:00:00-08:00",
+				OverlapHours:  3,
+			},
+		},
+		{
+			name: "no overlap",
+			input: TimeOverlapInput{
+				TimezoneA:     "Asia/Tokyo",
+				TimezoneB:     "America/New_York",
+				ReferenceDate: "2024-03-05",
+			},
+			expected: TimeOverlapResult{
+				TimezoneA:     "Asia/Tokyo",
+				TimezoneB:     "America/New_York",
+				ReferenceDate: "2024-03-05",
+			},
+		},
+		{
+			name: "reference date is not a work day",
+			input: TimeOverlapInput{
+				TimezoneA:     "Europe/Paris",
+				TimezoneB:     "Europe/London",
+				ReferenceDate: "2024-03-09",
+			},
+			expected: TimeOverlapResult{
+				TimezoneA:     "Europe/Paris",
+				TimezoneB:     "Europe/London",
+				ReferenceDate: "2024-03-09",
+			},
+		},
+		{
+			name: "custom work days",
+			input: TimeOverlapInput{
+				TimezoneA:     "Asia/Dubai",
+				TimezoneB:     "Europe/London",
+				WorkDays:      []string{"sat", "Sunday"},
+				ReferenceDate: "2024-03-09",
+			},
+			expected: TimeOverlapResult{
+				TimezoneA:     "Asia/Dubai",
+				TimezoneB:     "Europe/London",
+				ReferenceDate: "2024-03-09",
+				HasOverlap:    true,
+				OverlapStartA: "2024-03-09T13:00:00+04:00",
+				OverlapEndA:   "2024-03-09T17:00:00+04:00",
+				OverlapStartB: "2024-03-09T09:00:00Z",
+				OverlapEndB:   "2024-03-09T13:00:00Z",
+				OverlapHours:  4,
+			},
+		},
+		{
+			name:    "missing timezone",
+			input:   TimeOverlapInput{TimezoneA: "UTC"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid hours",
+			input:   TimeOverlapInput{TimezoneA: "UTC", TimezoneB: "UTC", WorkStartHour: intPtr(18), WorkEndHour: intPtr(9)},
+			wantErr: true,
+		},
+		{
+			name:    "invalid work day",
+			input:   TimeOverlapInput{TimezoneA: "UTC", TimezoneB: "UTC", WorkDays: []string{"Funday"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid reference date",
+			input:   TimeOverlapInput{TimezoneA: "UTC", TimezoneB: "UTC", ReferenceDate: "03/04/2024"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.FindWorkingHoursOverlap(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
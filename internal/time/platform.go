@@ -0,0 +1,29 @@
+package time
+
+import "time"
+
+const (
+	// ticksPerSecond is the number of 100-nanosecond ticks in a second
+	ticksPerSecond = 10_000_000
+
+	// filetimeUnixEpochTicks is the Windows FILETIME of the Unix epoch (ticks since 1601-01-01 UTC)
+	filetimeUnixEpochTicks = 116_444_736_000_000_000
+
+	// dotNetUnixEpochTicks is the .NET DateTime.Ticks of the Unix epoch (ticks since 0001-01-01 UTC)
+	dotNetUnixEpochTicks = 621_355_968_000_000_000
+)
+
+// unixTicks returns the 100-nanosecond ticks elapsed since the Unix epoch, truncated toward the past
+func unixTicks(t time.Time) int64 {
+	return t.Unix()*ticksPerSecond + int64(t.Nanosecond()/100)
+}
+
+// windowsFiletime returns t as a Windows FILETIME: 100-nanosecond intervals since January 1, 1601 UTC
+func windowsFiletime(t time.Time) int64 {
+	return unixTicks(t) + filetimeUnixEpochTicks
+}
+
+// dotNetTicks returns t as .NET DateTime.Ticks (UTC): 100-nanosecond intervals since January 1, 0001
+func dotNetTicks(t time.Time) int64 {
+	return unixTicks(t) + dotNetUnixEpochTicks
+}
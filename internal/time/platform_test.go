@@ -0,0 +1,49 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestPlatformTicks(t *testing.T) {
+	tests := []struct {
+		name             string
+		time             time.Time
+		expectedFiletime int64
+		expectedTicks    int64
+	}{
+		{"unix epoch", time.Unix(0, 0), 116444736000000000, 621355968000000000},
+		{"filetime epoch", time.Date(1601, 1, 1, 0, 0, 0, 0, time.UTC), 0, 504911232000000000},
+		{"sub-tick precision", time.Date(2024, 3, 15, 14, 30, 0, 123456789, time.UTC), 133549866001234567, 638461098001234567},
+		{"before the unix epoch", time.Date(1969, 12, 31, 23, 59, 59, 500000000, time.UTC), 116444735995000000, 621355967995000000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedFiletime, windowsFiletime(tt.time))
+			assert.Equal(t, tt.expectedTicks, dotNetTicks(tt.time))
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_PlatformFormats(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "Asia/Tokyo", IncludePlatformFormats: true})
+	require.NoError(t, err)
+	assert.Equal(t, int64(133549866000000000), result.WindowsFiletime)
+	assert.Equal(t, int64(638461098000000000), result.DotNetTicks)
+	assert.Equal(t, int64(1710513000000), result.JavaTimestamp)
+
+	without, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Zero(t, without.WindowsFiletime)
+	assert.Zero(t, without.DotNetTicks)
+	assert.Zero(t, without.JavaTimestamp)
+}
@@ -0,0 +1,96 @@
+package time
+
+import (
+	"sort"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Plugin adds output formats to the time service, such as a FIX protocol timestamp or GPS time,
+// without changing the built-in formats
+type Plugin interface {
+	// Name identifies the plugin in logs and errors
+	Name() string
+
+	// FormatTypes lists the format names the plugin handles
+	FormatTypes() []FormatType
+
+	// Format renders t in the requested format, one of FormatTypes
+	Format(t time.Time, layout string) (string, error)
+}
+
+// RegisterPlugin makes the plugin handle its format types in every tool that formats times. Its
+// formats become supported formats. Plugins cannot replace built-in formats or formats of another
+// plugin, and should be registered at startup, before the service handles requests.
+func (s *timeService) RegisterPlugin(p Plugin) error {
+	if p == nil {
+		return validationErrorf(CodeInvalidArgument, "plugin cannot be nil")
+	}
+
+	formatTypes := p.FormatTypes()
+	if len(formatTypes) == 0 {
+		return validationErrorf(CodeInvalidArgument, "plugin %s declares no format types", p.Name())
+	}
+
+	if err := s.addPlugin(p, formatTypes); err != nil {
+		return err
+	}
+
+	// A plugin may provide the configured default format that was unusable until now
+	s.defaultFormat = s.resolveDefaultFormat()
+
+	s.logger.Info("Registered time plugin",
+		zap.String("plugin", p.Name()),
+		zap.Any("format_types", formatTypes))
+
+	return nil
+}
+
+// addPlugin maps each format type to the plugin, unless one of them is already taken
+func (s *timeService) addPlugin(p Plugin, formatTypes []FormatType) error {
+	s.pluginsMu.Lock()
+	defer s.pluginsMu.Unlock()
+
+	for _, format := range formatTypes {
+		if _, builtin := formatDescriptions[format]; builtin {
+			return validationErrorf(CodeInvalidArgument, "plugin %s cannot replace built-in format %s", p.Name(), format)
+		}
+		if existing, ok := s.plugins[format]; ok {
+			return validationErrorf(CodeInvalidArgument, "plugin %s cannot replace format %s of plugin %s", p.Name(), format, existing.Name())
+		}
+	}
+
+	if s.plugins == nil {
+		s.plugins = make(map[FormatType]Plugin)
+	}
+	for _, format := range formatTypes {
+		s.plugins[format] = p
+	}
+	return nil
+}
+
+// pluginFor returns the plugin handling a format, if any
+func (s *timeService) pluginFor(format string) (Plugin, bool) {
+	s.pluginsMu.RLock()
+	defer s.pluginsMu.RUnlock()
+
+	p, ok := s.plugins[FormatType(format)]
+	return p, ok
+}
+
+// pluginFormats returns the formats provided by plugins that are not already listed as supported
+// formats, sorted
+func (s *timeService) pluginFormats() []string {
+	s.pluginsMu.RLock()
+	defer s.pluginsMu.RUnlock()
+
+	var formats []string
+	for format := range s.plugins {
+		if !s.isConfiguredFormat(string(format)) {
+			formats = append(formats, string(format))
+		}
+	}
+	sort.Strings(formats)
+	return formats
+}
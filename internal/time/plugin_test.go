@@ -0,0 +1,131 @@
+package time
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// fixPlugin renders FIX protocol UTCTimestamp values
+type fixPlugin struct{}
+
+func (fixPlugin) Name() string { return "fix" }
+
+func (fixPlugin) FormatTypes() []FormatType { return []FormatType{"FIX", "FIXDate"} }
+
+func (fixPlugin) Format(t time.Time, layout string) (string, error) {
+	if layout == "FIXDate" {
+		return t.UTC().Format("20060102"), nil
+	}
+	return t.UTC().Format("20060102-15:04:05.000"), nil
+}
+
+// failingPlugin cannot format any time
+type failingPlugin struct{}
+
+func (failingPlugin) Name() string { return "failing" }
+
+func (failingPlugin) FormatTypes() []FormatType { return []FormatType{"Broken"} }
+
+func (failingPlugin) Format(t time.Time, layout string) (string, error) {
+	return "", errors.New("clock not synchronized")
+}
+
+func TestTimeService_RegisterPlugin(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 5, 250000000, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}))
+
+	require.NoError(t, service.RegisterPlugin(fixPlugin{}))
+	require.NoError(t, service.RegisterPlugin(failingPlugin{}))
+
+	tests := []struct {
+		name           string
+		input          FormatTimeInput
+		expectedFormat string
+		expectError    bool
+	}{
+		{
+			name:           "plugin format",
+			input:          FormatTimeInput{Format: "FIX", Timezone: "Asia/Tokyo"},
+			expectedFormat: "20240315-14:30:05.250",
+		},
+		{
+			name:           "second format type of the same plugin",
+			input:          FormatTimeInput{Format: "FIXDate"},
+			expectedFormat: "20240315",
+		},
+		{
+			name:           "built-in format is unaffected",
+			input:          FormatTimeInput{Format: "RFC3339"},
+			expectedFormat: "2024-03-15T14:30:05Z",
+		},
+		{
+			name:        "plugin error",
+			input:       FormatTimeInput{Format: "Broken"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.FormatTime(context.Background(), tt.input)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "plugin failing")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFormat, result.FormattedTime)
+		})
+	}
+
+	assert.True(t, service.IsFormatSupported("FIX"))
+	assert.Equal(t, []string{"RFC3339", "Broken", "FIX", "FIXDate"}, service.GetSupportedFormats())
+
+	formats := service.ListFormats(context.Background())
+	require.Len(t, formats.Formats, 4)
+	assert.Equal(t, "Provided by the fix plugin", formats.Formats[2].Description)
+	assert.Equal(t, "20240315-14:30:05.250", formats.Formats[2].Example)
+}
+
+func TestTimeService_RegisterPlugin_Conflicts(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+	require.NoError(t, service.RegisterPlugin(fixPlugin{}))
+
+	assert.ErrorContains(t, service.RegisterPlugin(fixPlugin{}), "cannot replace format FIX of plugin fix")
+	assert.ErrorContains(t, service.RegisterPlugin(builtinPlugin{}), "cannot replace built-in format Unix")
+	assert.Error(t, service.RegisterPlugin(nil))
+}
+
+func TestTimeService_RegisterPlugin_DefaultFormat(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 5, 0, time.UTC)
+	service := NewTimeService("UTC", "FIX", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}), WithFallbackFormats([]string{"RFC3339"}))
+
+	// Until the plugin is registered the fallback format is used
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "RFC3339", result.Format)
+
+	require.NoError(t, service.RegisterPlugin(fixPlugin{}))
+
+	result, err = service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "FIX", result.Format)
+	assert.Equal(t, "20240315-14:30:05.000", result.FormattedTime)
+}
+
+// builtinPlugin tries to take over a built-in format
+type builtinPlugin struct{}
+
+func (builtinPlugin) Name() string { return "builtin" }
+
+func (builtinPlugin) FormatTypes() []FormatType { return []FormatType{FormatUnix} }
+
+func (builtinPlugin) Format(t time.Time, layout string) (string, error) { return "", nil }
@@ -0,0 +1,106 @@
+package time
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// posixDefaultTransitionSeconds is the transition time POSIX rules assume when none is given, 02:00
+const posixDefaultTransitionSeconds = 2 * 60 * 60
+
+// ianaToPOSIXTZ builds the POSIX TZ string (e.g. "EST5EDT,M3.2.0,M11.1.0") describing loc in the
+// given year, from the offset transitions that year. Zones without exactly one DST period in the
+// year are described by their standard time alone, as of the end of the year. Rules are written
+// as month, week (5 for the last) and weekday; the few zones whose rules are anchored to another
+// day, such as Israel's "Friday before the last Sunday", get the rule matching that year's dates.
+func ianaToPOSIXTZ(loc *time.Location, year int) string {
+	transitions := upcomingTransitions(time.Date(year, time.January, 1, 0, 0, 0, 0, loc), loc)
+
+	var enter, exit *DSTTransitionInfo
+	for i := range transitions {
+		if transitions[i].NextTransition.Year() != year {
+			continue
+		}
+		switch transitions[i].TransitionType {
+		case "enter_dst":
+			enter = &transitions[i]
+		case "exit_dst":
+			exit = &transitions[i]
+		}
+	}
+
+	if len(transitions) != 2 || enter == nil || exit == nil || enter.OffsetChange != -exit.OffsetChange {
+		abbreviation, offset := time.Date(year, time.December, 31, 23, 59, 59, 0, loc).Zone()
+		return posixZoneName(abbreviation) + posixOffset(offset)
+	}
+
+	stdOffset := exit.NewOffsetSeconds
+	dstOffset := enter.NewOffsetSeconds
+
+	var tz strings.Builder
+	tz.WriteString(posixZoneName(exit.NewAbbreviation))
+	tz.WriteString(posixOffset(stdOffset))
+	tz.WriteString(posixZoneName(enter.NewAbbreviation))
+	// The DST offset defaults to one hour ahead of standard time
+	if dstOffset != stdOffset+60*60 {
+		tz.WriteString(posixOffset(dstOffset))
+	}
+	fmt.Fprintf(&tz, ",%s,%s",
+		posixRule(enter.NextTransition, stdOffset),
+		posixRule(exit.NextTransition, dstOffset))
+
+	return tz.String()
+}
+
+// posixRule writes a transition as "Mm.w.d[/time]", in the wall clock time in effect before it
+func posixRule(at time.Time, offsetBefore int) string {
+	local := at.UTC().Add(time.Duration(offsetBefore) * time.Second)
+
+	week := (local.Day()-1)/7 + 1
+	daysInMonth := time.Date(local.Year(), local.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if local.Day()+7 > daysInMonth {
+		week = 5
+	}
+
+	rule := fmt.Sprintf("M%d.%d.%d", int(local.Month()), week, int(local.Weekday()))
+
+	seconds := local.Hour()*3600 + local.Minute()*60 + local.Second()
+	if seconds != posixDefaultTransitionSeconds {
+		rule += "/" + posixDuration(seconds)
+	}
+	return rule
+}
+
+// posixOffset writes a UTC offset the POSIX way, as the time to add to local time to get UTC:
+// UTC+05:30 is "-5:30"
+func posixOffset(offsetSeconds int) string {
+	seconds := -offsetSeconds
+	if seconds < 0 {
+		return "-" + posixDuration(-seconds)
+	}
+	return posixDuration(seconds)
+}
+
+// posixDuration writes a non-negative number of seconds as hh[:mm[:ss]] without padding the hours
+func posixDuration(seconds int) string {
+	hours, minutes, secs := seconds/3600, seconds/60%60, seconds%60
+	switch {
+	case secs != 0:
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, secs)
+	case minutes != 0:
+		return fmt.Sprintf("%d:%02d", hours, minutes)
+	default:
+		return fmt.Sprintf("%d", hours)
+	}
+}
+
+// posixZoneName quotes abbreviations that are not made of three or more letters, such as "+04"
+func posixZoneName(abbreviation string) string {
+	if len(abbreviation) < 3 || strings.IndexFunc(abbreviation, func(r rune) bool {
+		return (r < 'A' || r > 'Z') && (r < 'a' || r > 'z')
+	}) != -1 {
+		return "<" + abbreviation + ">"
+	}
+	return abbreviation
+}
@@ -0,0 +1,55 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestIANAToPOSIXTZ(t *testing.T) {
+	tests := []struct {
+		timezone string
+		year     int
+		expected string
+	}{
+		{timezone: "America/New_York", year: 2024, expected: "EST5EDT,M3.2.0,M11.1.0"},
+		{timezone: "Europe/London", year: 2024, expected: "GMT0BST,M3.5.0/1,M10.5.0"},
+		{timezone: "Europe/Paris", year: 2024, expected: "CET-1CEST,M3.5.0,M10.5.0/3"},
+		{timezone: "Australia/Sydney", year: 2024, expected: "AEST-10AEDT,M10.1.0,M4.1.0/3"},
+		{timezone: "Australia/Lord_Howe", year: 2024, expected: "<+1030>-10:30<+11>-11,M10.1.0,M4.1.0"},
+		{timezone: "America/Nuuk", year: 2024, expected: "<-02>2<-01>,M3.5.6/23,M10.5.0/0"},
+		{timezone: "Asia/Kolkata", year: 2024, expected: "IST-5:30"},
+		{timezone: "Asia/Kathmandu", year: 2024, expected: "<+0545>-5:45"},
+		{timezone: "Asia/Tokyo", year: 2024, expected: "JST-9"},
+		{timezone: "America/Sao_Paulo", year: 2024, expected: "<-03>3"},
+		{timezone: "America/Sao_Paulo", year: 2018, expected: "<-03>3<-02>,M11.1.0/0,M2.3.0/0"},
+		{timezone: "UTC", year: 2024, expected: "UTC0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.timezone)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expected, ianaToPOSIXTZ(loc, tt.year))
+		})
+	}
+}
+
+func TestTimeService_POSIXTZString(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)}))
+
+	info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "America/New_York"})
+	require.NoError(t, err)
+	assert.Equal(t, "EST5EDT,M3.2.0,M11.1.0", info.POSIXTZString)
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "Europe/Paris", Verbose: true})
+	require.NoError(t, err)
+	require.NotNil(t, result.TimezoneInfo)
+	assert.Equal(t, "CET-1CEST,M3.5.0,M10.5.0/3", result.TimezoneInfo.POSIXTZString)
+}
@@ -0,0 +1,99 @@
+package time
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// builtinFormats lists the built-in format types in preview order
+var builtinFormats = []FormatType{
+	FormatRFC3339,
+	FormatRFC3339Nano,
+	FormatUnix,
+	FormatUnixMilli,
+	FormatUnixMicro,
+	FormatUnixNano,
+	FormatTai64N,
+	FormatLayout,
+}
+
+// strftimePattern is a common strftime pattern and its equivalent Go layout
+type strftimePattern struct {
+	pattern     string
+	layout      string
+	description string
+}
+
+// strftimePatterns are the common strftime patterns shown by PreviewFormats
+var strftimePatterns = []strftimePattern{
+	{"%Y-%m-%d", "2006-01-02", "ISO 8601 calendar date"},
+	{"%Y-%m-%d %H:%M:%S", "2006-01-02 15:04:05", "ISO 8601 date and 24-hour time without timezone"},
+	{"%Y-%m-%dT%H:%M:%S%z", "2006-01-02T15:04:05-0700", "ISO 8601 with timezone offset (basic offset form)"},
+	{"%m/%d/%Y", "01/02/2006", "US numeric date (month first)"},
+	{"%d/%m/%Y", "02/01/2006", "European numeric date (day first)"},
+	{"%d.%m.%Y", "02.01.2006", "German-style numeric date"},
+	{"%B %d, %Y", "January 02, 2006", "Long US date with month name"},
+	{"%A, %B %d, %Y", "Monday, January 02, 2006", "Long date with weekday and month names"},
+	{"%d %b %Y", "02 Jan 2006", "Day, abbreviated month and year"},
+	{"%a, %d %b %Y %H:%M:%S %z", "Mon, 02 Jan 2006 15:04:05 -0700", "RFC 2822 / RFC 1123Z date used in email and HTTP headers"},
+	{"%H:%M", "15:04", "24-hour time"},
+	{"%H:%M:%S", "15:04:05", "24-hour time with seconds"},
+	{"%I:%M %p", "03:04 PM", "12-hour time with AM/PM"},
+	{"%Y%m%dT%H%M%S", "20060102T150405", "Compact ISO 8601 basic format, handy for file names"},
+}
+
+// PreviewFormats renders a timestamp in every built-in format and common strftime patterns
+func (s *timeService) PreviewFormats(ctx context.Context, input TimeFormatPreviewInput) (TimeFormatPreviewResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeFormatPreviewResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	s.logger.Debug("Previewing formats",
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeFormatPreviewResult{}, err
+	}
+
+	t, err := s.timestampToTime(input.Timestamp, loc)
+	if err != nil {
+		return TimeFormatPreviewResult{}, err
+	}
+
+	samples := make([]FormatSample, 0, len(builtinFormats)+len(strftimePatterns))
+	for _, format := range builtinFormats {
+		name := string(format)
+		if format == FormatLayout {
+			name = customLayoutExample
+		}
+
+		samples = append(samples, FormatSample{
+			FormatName:  string(format),
+			Formatted:   renderFormat(t, name),
+			Description: formatDescriptions[format],
+		})
+	}
+
+	for _, pattern := range strftimePatterns {
+		samples = append(samples, FormatSample{
+			FormatName:  pattern.pattern,
+			Formatted:   t.Format(pattern.layout),
+			Description: fmt.Sprintf("%s (Go layout %q)", pattern.description, pattern.layout),
+		})
+	}
+
+	return TimeFormatPreviewResult{
+		Timezone:      loc.String(),
+		UnixTimestamp: t.Unix(),
+		Samples:       samples,
+		Warning:       warning,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_PreviewFormats(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	result, err := service.PreviewFormats(context.Background(), TimeFormatPreviewInput{Timestamp: "2024-03-05T14:07:09Z", Timezone: "America/New_York"})
+	require.NoError(t, err)
+	assert.Equal(t, "America/New_York", result.Timezone)
+	assert.Equal(t, int64(1709647629), result.UnixTimestamp)
+	require.Len(t, result.Samples, len(builtinFormats)+len(strftimePatterns))
+
+	samples := make(map[string]FormatSample, len(result.Samples))
+	for _, sample := range result.Samples {
+		assert.NotEmpty(t, sample.Description, sample.FormatName)
+		samples[sample.FormatName] = sample
+	}
+
+	// Every built-in format is previewed, even if not configured as supported
+	for _, format := range builtinFormats {
+		assert.True(t, IsValidFormat(string(format)))
+		assert.Contains(t, samples, string(format))
+	}
+
+	tests := []struct {
+		formatName string
+		expected   string
+	}{
+		{"RFC3339", "2024-03-05T09:07:09-05:00"},
+		{"Unix", "1709647629"},
+		{"UnixMilli", "1709647629000"},
+		{"Layout", "2024-03-05 09:07:09"},
+		{"%Y-%m-%d", "2024-03-05"},
+		{"%m/%d/%Y", "03/05/2024"},
+		{"%A, %B %d, %Y", "Tuesday, March 05, 2024"},
+		{"%a, %d %b %Y %H:%M:%S %z", "Tue, 05 Mar 2024 09:07:09 -0500"},
+		{"%I:%M %p", "09:07 AM"},
+		{"%Y%m%dT%H%M%S", "20240305T090709"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.formatName, func(t *testing.T) {
+			assert.Equal(t, tt.expected, samples[tt.formatName].Formatted)
+		})
+	}
+}
+
+func TestTimeService_PreviewFormats_Errors(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	_, err := service.PreviewFormats(context.Background(), TimeFormatPreviewInput{Timezone: "Invalid/Zone"})
+	assert.Error(t, err)
+
+	_, err = service.PreviewFormats(context.Background(), TimeFormatPreviewInput{Timestamp: "not a time"})
+	assert.Error(t, err)
+}
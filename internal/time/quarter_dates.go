@@ -0,0 +1,89 @@
+package time
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// GetQuarterDates returns the first and last day of a calendar or fiscal quarter. A fiscal year is
+// named after the calendar year it starts in, so with fiscal_year_start_month 4, Q1 of 2024 is
+// 2024-04-01 to 2024-06-30 and Q4 of 2024 ends on 2025-03-31.
+func (s *timeService) GetQuarterDates(ctx context.Context, input QuarterDatesInput) (QuarterDatesResult, error) {
+	if err := ctx.Err(); err != nil {
+		return QuarterDatesResult{}, err
+	}
+
+	startMonth := input.FiscalYearStartMonth
+	if startMonth == 0 {
+		startMonth = 1
+	}
+	if startMonth < 1 || startMonth > 12 {
+		return QuarterDatesResult{}, validationErrorf(CodeInvalidArgument, "fiscal_year_start_month must be between 1 and 12, got %d", startMonth)
+	}
+	if input.Quarter < 0 || input.Quarter > 4 {
+		return QuarterDatesResult{}, validationErrorf(CodeInvalidArgument, "quarter must be between 1 and 4, got %d", input.Quarter)
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return QuarterDatesResult{}, err
+	}
+
+	// Months since the start of the fiscal year the current date falls in
+	now := s.clock.Now().In(loc)
+	monthsIntoYear := (int(now.Month()) - startMonth + 12) % 12
+	currentYear := now.Year()
+	if int(now.Month()) < startMonth {
+		currentYear--
+	}
+
+	year, quarter := input.Year, input.Quarter
+	if year == 0 {
+		year = currentYear
+	}
+	if quarter == 0 {
+		quarter = monthsIntoYear/3 + 1
+	}
+	if year < 1 || year > 9998 {
+		return QuarterDatesResult{}, validationErrorf(CodeInvalidArgument, "year must be between 1 and 9998, got %d", year)
+	}
+
+	start := time.Date(year, time.Month(startMonth+(quarter-1)*3), 1, 0, 0, 0, 0, loc)
+	end := time.Date(start.Year(), start.Month()+3, 0, 0, 0, 0, 0, loc)
+
+	result := QuarterDatesResult{
+		Year:                 year,
+		Quarter:              quarter,
+		FiscalYearStartMonth: startMonth,
+		QuarterStart:         start.Format("2006-01-02"),
+		QuarterEnd:           end.Format("2006-01-02"),
+		MonthCount:           3,
+		WeekCount:            daysBetweenDates(mondayOf(start), mondayOf(end))/7 + 1,
+		DayCount:             daysBetweenDates(start, end) + 1,
+		IsCurrentQuarter:     year == currentYear && quarter == monthsIntoYear/3+1,
+		Timezone:             loc.String(),
+		Warning:              warning,
+	}
+
+	s.logger.Debug("Computed quarter dates",
+		zap.Int("year", year),
+		zap.Int("quarter", quarter),
+		zap.Int("fiscal_year_start_month", startMonth),
+		zap.String("quarter_start", result.QuarterStart))
+
+	return result, nil
+}
+
+// daysBetweenDates counts the calendar days from one date to another, negative when to is earlier,
+// ignoring DST changes
+func daysBetweenDates(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
@@ -0,0 +1,117 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetQuarterDates(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name            string
+		input           QuarterDatesInput
+		expectedYear    int
+		expectedQuarter int
+		expectedStart   string
+		expectedEnd     string
+		expectedWeeks   int
+		expectedDays    int
+		expectedCurrent bool
+		expectError     bool
+	}{
+		{
+			name:            "calendar quarter in leap year",
+			input:           QuarterDatesInput{Year: 2024, Quarter: 1},
+			expectedYear:    2024,
+			expectedQuarter: 1,
+			expectedStart:   "2024-01-01",
+			expectedEnd:     "2024-03-31",
+			expectedWeeks:   13,
+			expectedDays:    91,
+		},
+		{
+			name:            "fiscal first quarter",
+			input:           QuarterDatesInput{Year: 2024, Quarter: 1, FiscalYearStartMonth: 4},
+			expectedYear:    2024,
+			expectedQuarter: 1,
+			expectedStart:   "2024-04-01",
+			expectedEnd:     "2024-06-30",
+			expectedWeeks:   13,
+			expectedDays:    91,
+		},
+		{
+			name:            "fiscal quarter crossing the calendar year",
+			input:           QuarterDatesInput{Year: 2024, Quarter: 4, FiscalYearStartMonth: 4},
+			expectedYear:    2024,
+			expectedQuarter: 4,
+			expectedStart:   "2025-01-01",
+			expectedEnd:     "2025-03-31",
+			expectedWeeks:   14,
+			expectedDays:    90,
+			expectedCurrent: true,
+		},
+		{
+			name:            "fiscal quarter spanning December",
+			input:           QuarterDatesInput{Year: 2024, Quarter: 1, FiscalYearStartMonth: 11},
+			expectedYear:    2024,
+			expectedQuarter: 1,
+			expectedStart:   "2024-11-01",
+			expectedEnd:     "2025-01-31",
+			expectedWeeks:   14,
+			expectedDays:    92,
+		},
+		{
+			name:            "current calendar quarter",
+			input:           QuarterDatesInput{},
+			expectedYear:    2025,
+			expectedQuarter: 1,
+			expectedStart:   "2025-01-01",
+			expectedEnd:     "2025-03-31",
+			expectedWeeks:   14,
+			expectedDays:    90,
+			expectedCurrent: true,
+		},
+		{
+			name:            "current fiscal quarter",
+			input:           QuarterDatesInput{FiscalYearStartMonth: 10},
+			expectedYear:    2024,
+			expectedQuarter: 2,
+			expectedStart:   "2025-01-01",
+			expectedEnd:     "2025-03-31",
+			expectedWeeks:   14,
+			expectedDays:    90,
+			expectedCurrent: true,
+		},
+		{name: "quarter too large", input: QuarterDatesInput{Year: 2024, Quarter: 5}, expectError: true},
+		{name: "invalid start month", input: QuarterDatesInput{Year: 2024, Quarter: 1, FiscalYearStartMonth: 13}, expectError: true},
+		{name: "invalid year", input: QuarterDatesInput{Year: -1, Quarter: 1}, expectError: true},
+		{name: "invalid timezone", input: QuarterDatesInput{Timezone: "Mars/Olympus"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetQuarterDates(context.Background(), tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedYear, result.Year)
+			assert.Equal(t, tt.expectedQuarter, result.Quarter)
+			assert.Equal(t, tt.expectedStart, result.QuarterStart)
+			assert.Equal(t, tt.expectedEnd, result.QuarterEnd)
+			assert.Equal(t, 3, result.MonthCount)
+			assert.Equal(t, tt.expectedWeeks, result.WeekCount)
+			assert.Equal(t, tt.expectedDays, result.DayCount)
+			assert.Equal(t, tt.expectedCurrent, result.IsCurrentQuarter)
+		})
+	}
+}
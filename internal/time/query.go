@@ -0,0 +1,53 @@
+package time
+
+import (
+	"context"
+	"time"
+
+	"github.com/topfreegames/mcp-server-time/internal/time/dsl"
+	"go.uber.org/zap"
+)
+
+// EvaluateTimeQuery computes the time described by a query in the time DSL, such as
+// "last business day of previous quarter" or "first Monday of next month at 9am UTC"
+func (s *timeService) EvaluateTimeQuery(ctx context.Context, input TimeExpressionInput) (TimeExpressionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeExpressionResult{}, err
+	}
+
+	query, err := dsl.Parse(input.Expression)
+	if err != nil {
+		return TimeExpressionResult{}, parseErrorf(CodeInvalidExpression, "invalid expression %q: %w", input.Expression, err)
+	}
+
+	// A timezone named in the expression takes precedence over the timezone parameter
+	timezone := input.Timezone
+	if query.Zone != "" {
+		timezone = query.Zone
+	}
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	s.logger.Debug("Evaluating time query",
+		zap.String("expression", input.Expression),
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeExpressionResult{}, err
+	}
+
+	t, err := query.Evaluate(s.clock.Now().In(loc))
+	if err != nil {
+		return TimeExpressionResult{}, parseErrorf(CodeInvalidExpression, "invalid expression %q: %w", input.Expression, err)
+	}
+
+	return TimeExpressionResult{
+		ResultTime:           t.Format(time.RFC3339),
+		UnixTimestamp:        t.Unix(),
+		NormalizedExpression: query.String(),
+		Timezone:             loc.String(),
+		Warning:              warning,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_EvaluateTimeQuery(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	now := time.Date(2024, time.March, 13, 10, 0, 0, 0, time.UTC) // a Wednesday
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: now}))
+
+	tests := []struct {
+		name               string
+		input              TimeExpressionInput
+		wantErr            bool
+		expectedTime       string
+		expectedNormalized string
+		expectedTimezone   string
+	}{
+		{
+			name:               "last business day of previous quarter",
+			input:              TimeExpressionInput{Expression: "last business day of previous quarter"},
+			expectedTime:       "2023-12-29T00:00:00Z",
+			expectedNormalized: "last business day of previous quarter",
+			expectedTimezone:   "UTC",
+		},
+		{
+			name:               "timezone parameter",
+			input:              TimeExpressionInput{Expression: "first Monday of next month at 9am", Timezone: "Europe/Paris"},
+			expectedTime:       "2024-04-01T09:00:00+02:00",
+			expectedNormalized: "first Monday of next month at 09:00",
+			expectedTimezone:   "Europe/Paris",
+		},
+		{
+			name:               "timezone in the expression wins",
+			input:              TimeExpressionInput{Expression: "today at noon America/New_York", Timezone: "Asia/Tokyo"},
+			expectedTime:       "2024-03-13T12:00:00-04:00",
+			expectedNormalized: "today at 12:00 America/New_York",
+			expectedTimezone:   "America/New_York",
+		},
+		{name: "syntax error", input: TimeExpressionInput{Expression: "first Monday of"}, wantErr: true},
+		{name: "evaluation error", input: TimeExpressionInput{Expression: "fifth Monday of previous month"}, wantErr: true},
+		{name: "invalid timezone", input: TimeExpressionInput{Expression: "now", Timezone: "Mars/Olympus"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.EvaluateTimeQuery(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTime, result.ResultTime)
+			assert.Equal(t, tt.expectedNormalized, result.NormalizedExpression)
+			assert.Equal(t, tt.expectedTimezone, result.Timezone)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package time
+
+import (
+	"strings"
+	"time"
+)
+
+// ResolveRelativeExpression resolves a natural language time relative to ref in loc. Supported
+// expressions are "now", "today", "yesterday", "tomorrow", "[last|next|this] <weekday>" and
+// "start of|end of" followed by "day", "week", "month" or "year". Days resolve to midnight, weeks
+// start on Monday, and "end of" is the last nanosecond of the period.
+func ResolveRelativeExpression(expr string, ref time.Time, loc *time.Location) (time.Time, error) {
+	words := strings.Fields(strings.ToLower(expr))
+	if len(words) == 0 {
+		return time.Time{}, parseErrorf(CodeInvalidExpression, "relative expression cannot be empty")
+	}
+
+	ref = ref.In(loc)
+	midnight := time.Date(ref.Year(), ref.Month(), ref.Day(), 0, 0, 0, 0, loc)
+
+	switch len(words) {
+	case 1:
+		switch words[0] {
+		case "now":
+			return ref, nil
+		case "today":
+			return midnight, nil
+		case "yesterday":
+			return midnight.AddDate(0, 0, -1), nil
+		case "tomorrow":
+			return midnight.AddDate(0, 0, 1), nil
+		}
+
+		if day, ok := parseWeekday(words[0]); ok {
+			return relativeWeekday(midnight, day, "this"), nil
+		}
+	case 2:
+		if day, ok := parseWeekday(words[1]); ok {
+			switch words[0] {
+			case "last", "next", "this":
+				return relativeWeekday(midnight, day, words[0]), nil
+			}
+		}
+	case 3:
+		if words[1] == "of" && (words[0] == "start" || words[0] == "end") {
+			start, next, ok := periodBounds(midnight, words[2])
+			if !ok {
+				return time.Time{}, parseErrorf(CodeInvalidExpression, "unknown period %q (expected day, week, month or year)", words[2])
+			}
+
+			if words[0] == "start" {
+				return start, nil
+			}
+			return next.Add(-time.Nanosecond), nil
+		}
+	}
+
+	return time.Time{}, parseErrorf(CodeInvalidExpression, "unsupported relative expression %q", expr)
+}
+
+// periodBounds returns the start of the period containing midnight and the start of the next one
+func periodBounds(midnight time.Time, period string) (time.Time, time.Time, bool) {
+	switch period {
+	case "day":
+		return midnight, midnight.AddDate(0, 0, 1), true
+	case "week":
+		start := midnight.AddDate(0, 0, -((int(midnight.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7), true
+	case "month":
+		start := time.Date(midnight.Year(), midnight.Month(), 1, 0, 0, 0, 0, midnight.Location())
+		return start, start.AddDate(0, 1, 0), true
+	case "year":
+		start := time.Date(midnight.Year(), time.January, 1, 0, 0, 0, 0, midnight.Location())
+		return start, start.AddDate(1, 0, 0), true
+	}
+
+	return time.Time{}, time.Time{}, false
+}
@@ -0,0 +1,81 @@
+package time
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// parseRelativeTime resolves a natural language expression relative to ref in loc. On top of the
+// expressions accepted by ResolveRelativeExpression it understands "<n> <unit> ago",
+// "<n> <unit> from now", "in <n> <unit>" and "last|next|this <unit>", where unit is any unit of
+// time_arithmetic_expression and n may be written out ("three days ago", "an hour ago"). Shifts
+// by a number of units keep the time of day of ref.
+func parseRelativeTime(expr string, ref time.Time, loc *time.Location) (time.Time, error) {
+	ref = ref.In(loc)
+
+	if t, err := ResolveRelativeExpression(expr, ref, loc); err == nil {
+		return t, nil
+	}
+
+	words := strings.Fields(strings.ToLower(expr))
+	switch {
+	case len(words) == 3 && words[2] == "ago":
+		if d, ok := parseRelativeDuration(words[0], words[1]); ok {
+			return d.addToRelative(ref, -1)
+		}
+	case len(words) == 4 && words[2] == "from" && words[3] == "now":
+		if d, ok := parseRelativeDuration(words[0], words[1]); ok {
+			return d.addToRelative(ref, 1)
+		}
+	case len(words) == 3 && words[0] == "in":
+		if d, ok := parseRelativeDuration(words[1], words[2]); ok {
+			return d.addToRelative(ref, 1)
+		}
+	case len(words) == 2:
+		unit, ok := exprUnits[words[1]]
+		if !ok {
+			break
+		}
+
+		d := exprDuration{amount: 1, unit: unit}
+		switch words[0] {
+		case "last":
+			return d.addToRelative(ref, -1)
+		case "next":
+			return d.addToRelative(ref, 1)
+		case "this":
+			return ref, nil
+		}
+	}
+
+	return time.Time{}, parseErrorf(CodeInvalidExpression, "unsupported relative expression %q", expr)
+}
+
+// parseRelativeDuration parses an amount (digits or a number word) and a unit
+func parseRelativeDuration(amount, unit string) (exprDuration, bool) {
+	canonical, ok := exprUnits[unit]
+	if !ok {
+		return exprDuration{}, false
+	}
+
+	n, ok := numberWords[amount]
+	if !ok {
+		var err error
+		n, err = strconv.Atoi(amount)
+		if err != nil || n < 0 || n > maxExprAmount {
+			return exprDuration{}, false
+		}
+	}
+
+	return exprDuration{amount: n, unit: canonical}, true
+}
+
+// addToRelative adds the duration to ref, reporting results outside years 1-9999 as parse errors
+func (d exprDuration) addToRelative(ref time.Time, sign int) (time.Time, error) {
+	t, err := d.addTo(ref, sign)
+	if err != nil {
+		return time.Time{}, parseErrorf(CodeInvalidExpression, "invalid relative expression: %w", err)
+	}
+	return t, nil
+}
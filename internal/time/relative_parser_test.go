@@ -0,0 +1,84 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_parseRelativeTime(t *testing.T) {
+	// Friday, March 15, 2024
+	ref := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected string
+	}{
+		{"yesterday", "yesterday", "2024-03-14T00:00:00Z"},
+		{"today", "Today", "2024-03-15T00:00:00Z"},
+		{"tomorrow", "tomorrow", "2024-03-16T00:00:00Z"},
+		{"last weekday", "last Tuesday", "2024-03-12T00:00:00Z"},
+		{"next weekday", "next friday", "2024-03-22T00:00:00Z"},
+		{"hours ago", "3 hours ago", "2024-03-15T11:30:00Z"},
+		{"spelled-out amount", "an hour ago", "2024-03-15T13:30:00Z"},
+		{"number word", "three days ago", "2024-03-12T14:30:00Z"},
+		{"from now", "2 weeks from now", "2024-03-29T14:30:00Z"},
+		{"in", "in 10 minutes", "2024-03-15T14:40:00Z"},
+		{"next month", "next month", "2024-04-15T14:30:00Z"},
+		{"last year", "last year", "2023-03-15T14:30:00Z"},
+		{"next week", "next week", "2024-03-22T14:30:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseRelativeTime(tt.expr, ref, time.UTC)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Format(time.RFC3339))
+		})
+	}
+}
+
+func Test_parseRelativeTime_Invalid(t *testing.T) {
+	ref := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+
+	for _, expr := range []string{"", "3 fortnights ago", "some days ago", "next blue moon", "2024-03-15T00:00:00Z", "9999999999999 hours ago", "in 9000 years"} {
+		t.Run(expr, func(t *testing.T) {
+			_, err := parseRelativeTime(expr, ref, time.UTC)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTimeService_ParseTime_AllowRelative(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	t.Run("resolved in the timezone", func(t *testing.T) {
+		result, err := service.ParseTime(context.Background(), ParseTimeInput{
+			TimeString:    "yesterday",
+			Timezone:      "America/New_York",
+			AllowRelative: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-03-14T00:00:00-04:00", result.RFC3339)
+	})
+
+	t.Run("absolute strings still parse", func(t *testing.T) {
+		result, err := service.ParseTime(context.Background(), ParseTimeInput{
+			TimeString:    "2024-01-01T00:00:00Z",
+			AllowRelative: true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-01T00:00:00Z", result.UTCTime)
+	})
+
+	t.Run("disabled by default", func(t *testing.T) {
+		_, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "3 hours ago"})
+		assert.Error(t, err)
+	})
+}
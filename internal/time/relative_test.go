@@ -0,0 +1,72 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestResolveRelativeExpression(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	ref := time.Date(2024, time.February, 14, 15, 30, 0, 0, loc) // a Wednesday
+
+	tests := []struct {
+		expr     string
+		wantErr  bool
+		expected string
+	}{
+		{expr: "now", expected: "2024-02-14T15:30:00-05:00"},
+		{expr: "Today", expected: "2024-02-14T00:00:00-05:00"},
+		{expr: "yesterday", expected: "2024-02-13T00:00:00-05:00"},
+		{expr: "tomorrow", expected: "2024-02-15T00:00:00-05:00"},
+		{expr: "last monday", expected: "2024-02-12T00:00:00-05:00"},
+		{expr: "next wednesday", expected: "2024-02-21T00:00:00-05:00"},
+		{expr: "friday", expected: "2024-02-16T00:00:00-05:00"},
+		{expr: "start of week", expected: "2024-02-12T00:00:00-05:00"},
+		{expr: "end of day", expected: "2024-02-14T23:59:59.999999999-05:00"},
+		{expr: "start of month", expected: "2024-02-01T00:00:00-05:00"},
+		{expr: "end of month", expected: "2024-02-29T23:59:59.999999999-05:00"},
+		{expr: "end of year", expected: "2024-12-31T23:59:59.999999999-05:00"},
+		{expr: "", wantErr: true},
+		{expr: "start of decade", wantErr: true},
+		{expr: "the day after tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			result, err := ResolveRelativeExpression(tt.expr, ref, loc)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Format(time.RFC3339Nano))
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_RelativeExpression(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	now := time.Date(2024, time.February, 14, 3, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: now}))
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "America/New_York", RelativeExpression: "start of month"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-01T00:00:00-05:00", result.FormattedTime)
+
+	// The current day is resolved in the requested timezone, where it is still February 13
+	result, err = service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "America/New_York", RelativeExpression: "today"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-13T00:00:00-05:00", result.FormattedTime)
+
+	_, err = service.GetCurrentTime(context.Background(), GetTimeInput{RelativeExpression: "someday"})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid relative_expression")
+}
@@ -0,0 +1,144 @@
+package time
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Season calculation methods
+const (
+	// SeasonTypeMeteorological splits the year into whole months: spring starts on March 1
+	SeasonTypeMeteorological = "meteorological"
+	// SeasonTypeAstronomical starts each season at an equinox or solstice
+	SeasonTypeAstronomical = "astronomical"
+)
+
+// northernSeasons are the northern hemisphere seasons starting at the March, June, September
+// and December boundaries
+var northernSeasons = [4]string{"spring", "summer", "autumn", "winter"}
+
+// oppositeSeasons maps a northern hemisphere season to the southern hemisphere one
+var oppositeSeasons = map[string]string{
+	"spring": "autumn", "summer": "winter", "autumn": "spring", "winter": "summer",
+}
+
+// seasonInfo describes the season a date falls in
+type seasonInfo struct {
+	northern string
+	southern string
+	start    time.Time
+	end      time.Time
+}
+
+// seasonFor returns the season of t's calendar date. Both hemispheres share the boundaries,
+// which are the equinox and solstice dates (UTC) for astronomical seasons.
+func seasonFor(t time.Time, seasonType string) (seasonInfo, error) {
+	var boundariesOf func(year int) [4]time.Time
+	switch seasonType {
+	case "", SeasonTypeMeteorological:
+		boundariesOf = meteorologicalSeasonStarts
+	case SeasonTypeAstronomical:
+		boundariesOf = equinoxSolsticeDates
+	default:
+		return seasonInfo{}, validationErrorf(CodeInvalidArgument, "invalid season_type %s (must be one of: %s, %s)",
+			seasonType, SeasonTypeMeteorological, SeasonTypeAstronomical)
+	}
+
+	year, month, day := t.Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	// The previous December boundary covers January and February, the next March one ends December
+	previous, current, next := boundariesOf(year-1), boundariesOf(year), boundariesOf(year+1)
+	starts := []time.Time{previous[3], current[0], current[1], current[2], current[3], next[0]}
+
+	for i := len(starts) - 2; i >= 0; i-- {
+		if !date.Before(starts[i]) {
+			northern := northernSeasons[(i+3)%4]
+			return seasonInfo{
+				northern: northern,
+				southern: oppositeSeasons[northern],
+				start:    starts[i],
+				end:      starts[i+1].AddDate(0, 0, -1),
+			}, nil
+		}
+	}
+
+	// Unreachable: the previous December boundary always precedes the date
+	return seasonInfo{}, fmt.Errorf("no season found for %s", date.Format("2006-01-02"))
+}
+
+// meteorologicalSeasonStarts returns March 1, June 1, September 1 and December 1 of year
+func meteorologicalSeasonStarts(year int) [4]time.Time {
+	return [4]time.Time{
+		time.Date(year, time.March, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.June, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.September, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// equinoxSolsticeCoefficients are the polynomial coefficients in millennia from J2000 of the mean
+// March equinox, June solstice, September equinox and December solstice (Meeus, Astronomical
+// Algorithms, table 27.b, valid for the years 1000 to 3000)
+var equinoxSolsticeCoefficients = [4][5]float64{
+	{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+	{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+	{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+	{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+}
+
+// equinoxPeriodicTerms correct the mean equinoxes and solstices (Meeus, table 27.c): amplitude,
+// phase in degrees and rate in degrees per Julian century
+var equinoxPeriodicTerms = [24][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186}, {182, 27.85, 445267.112},
+	{156, 73.14, 45036.886}, {136, 171.52, 22518.443}, {77, 222.54, 65928.934}, {74, 296.72, 3034.906},
+	{70, 243.58, 9037.513}, {58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417}, {18, 155.12, 67555.328},
+	{17, 288.79, 4562.452}, {16, 198.04, 62894.029}, {14, 199.76, 31436.921}, {12, 95.39, 14577.848},
+	{12, 287.11, 31931.756}, {12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// equinoxSolsticeDates returns the UTC dates of the March equinox, June solstice, September
+// equinox and December solstice of year, accurate to about a minute between 1000 and 3000
+func equinoxSolsticeDates(year int) [4]time.Time {
+	var dates [4]time.Time
+	for i, coefficients := range equinoxSolsticeCoefficients {
+		dates[i] = truncateToDate(julianDateToTime(equinoxSolsticeJDE(year, coefficients)))
+	}
+	return dates
+}
+
+// equinoxSolsticeJDE computes the Julian Ephemeris Day of an equinox or solstice
+func equinoxSolsticeJDE(year int, coefficients [5]float64) float64 {
+	y := (float64(year) - 2000) / 1000
+	jde0 := coefficients[0] + y*(coefficients[1]+y*(coefficients[2]+y*(coefficients[3]+y*coefficients[4])))
+
+	centuries := (jde0 - 2451545.0) / 36525
+	w := degreesToRadians(35999.373*centuries - 2.47)
+	deltaLambda := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	var s float64
+	for _, term := range equinoxPeriodicTerms {
+		s += term[0] * math.Cos(degreesToRadians(term[1]+term[2]*centuries))
+	}
+
+	return jde0 + 0.00001*s/deltaLambda
+}
+
+// julianDateToTime converts a Julian Date to a UTC time, ignoring the minute separating TT from UTC
+func julianDateToTime(jd float64) time.Time {
+	seconds := (jd - julianDateUnixEpoch) * secondsPerDay
+	return time.Unix(int64(math.Floor(seconds)), 0).UTC()
+}
+
+// truncateToDate returns midnight UTC of t's UTC date
+func truncateToDate(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// degreesToRadians converts an angle in degrees to radians
+func degreesToRadians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
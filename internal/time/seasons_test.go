@@ -0,0 +1,83 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestSeasonFor(t *testing.T) {
+	tests := []struct {
+		name             string
+		date             time.Time
+		seasonType       string
+		expectedNorthern string
+		expectedSouthern string
+		expectedStart    string
+		expectedEnd      string
+	}{
+		{"meteorological spring start", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "", "spring", "autumn", "2024-03-01", "2024-05-31"},
+		{"meteorological summer", time.Date(2024, 7, 4, 12, 0, 0, 0, time.UTC), SeasonTypeMeteorological, "summer", "winter", "2024-06-01", "2024-08-31"},
+		{"meteorological winter in january", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), "", "winter", "summer", "2023-12-01", "2024-02-29"},
+		{"meteorological winter in december", time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), "", "winter", "summer", "2023-12-01", "2024-02-29"},
+		{"meteorological autumn end", time.Date(2024, 11, 30, 23, 59, 0, 0, time.UTC), "", "autumn", "spring", "2024-09-01", "2024-11-30"},
+		{"astronomical before the march equinox", time.Date(2024, 3, 19, 0, 0, 0, 0, time.UTC), SeasonTypeAstronomical, "winter", "summer", "2023-12-22", "2024-03-19"},
+		{"astronomical march equinox", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC), SeasonTypeAstronomical, "spring", "autumn", "2024-03-20", "2024-06-19"},
+		{"astronomical june solstice", time.Date(2024, 6, 20, 0, 0, 0, 0, time.UTC), SeasonTypeAstronomical, "summer", "winter", "2024-06-20", "2024-09-21"},
+		{"astronomical september equinox", time.Date(2024, 9, 22, 0, 0, 0, 0, time.UTC), SeasonTypeAstronomical, "autumn", "spring", "2024-09-22", "2024-12-20"},
+		{"astronomical december solstice", time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), SeasonTypeAstronomical, "winter", "summer", "2024-12-21", "2025-03-19"},
+		{"local calendar date", time.Date(2024, 3, 1, 0, 30, 0, 0, time.FixedZone("UTC+1", 3600)), "", "spring", "autumn", "2024-03-01", "2024-05-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			season, err := seasonFor(tt.date, tt.seasonType)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNorthern, season.northern)
+			assert.Equal(t, tt.expectedSouthern, season.southern)
+			assert.Equal(t, tt.expectedStart, season.start.Format("2006-01-02"))
+			assert.Equal(t, tt.expectedEnd, season.end.Format("2006-01-02"))
+		})
+	}
+
+	_, err := seasonFor(time.Now(), "solar")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid season_type solar")
+}
+
+func TestEquinoxSolsticeDates(t *testing.T) {
+	// Published UTC instants: 2024-03-20 03:06, 06-20 20:51, 09-22 12:44, 12-21 09:21
+	dates := equinoxSolsticeDates(2024)
+	expected := []string{"2024-03-20", "2024-06-20", "2024-09-22", "2024-12-21"}
+	for i, date := range dates {
+		assert.Equal(t, expected[i], date.Format("2006-01-02"))
+	}
+
+	instant := julianDateToTime(equinoxSolsticeJDE(2024, equinoxSolsticeCoefficients[0]))
+	assert.WithinDuration(t, time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC), instant, 3*time.Minute)
+}
+
+func TestTimeService_Seasons(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	current, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "spring", current.SeasonNorthern)
+	assert.Equal(t, "autumn", current.SeasonSouthern)
+	assert.Equal(t, "2024-03-01", current.SeasonStartDate)
+	assert.Equal(t, "2024-05-31", current.SeasonEndDate)
+
+	parsed, err := service.ParseTime(context.Background(), ParseTimeInput{TimeString: "2024-03-15T14:30:00Z", SeasonType: SeasonTypeAstronomical})
+	require.NoError(t, err)
+	assert.Equal(t, "winter", parsed.SeasonNorthern)
+	assert.Equal(t, "summer", parsed.SeasonSouthern)
+	assert.Equal(t, "2023-12-22", parsed.SeasonStartDate)
+
+	_, err = service.GetCurrentTime(context.Background(), GetTimeInput{SeasonType: "lunar"})
+	require.Error(t, err)
+}
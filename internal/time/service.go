@@ -1,8 +1,15 @@
 package time
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"go.uber.org/zap"
@@ -13,25 +20,106 @@ import (
 // TimeService defines the interface for time operations
 type TimeService interface {
 	// GetCurrentTime returns the current time in the specified timezone and format
-	GetCurrentTime(input GetTimeInput) (GetTimeResult, error)
+	GetCurrentTime(ctx context.Context, input GetTimeInput) (GetTimeResult, error)
+
+	// BatchGetCurrentTime returns the current time for several queries at once
+	BatchGetCurrentTime(ctx context.Context, input BatchGetTimeInput) (BatchGetTimeResult, error)
 
 	// FormatTime formats a timestamp using the specified format and timezone
-	FormatTime(input FormatTimeInput) (FormatTimeResult, error)
+	FormatTime(ctx context.Context, input FormatTimeInput) (FormatTimeResult, error)
+
+	// BatchFormatTime formats several timestamps at once
+	BatchFormatTime(ctx context.Context, input BatchFormatTimeInput) (BatchFormatTimeResult, error)
 
 	// ParseTime parses a time string and returns timestamp information
-	ParseTime(input ParseTimeInput) (ParseTimeResult, error)
+	ParseTime(ctx context.Context, input ParseTimeInput) (ParseTimeResult, error)
 
 	// GetTimezoneInfo returns information about a timezone
-	GetTimezoneInfo(input TimezoneInfoInput) (TimezoneInfo, error)
+	GetTimezoneInfo(ctx context.Context, input TimezoneInfoInput) (TimezoneInfo, error)
+
+	// GetTimezoneHistory returns the current name, deprecated aliases and country changes of a timezone
+	GetTimezoneHistory(ctx context.Context, input TimezoneHistoryInput) (TimezoneHistoryResult, error)
+
+	// SummarizeWeek describes an ISO 8601 week: its dates, number, quarter, DST changes and holidays
+	SummarizeWeek(ctx context.Context, input WeeklyReportInput) (WeeklyReportResult, error)
+
+	// LastDayOfMonth returns the last day of a month, with its weekday and the number of days in the month
+	LastDayOfMonth(ctx context.Context, input LastDayOfMonthInput) (LastDayOfMonthResult, error)
+
+	// GetQuarterDates returns the first and last day of a calendar or fiscal quarter
+	GetQuarterDates(ctx context.Context, input QuarterDatesInput) (QuarterDatesResult, error)
+
+	// AddBusinessDays moves a date by a number of business days, skipping weekends and a country's holidays
+	AddBusinessDays(ctx context.Context, input DateAddBusinessDaysInput) (DateAddBusinessDaysResult, error)
+
+	// GetTimezoneOffsetAt returns the UTC offset of a timezone at a specific moment
+	GetTimezoneOffsetAt(ctx context.Context, input TimezoneOffsetAtInput) (TimezoneOffsetAtResult, error)
+
+	// ListTimezoneOffsets returns all distinct current UTC offsets, sorted from west to east
+	ListTimezoneOffsets(ctx context.Context, input TimezoneOffsetListInput) (TimezoneOffsetListResult, error)
+
+	// Countdown returns the time remaining until (or elapsed since) a target date
+	Countdown(ctx context.Context, input CountdownInput) (CountdownResult, error)
+
+	// TimeInWords expresses a timestamp's time of day in natural language
+	TimeInWords(ctx context.Context, input TimeInWordsInput) (TimeInWordsResult, error)
+
+	// FindWorkingHoursOverlap finds when the working hours of two timezones overlap on a reference date
+	FindWorkingHoursOverlap(ctx context.Context, input TimeOverlapInput) (TimeOverlapResult, error)
+
+	// EvaluateTimeExpression computes the time described by an arithmetic expression
+	EvaluateTimeExpression(ctx context.Context, input TimeArithmeticInput) (TimeArithmeticResult, error)
+
+	// AddWallClock adds years, months, days, hours, minutes and seconds on the wall clock of a timezone
+	AddWallClock(ctx context.Context, input DSTSafeAddInput) (DSTSafeAddResult, error)
+
+	// EvaluateTimeQuery computes the time described by a query in the time DSL
+	EvaluateTimeQuery(ctx context.Context, input TimeExpressionInput) (TimeExpressionResult, error)
+
+	// BucketTimestamps bins timestamps into contiguous time buckets
+	BucketTimestamps(ctx context.Context, input TimeHistogramInput) (TimeHistogramResult, error)
+
+	// CompareTimestamps reports whether one timestamp is before, equal to or after another
+	CompareTimestamps(ctx context.Context, input CompareTimestampsInput) (CompareTimestampsResult, error)
+
+	// CheckClockSkew compares a client-reported time with the server's current time
+	CheckClockSkew(ctx context.Context, input ClockSkewCheckInput) (ClockSkewCheckResult, error)
+
+	// CheckNTPSync queries several NTP servers and reports their consensus offset and disagreement
+	CheckNTPSync(ctx context.Context, input NTPSyncCheckInput) (NTPSyncCheckResult, error)
+
+	// FindOptimalMeetingTime ranks meeting slots by how many participants are within working hours
+	FindOptimalMeetingTime(ctx context.Context, input OptimalMeetingTimeInput) (OptimalMeetingTimeResult, error)
+
+	// GenerateTimeGrid lists the time in several timezones at regular intervals
+	GenerateTimeGrid(ctx context.Context, input TimeGridInput) (TimeGridResult, error)
+
+	// ComputeTimeSeriesStats computes descriptive statistics over a list of timestamps
+	ComputeTimeSeriesStats(ctx context.Context, input TimeSeriesStatsInput) (TimeSeriesStatsResult, error)
+
+	// ForecastTimeSeries projects the next timestamps of an evenly spaced series
+	ForecastTimeSeries(ctx context.Context, input TimeSeriesForecastInput) (TimeSeriesForecastResult, error)
+
+	// PreviewFormats renders a timestamp in every built-in format and common strftime patterns
+	PreviewFormats(ctx context.Context, input TimeFormatPreviewInput) (TimeFormatPreviewResult, error)
+
+	// ListFormats describes every supported format with an example of the current time
+	ListFormats(ctx context.Context) ListFormatsResult
 
 	// ConvertTimezone converts a time from one timezone to another (kept for internal use)
-	ConvertTimezone(t time.Time, fromTZ, toTZ string) (time.Time, error)
+	ConvertTimezone(ctx context.Context, t time.Time, fromTZ, toTZ string) (time.Time, error)
 
 	// IsFormatSupported checks if a format is supported
 	IsFormatSupported(format string) bool
 
 	// GetSupportedFormats returns a list of supported formats
 	GetSupportedFormats() []string
+
+	// RegisterPlugin adds the output formats of a plugin
+	RegisterPlugin(p Plugin) error
+
+	// Stats returns how often the main operations were called and how many of those calls failed
+	Stats() ServiceStats
 }
 
 // timeService implements the TimeService interface
@@ -39,21 +127,89 @@ type timeService struct {
 	defaultTimezone  string
 	defaultFormat    string
 	supportedFormats []string
+	weekNumbering    string
+	allowMockNow     bool
+	fallbackTimezone string
+	fallbackFormats  []string
+	ntpSyncServers   []string
+	clock            Clock
 	logger           *zap.Logger
+
+	// configuredFormat is the default format before falling back, kept for plugins providing it
+	configuredFormat string
+
+	pluginsMu sync.RWMutex
+	plugins   map[FormatType]Plugin
+
+	// Operation counters reported by Stats
+	totalGetTimeCalls      atomic.Int64
+	totalFormatTimeCalls   atomic.Int64
+	totalParseTimeCalls    atomic.Int64
+	totalTimezoneInfoCalls atomic.Int64
+	totalErrors            atomic.Int64
+}
+
+// Option configures optional time service behavior
+type Option func(*timeService)
+
+// WithWeekNumbering sets the week numbering system ("iso" or "us") used for the primary week number.
+// An empty system keeps the ISO 8601 default.
+func WithWeekNumbering(system string) Option {
+	return func(s *timeService) {
+		if system != "" {
+			s.weekNumbering = system
+		}
+	}
+}
+
+// WithAllowMockNow enables the mock_now input of get_time, which replaces the real clock.
+// It must only be enabled in test deployments.
+func WithAllowMockNow(allow bool) Option {
+	return func(s *timeService) {
+		s.allowMockNow = allow
+	}
+}
+
+// WithClock replaces the system clock used whenever the service needs the current time.
+// A nil clock keeps the system clock.
+func WithClock(clock Clock) Option {
+	return func(s *timeService) {
+		if clock != nil {
+			s.clock = clock
+		}
+	}
 }
 
 // NewTimeService creates a new time service instance
-func NewTimeService(defaultTimezone, defaultFormat string, supportedFormats []string, logger *zap.Logger) TimeService {
-	return &timeService{
+func NewTimeService(defaultTimezone, defaultFormat string, supportedFormats []string, logger *zap.Logger, opts ...Option) TimeService {
+	s := &timeService{
 		defaultTimezone:  defaultTimezone,
 		defaultFormat:    defaultFormat,
+		configuredFormat: defaultFormat,
 		supportedFormats: supportedFormats,
+		weekNumbering:    WeekNumberingISO,
+		ntpSyncServers:   DefaultNTPSyncServers,
+		clock:            RealClock{},
 		logger:           logger,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.defaultFormat = s.resolveDefaultFormat()
+
+	return s
 }
 
 // GetCurrentTime returns the current time with result information
-func (s *timeService) GetCurrentTime(input GetTimeInput) (GetTimeResult, error) {
+func (s *timeService) GetCurrentTime(ctx context.Context, input GetTimeInput) (_ GetTimeResult, err error) {
+	defer s.countCall(&s.totalGetTimeCalls, &err)
+
+	if err := ctx.Err(); err != nil {
+		return GetTimeResult{}, err
+	}
+
 	timezone := input.Timezone
 	format := input.Format
 
@@ -64,26 +220,169 @@ func (s *timeService) GetCurrentTime(input GetTimeInput) (GetTimeResult, error)
 		format = s.defaultFormat
 	}
 
-	currentTime, err := s.getCurrentTimeInternal(timezone)
+	// Expanded up front so the result reports the zone an alias stands for
+	timezone = s.expandTimezoneAlias(timezone)
+
+	explainer := newExplainer(input.Explain)
+
+	currentTime, warning, err := s.getCurrentTimeInternal(timezone)
 	if err != nil {
 		return GetTimeResult{}, err
 	}
+	if warning != "" {
+		timezone = currentTime.Location().String()
+	}
+	explainer.Step("Read the current time: %s", currentTime.UTC().Format(time.RFC3339Nano))
+	explainer.Step("Converted to %s: %s", timezone, currentTime.Format(time.RFC3339Nano))
+
+	// Replace the real clock with the mocked time when allowed, otherwise ignore it
+	if input.MockNow != "" && s.allowMockNow {
+		currentTime, err = parseFlexibleTime(input.MockNow, currentTime.Location())
+		if err != nil {
+			return GetTimeResult{}, parseErrorf(CodeInvalidTimestamp, "invalid mock_now: %w", err)
+		}
+		explainer.Step("Replaced the current time with mock_now '%s': %s", input.MockNow, currentTime.Format(time.RFC3339))
+	}
+
+	if input.RelativeExpression != "" {
+		currentTime, err = ResolveRelativeExpression(input.RelativeExpression, currentTime, currentTime.Location())
+		if err != nil {
+			return GetTimeResult{}, parseErrorf(CodeInvalidExpression, "invalid relative_expression: %w", err)
+		}
+		explainer.Step("Resolved relative expression '%s' to %s", input.RelativeExpression, currentTime.Format(time.RFC3339Nano))
+	}
+	s.explainZone(explainer, currentTime)
 
 	formatted, err := s.formatTimeInternal(currentTime, format)
 	if err != nil {
 		return GetTimeResult{}, err
 	}
+	explainer.Step("Formatted with %s: %s", format, formatted)
+	explainer.Step("Converted to Unix: %d", currentTime.Unix())
 
-	return GetTimeResult{
-		FormattedTime: formatted,
-		Timezone:      timezone,
-		Format:        format,
-		UnixTimestamp: currentTime.Unix(),
-	}, nil
+	isoYear, isoWeek := currentTime.ISOWeek()
+	usWeek := usWeekNumber(currentTime)
+
+	season, err := seasonFor(currentTime, input.SeasonType)
+	if err != nil {
+		return GetTimeResult{}, err
+	}
+
+	result := GetTimeResult{
+		FormattedTime:       formatted,
+		Timezone:            timezone,
+		Format:              format,
+		UnixTimestamp:       currentTime.Unix(),
+		WeekNumber:          isoWeek,
+		WeekNumberingSystem: WeekNumberingISO,
+		ISOWeekNumber:       isoWeek,
+		ISOWeekYear:         isoYear,
+		USWeekNumber:        usWeek,
+		SeasonNorthern:      season.northern,
+		SeasonSouthern:      season.southern,
+		SeasonStartDate:     season.start.Format("2006-01-02"),
+		SeasonEndDate:       season.end.Format("2006-01-02"),
+		Warning:             warning,
+	}
+
+	setDateNames(&result, currentTime)
+
+	// Date.getTimezoneOffset() counts minutes from local time to UTC, the opposite sign of the
+	// offset itself, so New York in winter is 300 and Kolkata -330
+	_, offsetSeconds := currentTime.Zone()
+	result.OffsetMinutesFromUTC = offsetSeconds / 60
+	result.JSTimezoneOffset = -result.OffsetMinutesFromUTC
+
+	if s.weekNumbering == WeekNumberingUS {
+		result.WeekNumber = usWeek
+		result.WeekNumberingSystem = WeekNumberingUS
+	}
+
+	result.TimeSource, result.IsAuthoritative = describeTimeSource(s.clock)
+
+	if input.IncludeZodiac {
+		result.ZodiacSign, result.ZodiacElement = zodiacFor(currentTime)
+	}
+
+	if input.SiderealTime {
+		result.GreenwichSiderealTime = formatSiderealHours(greenwichSiderealHours(currentTime))
+
+		if input.Longitude != nil {
+			longitude := *input.Longitude
+			if longitude < -180 || longitude > 180 || math.IsNaN(longitude) {
+				return GetTimeResult{}, validationErrorf(CodeInvalidArgument, "invalid longitude %v: must be between -180 and 180", longitude)
+			}
+			result.SiderealTime = formatSiderealHours(localSiderealHours(currentTime, longitude))
+		}
+	}
+
+	if input.IncludeJulianDate {
+		result.JulianDayNumber = julianDate(currentTime)
+		result.ModifiedJulianDate = modifiedJulianDate(currentTime)
+	}
+
+	if input.Locale != "" {
+		s.applyLocale(&result, currentTime, input.Locale)
+	}
+
+	if input.Verbose {
+		// Abbreviated timezone info: the DST transition lookup is left to timezone_info
+		abbreviation, offset := currentTime.Zone()
+		result.TimezoneInfo = &TimezoneInfo{
+			Name:          timezone,
+			Abbreviation:  abbreviation,
+			Offset:        formatOffset(offset),
+			OffsetSeconds: offset,
+			IsDST:         s.isDST(currentTime, currentTime.Location()),
+			POSIXTZString: ianaToPOSIXTZ(currentTime.Location(), currentTime.Year()),
+		}
+		result.ISOWeek = fmt.Sprintf("%04d-W%02d", isoYear, isoWeek)
+		result.DayOfYear = currentTime.YearDay()
+		result.Quarter = (int(currentTime.Month())-1)/3 + 1
+		result.EpochBreakdown = &EpochBreakdown{
+			Seconds:      currentTime.Unix(),
+			Milliseconds: currentTime.UnixMilli(),
+			Microseconds: currentTime.UnixMicro(),
+			Nanoseconds:  currentTime.UnixNano(),
+		}
+	}
+
+	if input.IncludeHexEpoch {
+		// Negative timestamps are shown as their 64-bit two's complement
+		epoch := uint64(currentTime.Unix())
+		result.EpochHex = fmt.Sprintf("0x%X", epoch)
+		result.EpochOctal = fmt.Sprintf("0o%o", epoch)
+		result.EpochBinary = fmt.Sprintf("0b%064b", epoch)
+	}
+
+	if input.IncludeNATO {
+		_, offset := currentTime.Zone()
+		result.NATOTimezone = natoTimezoneLetter(offset)
+		result.NATOTime = natoTime(currentTime)
+	}
+
+	if input.IncludePlatformFormats {
+		result.WindowsFiletime = windowsFiletime(currentTime)
+		result.DotNetTicks = dotNetTicks(currentTime)
+		result.JavaTimestamp = currentTime.UnixMilli()
+	}
+
+	if input.IncludeHexColor {
+		result.HexColorTime = hexColorTime(currentTime)
+		result.HSLColor = hslColorTime(currentTime)
+	}
+
+	if input.IncludeTAI {
+		result.TAI64N = formatTai64N(currentTime)
+	}
+
+	result.Explanation = explainer.Steps()
+
+	return result, nil
 }
 
 // getCurrentTimeInternal returns the current time in the specified timezone (internal method)
-func (s *timeService) getCurrentTimeInternal(timezone string) (time.Time, error) {
+func (s *timeService) getCurrentTimeInternal(timezone string) (time.Time, string, error) {
 	if timezone == "" {
 		timezone = s.defaultTimezone
 	}
@@ -92,24 +391,30 @@ func (s *timeService) getCurrentTimeInternal(timezone string) (time.Time, error)
 		zap.String("timezone", timezone),
 		zap.String("default_timezone", s.defaultTimezone))
 
-	loc, err := time.LoadLocation(timezone)
+	loc, warning, err := s.loadLocation(timezone)
 	if err != nil {
 		s.logger.Error("Failed to load timezone location",
 			zap.String("timezone", timezone),
 			zap.Error(err))
-		return time.Time{}, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+		return time.Time{}, "", err
 	}
 
-	currentTime := time.Now().In(loc)
+	currentTime := s.clock.Now().In(loc)
 	s.logger.Debug("Successfully retrieved current time",
 		zap.String("timezone", timezone),
 		zap.Time("time", currentTime))
 
-	return currentTime, nil
+	return currentTime, warning, nil
 }
 
 // FormatTime formats a timestamp with result information
-func (s *timeService) FormatTime(input FormatTimeInput) (FormatTimeResult, error) {
+func (s *timeService) FormatTime(ctx context.Context, input FormatTimeInput) (_ FormatTimeResult, err error) {
+	defer s.countCall(&s.totalFormatTimeCalls, &err)
+
+	if err := ctx.Err(); err != nil {
+		return FormatTimeResult{}, err
+	}
+
 	format := input.Format
 	timezone := input.Timezone
 
@@ -117,9 +422,28 @@ func (s *timeService) FormatTime(input FormatTimeInput) (FormatTimeResult, error
 		timezone = s.defaultTimezone
 	}
 
+	if !isEmptyTimestamp(input.Timestamp) && input.OffsetFromNow != "" {
+		return FormatTimeResult{}, validationErrorf(CodeConflictingArguments, "timestamp and offset_from_now cannot both be set")
+	}
+
+	explainer := newExplainer(input.Explain)
+
 	// Parse the timestamp
 	var t time.Time
-	var err error
+
+	if input.OffsetFromNow != "" {
+		offset, err := time.ParseDuration(input.OffsetFromNow)
+		if err != nil {
+			return FormatTimeResult{}, parseErrorf(CodeInvalidDuration, "invalid offset_from_now %s: %w", input.OffsetFromNow, err)
+		}
+		now := s.clock.Now()
+		input.Timestamp = now.Add(offset)
+		explainer.Step("Added offset %s to the current time %s", offset, now.UTC().Format(time.RFC3339))
+	} else if isEmptyTimestamp(input.Timestamp) {
+		// A missing timestamp means "format the current time"
+		input.Timestamp = s.clock.Now()
+		explainer.Step("No timestamp given: used the current time")
+	}
 
 	switch v := input.Timestamp.(type) {
 	case string:
@@ -129,7 +453,7 @@ func (s *timeService) FormatTime(input FormatTimeInput) (FormatTimeResult, error
 		} else {
 			t, err = time.Parse(time.RFC3339, v)
 			if err != nil {
-				return FormatTimeResult{}, fmt.Errorf("failed to parse timestamp string: %w", err)
+				return FormatTimeResult{}, parseErrorf(CodeInvalidTimestamp, "failed to parse timestamp string: %w", err)
 			}
 		}
 	case int:
@@ -141,29 +465,80 @@ func (s *timeService) FormatTime(input FormatTimeInput) (FormatTimeResult, error
 	case time.Time:
 		t = v
 	default:
-		return FormatTimeResult{}, fmt.Errorf("unsupported timestamp type: %T", input.Timestamp)
+		return FormatTimeResult{}, validationErrorf(CodeInvalidArgument, "unsupported timestamp type: %T", input.Timestamp)
+	}
+
+	if _, isTime := input.Timestamp.(time.Time); !isTime {
+		explainer.Step("Interpreted timestamp %v as %s", input.Timestamp, t.UTC().Format(time.RFC3339Nano))
 	}
 
 	// Convert to target timezone
+	var warning string
 	if timezone != "" {
-		loc, err := time.LoadLocation(timezone)
+		var loc *time.Location
+		loc, warning, err = s.loadLocation(timezone)
 		if err != nil {
-			return FormatTimeResult{}, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+			return FormatTimeResult{}, err
 		}
 		t = t.In(loc)
+		explainer.Step("Converted to %s: %s", timezone, t.Format(time.RFC3339Nano))
+	}
+	s.explainZone(explainer, t)
+
+	effectiveFormat := format
+	if effectiveFormat == "" {
+		effectiveFormat = s.defaultFormat
+	}
+	rangeWarning, err := checkTimestampRange(t, effectiveFormat)
+	if err != nil {
+		return FormatTimeResult{}, err
 	}
 
 	formatted, err := s.formatTimeInternal(t, format)
 	if err != nil {
 		return FormatTimeResult{}, err
 	}
+	if format == "" {
+		explainer.Step("Formatted with the default format %s: %s", s.defaultFormat, formatted)
+	} else {
+		explainer.Step("Formatted with %s: %s", format, formatted)
+	}
 
-	return FormatTimeResult{
+	result := FormatTimeResult{
 		FormattedTime: formatted,
 		Timezone:      t.Location().String(),
 		Format:        format,
 		UnixTimestamp: t.Unix(),
-	}, nil
+		Warning:       joinWarnings(warning, rangeWarning),
+	}
+
+	if input.IncludeElapsed {
+		result.ElapsedSince = describeElapsed(t, s.clock.Now())
+		explainer.Step("Compared with the current time: %s", result.ElapsedSince)
+	}
+
+	result.Explanation = explainer.Steps()
+
+	return result, nil
+}
+
+// isEmptyTimestamp reports whether a format_time timestamp was omitted:
+// nil (JSON null), an empty string, or a numeric zero.
+func isEmptyTimestamp(ts interface{}) bool {
+	switch v := ts.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case int:
+		return v == 0
+	case int64:
+		return v == 0
+	case float64:
+		return v == 0
+	default:
+		return false
+	}
 }
 
 // formatTimeInternal formats a time value using the specified format (internal method)
@@ -177,76 +552,188 @@ func (s *timeService) formatTimeInternal(t time.Time, format string) (string, er
 		zap.String("format", format))
 
 	if !s.IsFormatSupported(format) {
-		return "", fmt.Errorf("unsupported format: %s (supported: %v)", format, s.supportedFormats)
+		return "", formatErrorf(CodeUnsupportedFormat, "unsupported format: %s (supported: %v)", format, s.GetSupportedFormats())
 	}
 
 	var result string
-	var err error
+	if p, ok := s.pluginFor(format); ok {
+		var err error
+		result, err = p.Format(t, format)
+		if err != nil {
+			return "", formatErrorf(CodeFormatFailed, "plugin %s failed to format time as %s: %w", p.Name(), format, err)
+		}
+	} else {
+		result = renderFormat(t, format)
+	}
 
+	s.logger.Debug("Successfully formatted time",
+		zap.String("format", format),
+		zap.String("result", result))
+
+	return result, nil
+}
+
+// renderFormat formats t with a built-in format type or, for any other name, as a Go time layout
+func renderFormat(t time.Time, format string) string {
 	switch FormatType(format) {
 	case FormatRFC3339:
-		result = t.Format(time.RFC3339)
+		return t.Format(time.RFC3339)
 	case FormatRFC3339Nano:
-		result = t.Format(time.RFC3339Nano)
+		return t.Format(time.RFC3339Nano)
 	case FormatUnix:
-		result = strconv.FormatInt(t.Unix(), 10)
+		return strconv.FormatInt(t.Unix(), 10)
 	case FormatUnixMilli:
-		result = strconv.FormatInt(t.UnixMilli(), 10)
+		return strconv.FormatInt(t.UnixMilli(), 10)
 	case FormatUnixMicro:
-		result = strconv.FormatInt(t.UnixMicro(), 10)
+		return strconv.FormatInt(t.UnixMicro(), 10)
 	case FormatUnixNano:
-		result = strconv.FormatInt(t.UnixNano(), 10)
+		return strconv.FormatInt(t.UnixNano(), 10)
+	case FormatTai64N:
+		return formatTai64N(t)
 	case FormatLayout:
 		// For layout format, we expect the format to be a Go time layout
-		result = t.Format(format)
+		return t.Format(format)
 	default:
 		// Try as a Go time layout
-		result = t.Format(format)
+		return t.Format(format)
 	}
-
-	s.logger.Debug("Successfully formatted time",
-		zap.String("format", format),
-		zap.String("result", result))
-
-	return result, err
 }
 
 // ParseTime parses a time string and returns result information
-func (s *timeService) ParseTime(input ParseTimeInput) (ParseTimeResult, error) {
+func (s *timeService) ParseTime(ctx context.Context, input ParseTimeInput) (_ ParseTimeResult, err error) {
+	defer s.countCall(&s.totalParseTimeCalls, &err)
+
+	if err := ctx.Err(); err != nil {
+		return ParseTimeResult{}, err
+	}
+
 	timeStr := input.TimeString
 	format := input.Format
 	timezone := input.Timezone
 
-	if format == "" {
-		format = s.defaultFormat
+	var loc *time.Location
+	var warning string
+	if timezone != "" {
+		var err error
+		loc, warning, err = s.loadLocation(timezone)
+		if err != nil {
+			return ParseTimeResult{}, err
+		}
 	}
 
-	parsedTime, err := s.parseTimeInternal(timeStr, format)
-	if err != nil {
-		return ParseTimeResult{}, err
+	explainer := newExplainer(input.Explain)
+
+	if input.Language != "" {
+		if format != "" {
+			return ParseTimeResult{}, validationErrorf(CodeConflictingArguments, "format and language cannot both be set")
+		}
+
+		normalized, layout, err := normalizeLocalizedDate(timeStr, input.Language)
+		if err != nil {
+			return ParseTimeResult{}, parseErrorf(CodeInvalidTimestamp, "failed to parse localized time string %s: %w", timeStr, err)
+		}
+		explainer.Step("Normalized the %s date '%s' to '%s'", input.Language, timeStr, normalized)
+		timeStr, format = normalized, layout
 	}
 
-	// Apply timezone if specified
-	if timezone != "" {
-		loc, err := time.LoadLocation(timezone)
+	var candidates []parsedCandidate
+	if input.ReturnAllCandidates {
+		candidates = rankParseCandidates(timeStr, loc)
+	}
+
+	var parsedTime time.Time
+	var ambiguity string
+	relative := false
+	if input.AllowRelative && format == "" {
+		relativeLoc := loc
+		if relativeLoc == nil {
+			relativeLoc = time.UTC
+		}
+
+		// Strings that are not relative expressions fall through to the regular parsing
+		if t, err := parseRelativeTime(timeStr, s.clock.Now(), relativeLoc); err == nil {
+			parsedTime, relative = t, true
+			explainer.Step("Resolved the relative expression '%s' to %s", timeStr, parsedTime.Format(time.RFC3339Nano))
+		}
+	}
+
+	switch {
+	case relative:
+		// Already resolved in the requested timezone
+	case input.ReturnAllCandidates && format == "":
+		// Without an explicit format, the most confident candidate is the primary result
+		if len(candidates) == 0 {
+			return ParseTimeResult{}, parseErrorf(CodeInvalidTimestamp, "failed to parse time string %s: no format matched", timeStr)
+		}
+		parsedTime = candidates[0].time
+		explainer.Step("Parsed '%s' with the most confident format %s as %s", timeStr, candidates[0].Format, parsedTime.Format(time.RFC3339Nano))
+	case format == "" && isNumericDate(timeStr):
+		date, err := parseNumericDate(timeStr, input.Locale)
+		if err != nil {
+			return ParseTimeResult{}, parseErrorf(CodeInvalidTimestamp, "failed to parse time string %s: %w", timeStr, err)
+		}
+		parsedTime, ambiguity = date.time, date.ambiguity
+		explainer.Step("Parsed the numeric date '%s' as %s", timeStr, parsedTime.Format("2006-01-02"))
+
+		if loc != nil {
+			parsedTime = applyParseLocation(parsedTime, loc)
+			explainer.Step("Interpreted in %s: %s", timezone, parsedTime.Format(time.RFC3339Nano))
+		}
+	default:
+		if format == "" {
+			format = s.defaultFormat
+		}
+
+		var err error
+		parsedTime, err = s.parseTimeInternal(timeStr, format)
 		if err != nil {
-			return ParseTimeResult{}, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+			return ParseTimeResult{}, err
 		}
+		explainer.Step("Parsed '%s' with format %s as %s", timeStr, format, parsedTime.Format(time.RFC3339Nano))
+
 		// If the parsed time has no timezone info, assume it's in the specified timezone
-		if parsedTime.Location() == time.UTC {
-			parsedTime = time.Date(parsedTime.Year(), parsedTime.Month(), parsedTime.Day(),
-				parsedTime.Hour(), parsedTime.Minute(), parsedTime.Second(), parsedTime.Nanosecond(), loc)
-		} else {
-			parsedTime = parsedTime.In(loc)
+		if loc != nil {
+			parsedTime = applyParseLocation(parsedTime, loc)
+			explainer.Step("Interpreted in %s: %s", timezone, parsedTime.Format(time.RFC3339Nano))
 		}
 	}
+	s.explainZone(explainer, parsedTime)
+	explainer.Step("Converted to Unix: %d", parsedTime.Unix())
 
-	return ParseTimeResult{
-		UnixTimestamp: parsedTime.Unix(),
-		RFC3339:       parsedTime.Format(time.RFC3339),
-		Timezone:      parsedTime.Location().String(),
-		IsDST:         s.isDST(parsedTime, parsedTime.Location()),
-	}, nil
+	season, err := seasonFor(parsedTime, input.SeasonType)
+	if err != nil {
+		return ParseTimeResult{}, err
+	}
+
+	_, offset := parsedTime.Zone()
+
+	result := ParseTimeResult{
+		UnixTimestamp:      parsedTime.Unix(),
+		RFC3339:            parsedTime.Format(time.RFC3339),
+		UTCTime:            parsedTime.UTC().Format(time.RFC3339),
+		Timezone:           parsedTime.Location().String(),
+		IsDST:              s.isDST(parsedTime, parsedTime.Location()),
+		OffsetHours:        offset / 3600,
+		OffsetMinutes:      (offset % 3600) / 60,
+		TotalOffsetSeconds: offset,
+		SeasonNorthern:     season.northern,
+		SeasonSouthern:     season.southern,
+		SeasonStartDate:    season.start.Format("2006-01-02"),
+		SeasonEndDate:      season.end.Format("2006-01-02"),
+		AmbiguityWarning:   ambiguity,
+		Warning:            warning,
+	}
+
+	if input.ReturnAllCandidates {
+		result.Candidates = make([]ParseCandidate, 0, len(candidates))
+		for _, candidate := range candidates {
+			result.Candidates = append(result.Candidates, candidate.ParseCandidate)
+		}
+	}
+
+	result.Explanation = explainer.Steps()
+
+	return result, nil
 }
 
 // parseTimeInternal parses a time string using the specified format (internal method)
@@ -291,6 +778,8 @@ func (s *timeService) parseTimeInternal(timeStr, format string) (time.Time, erro
 		if err == nil {
 			parsedTime = time.Unix(0, nanoTime)
 		}
+	case FormatTai64N:
+		parsedTime, err = parseTai64N(timeStr)
 	default:
 		// Try as Go time layout
 		parsedTime, err = time.Parse(format, timeStr)
@@ -301,7 +790,7 @@ func (s *timeService) parseTimeInternal(timeStr, format string) (time.Time, erro
 			zap.String("time_string", timeStr),
 			zap.String("format", format),
 			zap.Error(err))
-		return time.Time{}, fmt.Errorf("failed to parse time string %s with format %s: %w", timeStr, format, err)
+		return time.Time{}, parseErrorf(CodeInvalidTimestamp, "failed to parse time string %s with format %s: %w", timeStr, format, err)
 	}
 
 	s.logger.Debug("Successfully parsed time string",
@@ -313,16 +802,41 @@ func (s *timeService) parseTimeInternal(timeStr, format string) (time.Time, erro
 }
 
 // GetTimezoneInfo returns information about a timezone
-func (s *timeService) GetTimezoneInfo(input TimezoneInfoInput) (TimezoneInfo, error) {
+func (s *timeService) GetTimezoneInfo(ctx context.Context, input TimezoneInfoInput) (_ TimezoneInfo, err error) {
+	defer s.countCall(&s.totalTimezoneInfoCalls, &err)
+
+	if err := ctx.Err(); err != nil {
+		return TimezoneInfo{}, err
+	}
+
 	timezone := input.Timezone
 	if timezone == "" {
 		timezone = s.defaultTimezone
 	}
+	// Expanded up front so the result and the optional lookups use the zone an alias stands for
+	timezone = s.expandTimezoneAlias(timezone)
 
-	// Use provided reference time or current time
-	refTime := time.Now()
-	if !input.ReferenceTime.IsZero() {
-		refTime = input.ReferenceTime
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimezoneInfo{}, err
+	}
+	if warning != "" {
+		timezone = loc.String()
+	}
+
+	// Use the historical date, the provided reference time or the current time
+	refTime := s.clock.Now()
+	switch {
+	case input.HistoricalDate != "":
+		refTime, err = parseFlexibleTime(input.HistoricalDate, loc)
+		if err != nil {
+			return TimezoneInfo{}, parseErrorf(CodeInvalidTimestamp, "invalid historical_date: %w", err)
+		}
+	case input.ReferenceTime != "":
+		refTime, err = parseFlexibleTime(input.ReferenceTime, loc)
+		if err != nil {
+			return TimezoneInfo{}, parseErrorf(CodeInvalidTimestamp, "invalid reference_time: %w", err)
+		}
 	}
 
 	info, err := s.getTimezoneInfoInternal(timezone, &refTime)
@@ -330,6 +844,34 @@ func (s *timeService) GetTimezoneInfo(input TimezoneInfoInput) (TimezoneInfo, er
 		return TimezoneInfo{}, err
 	}
 
+	if input.IncludeUpcomingTransitions {
+		info.UpcomingTransitions = upcomingTransitions(refTime, loc)
+	}
+
+	if input.IncludeComparableZones {
+		info.ComparableZones = comparableZones(timezone, refTime, loc)
+	}
+
+	if input.IncludeGeo {
+		info.GeoMetadata = timezoneGeoMetadata(timezone)
+	}
+
+	if input.IncludeCities {
+		info.MajorCities = majorCities(timezone)
+	}
+
+	if input.Locale != "" {
+		language, ok := zoneNameLanguage(input.Locale)
+		if !ok {
+			s.logger.Warn("Unsupported locale for timezone names, falling back to English",
+				zap.String("locale", input.Locale))
+			language = "en"
+		}
+		info.LocalizedName, info.LocalizedCity = localizedZoneNames(timezone, language)
+	}
+
+	info.Warning = warning
+
 	// Return as value instead of pointer to match interface
 	return *info, nil
 }
@@ -343,16 +885,19 @@ func (s *timeService) getTimezoneInfoInternal(timezone string, referenceTime *ti
 	s.logger.Debug("Getting timezone info",
 		zap.String("timezone", timezone))
 
-	loc, err := time.LoadLocation(timezone)
+	loc, err := loadZone(timezone)
 	if err != nil {
 		s.logger.Error("Failed to load timezone location for info",
 			zap.String("timezone", timezone),
 			zap.Error(err))
-		return nil, fmt.Errorf("invalid timezone %s: %w", timezone, err)
+		if errors.Is(err, ErrInvalidUTCOffset) {
+			return nil, err
+		}
+		return nil, timezoneErrorf(CodeInvalidTimezone, "invalid timezone %s: %w", timezone, err)
 	}
 
 	// Use provided reference time or current time
-	refTime := time.Now()
+	refTime := s.clock.Now()
 	if referenceTime != nil {
 		refTime = *referenceTime
 	}
@@ -375,9 +920,22 @@ func (s *timeService) getTimezoneInfoInternal(timezone string, referenceTime *ti
 		Offset:        formatOffset(offset),
 		OffsetSeconds: offset,
 		IsDST:         isDST,
+		POSIXTZString: ianaToPOSIXTZ(loc, timeInZone.Year()),
 		DSTTransition: dstTransition,
 	}
 
+	transitionCount, firstTransition, lastTransition := zoneDatabaseTransitions(loc)
+	info.TransitionCount = transitionCount
+	if firstTransition != nil {
+		first := firstTransition.Format(time.RFC3339)
+		info.FirstTransitionTime = &first
+		info.HasHistoricalData = firstTransition.Year() < 1970
+	}
+	if lastTransition != nil {
+		last := lastTransition.Format(time.RFC3339)
+		info.LastTransitionTime = &last
+	}
+
 	s.logger.Debug("Successfully retrieved timezone info",
 		zap.String("timezone", timezone),
 		zap.String("abbreviation", zoneName),
@@ -387,29 +945,130 @@ func (s *timeService) getTimezoneInfoInternal(timezone string, referenceTime *ti
 	return info, nil
 }
 
+// GetTimezoneOffsetAt returns the UTC offset of a timezone at a specific moment
+func (s *timeService) GetTimezoneOffsetAt(ctx context.Context, input TimezoneOffsetAtInput) (TimezoneOffsetAtResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimezoneOffsetAtResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	s.logger.Debug("Getting timezone offset",
+		zap.String("timezone", timezone),
+		zap.String("at", input.At))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		s.logger.Error("Failed to load timezone location for offset",
+			zap.String("timezone", timezone),
+			zap.Error(err))
+		return TimezoneOffsetAtResult{}, err
+	}
+
+	explainer := newExplainer(input.Explain)
+
+	// Use provided moment or current time
+	at := s.clock.Now()
+	if input.At != "" {
+		at, err = parseFlexibleTime(input.At, loc)
+		if err != nil {
+			return TimezoneOffsetAtResult{}, err
+		}
+		explainer.Step("Parsed '%s' in %s as %s", input.At, timezone, at.Format(time.RFC3339))
+	} else {
+		explainer.Step("No moment given: used the current time %s", at.UTC().Format(time.RFC3339))
+	}
+
+	timeInZone := at.In(loc)
+	abbreviation, offset := timeInZone.Zone()
+	s.explainZone(explainer, timeInZone)
+	explainer.Step("Offset from UTC: %d seconds", offset)
+
+	return TimezoneOffsetAtResult{
+		OffsetString:  formatOffset(offset),
+		OffsetSeconds: offset,
+		Abbreviation:  abbreviation,
+		IsDST:         s.isDST(timeInZone, loc),
+		UTCTime:       timeInZone.UTC().Format(time.RFC3339),
+		Explanation:   explainer.Steps(),
+		Warning:       warning,
+	}, nil
+}
+
+// ListTimezoneOffsets returns all distinct current UTC offsets, sorted from west to east
+func (s *timeService) ListTimezoneOffsets(ctx context.Context, input TimezoneOffsetListInput) (TimezoneOffsetListResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimezoneOffsetListResult{}, err
+	}
+
+	now := s.clock.Now()
+
+	s.logger.Debug("Listing timezone offsets",
+		zap.Bool("include_zones", input.IncludeZones))
+
+	byOffset := make(map[int]*OffsetEntry)
+	for _, zone := range loadZoneLocations() {
+		if err := ctx.Err(); err != nil {
+			return TimezoneOffsetListResult{}, err
+		}
+
+		_, offset := now.In(zone.location).Zone()
+
+		entry, ok := byOffset[offset]
+		if !ok {
+			entry = &OffsetEntry{
+				OffsetString:  formatOffset(offset),
+				OffsetSeconds: offset,
+			}
+			byOffset[offset] = entry
+		}
+
+		if input.IncludeZones {
+			entry.Zones = append(entry.Zones, zone.name)
+		}
+	}
+
+	offsets := make([]OffsetEntry, 0, len(byOffset))
+	for _, entry := range byOffset {
+		offsets = append(offsets, *entry)
+	}
+	sort.Slice(offsets, func(i, j int) bool {
+		return offsets[i].OffsetSeconds < offsets[j].OffsetSeconds
+	})
+
+	return TimezoneOffsetListResult{Offsets: offsets}, nil
+}
+
 // ConvertTimezone converts a time from one timezone to another
-func (s *timeService) ConvertTimezone(t time.Time, fromTZ, toTZ string) (time.Time, error) {
+func (s *timeService) ConvertTimezone(ctx context.Context, t time.Time, fromTZ, toTZ string) (time.Time, error) {
+	if err := ctx.Err(); err != nil {
+		return time.Time{}, err
+	}
+
 	s.logger.Debug("Converting timezone",
 		zap.Time("time", t),
 		zap.String("from_timezone", fromTZ),
 		zap.String("to_timezone", toTZ))
 
-	toLoc, err := time.LoadLocation(toTZ)
+	toLoc, err := loadZone(s.expandTimezoneAlias(toTZ))
 	if err != nil {
 		s.logger.Error("Failed to load destination timezone",
 			zap.String("to_timezone", toTZ),
 			zap.Error(err))
-		return time.Time{}, fmt.Errorf("invalid destination timezone %s: %w", toTZ, err)
+		return time.Time{}, timezoneErrorf(CodeInvalidTimezone, "invalid destination timezone %s: %w", toTZ, err)
 	}
 
 	// If the time doesn't have location info and fromTZ is specified, set it
 	if fromTZ != "" && t.Location() == time.UTC {
-		fromLoc, err := time.LoadLocation(fromTZ)
+		fromLoc, err := loadZone(s.expandTimezoneAlias(fromTZ))
 		if err != nil {
 			s.logger.Error("Failed to load source timezone",
 				zap.String("from_timezone", fromTZ),
 				zap.Error(err))
-			return time.Time{}, fmt.Errorf("invalid source timezone %s: %w", fromTZ, err)
+			return time.Time{}, timezoneErrorf(CodeInvalidTimezone, "invalid source timezone %s: %w", fromTZ, err)
 		}
 		// Interpret the time as being in the source timezone
 		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), fromLoc)
@@ -428,6 +1087,15 @@ func (s *timeService) ConvertTimezone(t time.Time, fromTZ, toTZ string) (time.Ti
 
 // IsFormatSupported checks if a format is supported
 func (s *timeService) IsFormatSupported(format string) bool {
+	if s.isConfiguredFormat(format) {
+		return true
+	}
+	_, ok := s.pluginFor(format)
+	return ok
+}
+
+// isConfiguredFormat checks if a format is in the configured supported formats
+func (s *timeService) isConfiguredFormat(format string) bool {
 	for _, supported := range s.supportedFormats {
 		if supported == format {
 			return true
@@ -436,11 +1104,11 @@ func (s *timeService) IsFormatSupported(format string) bool {
 	return false
 }
 
-// GetSupportedFormats returns a list of supported formats
+// GetSupportedFormats returns a list of supported formats, followed by those added by plugins
 func (s *timeService) GetSupportedFormats() []string {
 	formats := make([]string, len(s.supportedFormats))
 	copy(formats, s.supportedFormats)
-	return formats
+	return append(formats, s.pluginFormats()...)
 }
 
 // Helper functions
@@ -492,6 +1160,65 @@ func (s *timeService) getNextDSTTransition(t time.Time, loc *time.Location) *DST
 	return nil // No transition found within a year
 }
 
+// flexibleLayouts lists the layouts tried, in order, when parsing free-form timestamps
+var flexibleLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// parseFlexibleTime parses a Unix timestamp or a time string in one of the common layouts.
+// Layouts without zone information are interpreted in the given location, and the
+// result is always expressed in that location.
+func parseFlexibleTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	if unixTime, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(unixTime, 0).In(loc), nil
+	}
+
+	for _, layout := range flexibleLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t.In(loc), nil
+		}
+	}
+
+	return time.Time{}, parseErrorf(CodeInvalidTimestamp, "failed to parse timestamp %s: unrecognized format", value)
+}
+
+// timestampToTime converts a JSON timestamp value (Unix number or flexible time string) to a time
+// in the given location. A nil value means the current time.
+func (s *timeService) timestampToTime(value interface{}, loc *time.Location) (time.Time, error) {
+	switch v := value.(type) {
+	case nil:
+		return s.clock.Now().In(loc), nil
+	case string:
+		return parseFlexibleTime(v, loc)
+	case int:
+		return time.Unix(int64(v), 0).In(loc), nil
+	case int64:
+		return time.Unix(v, 0).In(loc), nil
+	case float64:
+		return time.Unix(int64(v), 0).In(loc), nil
+	case time.Time:
+		return v.In(loc), nil
+	default:
+		return time.Time{}, validationErrorf(CodeInvalidArgument, "unsupported timestamp type: %T", value)
+	}
+}
+
+// usWeekNumber returns the US week number, where weeks start on Sunday and week 1 contains January 1
+func usWeekNumber(t time.Time) int {
+	jan1 := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	return (t.YearDay()-1+int(jan1.Weekday()))/7 + 1
+}
+
 // formatOffset formats a timezone offset in seconds to a human-readable string
 func formatOffset(offsetSeconds int) string {
 	if offsetSeconds == 0 {
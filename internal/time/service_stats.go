@@ -0,0 +1,34 @@
+package time
+
+import "sync/atomic"
+
+// ServiceStats is a snapshot of how often the main TimeService operations were called. Calls made
+// by batch operations are counted individually.
+type ServiceStats struct {
+	TotalGetTimeCalls      int64 `json:"total_get_time_calls"`
+	TotalFormatTimeCalls   int64 `json:"total_format_time_calls"`
+	TotalParseTimeCalls    int64 `json:"total_parse_time_calls"`
+	TotalTimezoneInfoCalls int64 `json:"total_timezone_info_calls"`
+	TotalErrors            int64 `json:"total_errors"`
+}
+
+// Stats returns the current operation counts. The counters are atomic, so reading them never
+// blocks the operations updating them.
+func (s *timeService) Stats() ServiceStats {
+	return ServiceStats{
+		TotalGetTimeCalls:      s.totalGetTimeCalls.Load(),
+		TotalFormatTimeCalls:   s.totalFormatTimeCalls.Load(),
+		TotalParseTimeCalls:    s.totalParseTimeCalls.Load(),
+		TotalTimezoneInfoCalls: s.totalTimezoneInfoCalls.Load(),
+		TotalErrors:            s.totalErrors.Load(),
+	}
+}
+
+// countCall increments an operation counter, and the error counter when the operation failed.
+// It is deferred with a pointer to the operation's error result.
+func (s *timeService) countCall(calls *atomic.Int64, err *error) {
+	calls.Add(1)
+	if *err != nil {
+		s.totalErrors.Add(1)
+	}
+}
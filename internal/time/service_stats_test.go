@@ -0,0 +1,61 @@
+package time
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_Stats(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+	ctx := context.Background()
+
+	assert.Equal(t, ServiceStats{}, service.Stats())
+
+	_, err := service.GetCurrentTime(ctx, GetTimeInput{})
+	require.NoError(t, err)
+	_, err = service.GetCurrentTime(ctx, GetTimeInput{Timezone: "Mars/Olympus"})
+	require.Error(t, err)
+	_, err = service.FormatTime(ctx, FormatTimeInput{Timestamp: "2024-03-15T12:00:00Z", Format: "RFC3339"})
+	require.NoError(t, err)
+	_, err = service.ParseTime(ctx, ParseTimeInput{TimeString: "not a time"})
+	require.Error(t, err)
+	_, err = service.GetTimezoneInfo(ctx, TimezoneInfoInput{Timezone: "Europe/London"})
+	require.NoError(t, err)
+
+	// Each query of a batch counts as a call
+	_, err = service.BatchGetCurrentTime(ctx, BatchGetTimeInput{Queries: []GetTimeInput{{}, {Timezone: "Asia/Tokyo"}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, ServiceStats{
+		TotalGetTimeCalls:      4,
+		TotalFormatTimeCalls:   1,
+		TotalParseTimeCalls:    1,
+		TotalTimezoneInfoCalls: 1,
+		TotalErrors:            2,
+	}, service.Stats())
+}
+
+func TestTimeService_Stats_Concurrent(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			service.GetCurrentTime(context.Background(), GetTimeInput{})
+		}()
+		go func() {
+			defer wg.Done()
+			service.Stats()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(50), service.Stats().TotalGetTimeCalls)
+}
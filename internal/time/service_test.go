@@ -1,6 +1,9 @@
 package time
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -52,7 +55,7 @@ func TestTimeService_GetCurrentTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.GetCurrentTime(tt.input)
+			result, err := service.GetCurrentTime(context.Background(), tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -74,6 +77,154 @@ func TestTimeService_GetCurrentTime(t *testing.T) {
 	}
 }
 
+func TestTimeService_GetCurrentTime_MockNow(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	input := GetTimeInput{Timezone: "America/New_York", MockNow: "2024-01-15T12:00:00Z"}
+
+	t.Run("allowed", func(t *testing.T) {
+		service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithAllowMockNow(true))
+
+		result, err := service.GetCurrentTime(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-15T07:00:00-05:00", result.FormattedTime)
+		assert.Equal(t, int64(1705320000), result.UnixTimestamp)
+	})
+
+	t.Run("ignored when not allowed", func(t *testing.T) {
+		service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+		result, err := service.GetCurrentTime(context.Background(), input)
+		require.NoError(t, err)
+		assert.InDelta(t, time.Now().Unix(), result.UnixTimestamp, 2)
+	})
+
+	t.Run("invalid mock time", func(t *testing.T) {
+		service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithAllowMockNow(true))
+
+		_, err := service.GetCurrentTime(context.Background(), GetTimeInput{MockNow: "yesterday-ish"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid mock_now")
+	})
+}
+
+func TestTimeService_GetCurrentTime_Verbose(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Date(2024, time.July, 4, 16, 30, 0, 500, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: fixed}))
+
+	t.Run("verbose", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "America/New_York", Verbose: true})
+		require.NoError(t, err)
+
+		require.NotNil(t, result.TimezoneInfo)
+		assert.Equal(t, "America/New_York", result.TimezoneInfo.Name)
+		assert.Equal(t, "EDT", result.TimezoneInfo.Abbreviation)
+		assert.Equal(t, "-04:00", result.TimezoneInfo.Offset)
+		assert.True(t, result.TimezoneInfo.IsDST)
+		assert.Nil(t, result.TimezoneInfo.DSTTransition)
+
+		assert.Equal(t, "2024-W27", result.ISOWeek)
+		assert.Equal(t, 186, result.DayOfYear)
+		assert.Equal(t, 3, result.Quarter)
+
+		require.NotNil(t, result.EpochBreakdown)
+		assert.Equal(t, fixed.Unix(), result.EpochBreakdown.Seconds)
+		assert.Equal(t, fixed.UnixMilli(), result.EpochBreakdown.Milliseconds)
+		assert.Equal(t, fixed.UnixMicro(), result.EpochBreakdown.Microseconds)
+		assert.Equal(t, fixed.UnixNano(), result.EpochBreakdown.Nanoseconds)
+	})
+
+	t.Run("not verbose", func(t *testing.T) {
+		result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+		require.NoError(t, err)
+		assert.Nil(t, result.TimezoneInfo)
+		assert.Nil(t, result.EpochBreakdown)
+		assert.Zero(t, result.Quarter)
+	})
+}
+
+func TestTimeService_GetCurrentTime_HexEpoch(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Unix(1710466242, 0)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: fixed}))
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{IncludeHexEpoch: true})
+	require.NoError(t, err)
+	assert.Equal(t, "0x65F3A4C2", result.EpochHex)
+	assert.Equal(t, "0o14574722302", result.EpochOctal)
+	assert.Equal(t, "0b0000000000000000000000000000000001100101111100111010010011000010", result.EpochBinary)
+
+	result, err = service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Empty(t, result.EpochHex)
+	assert.Empty(t, result.EpochOctal)
+	assert.Empty(t, result.EpochBinary)
+}
+
+func TestTimeService_GetCurrentTime_OffsetMinutes(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		timezone         string
+		expectedMinutes  int
+		expectedJSOffset int
+	}{
+		{timezone: "UTC", expectedMinutes: 0, expectedJSOffset: 0},
+		{timezone: "America/New_York", expectedMinutes: -300, expectedJSOffset: 300},
+		{timezone: "Asia/Kolkata", expectedMinutes: 330, expectedJSOffset: -330},
+		{timezone: "Asia/Kathmandu", expectedMinutes: 345, expectedJSOffset: -345},
+		{timezone: "America/St_Johns", expectedMinutes: -210, expectedJSOffset: 210},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: tt.timezone})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMinutes, result.OffsetMinutesFromUTC)
+			assert.Equal(t, tt.expectedJSOffset, result.JSTimezoneOffset)
+		})
+	}
+}
+
+func TestTimeService_GetCurrentTime_WeekNumbering(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+
+	isoService := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+	result, err := isoService.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "iso", result.WeekNumberingSystem)
+	assert.Equal(t, result.ISOWeekNumber, result.WeekNumber)
+
+	usService := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithWeekNumbering("us"))
+	result, err = usService.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Equal(t, "us", result.WeekNumberingSystem)
+	assert.Equal(t, result.USWeekNumber, result.WeekNumber)
+}
+
+func Test_usWeekNumber(t *testing.T) {
+	tests := []struct {
+		date     string
+		expected int
+	}{
+		{"2023-01-01", 1},  // Sunday
+		{"2023-01-07", 1},  // Saturday
+		{"2023-01-08", 2},  // Sunday
+		{"2022-01-01", 1},  // Saturday
+		{"2022-01-02", 2},  // Sunday
+		{"2024-12-31", 53}, // Tuesday
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			date, err := time.Parse("2006-01-02", tt.date)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, usWeekNumber(date))
+		})
+	}
+}
+
 func TestTimeService_FormatTime(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	supportedFormats := []string{"RFC3339", "Unix", "UnixMilli", "2006-01-02 15:04:05"}
@@ -126,7 +277,7 @@ func TestTimeService_FormatTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.FormatTime(tt.input)
+			result, err := service.FormatTime(context.Background(), tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -139,6 +290,118 @@ func TestTimeService_FormatTime(t *testing.T) {
 	}
 }
 
+func TestTimeService_FormatTime_OffsetFromNow(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix"}, logger)
+
+	t.Run("future offset in timezone", func(t *testing.T) {
+		before := time.Now()
+		result, err := service.FormatTime(context.Background(), FormatTimeInput{OffsetFromNow: "2h30m", Format: "RFC3339", Timezone: "Asia/Tokyo"})
+		require.NoError(t, err)
+
+		assert.Equal(t, "Asia/Tokyo", result.Timezone)
+		assert.InDelta(t, before.Add(150*time.Minute).Unix(), result.UnixTimestamp, 2)
+	})
+
+	t.Run("negative offset", func(t *testing.T) {
+		before := time.Now()
+		result, err := service.FormatTime(context.Background(), FormatTimeInput{OffsetFromNow: "-45m", Format: "Unix"})
+		require.NoError(t, err)
+
+		assert.InDelta(t, before.Add(-45*time.Minute).Unix(), result.UnixTimestamp, 2)
+	})
+
+	t.Run("invalid offset", func(t *testing.T) {
+		_, err := service.FormatTime(context.Background(), FormatTimeInput{OffsetFromNow: "two hours", Format: "RFC3339"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid offset_from_now")
+	})
+
+	t.Run("timestamp and offset both set", func(t *testing.T) {
+		_, err := service.FormatTime(context.Background(), FormatTimeInput{Timestamp: "1703518245", OffsetFromNow: "1h", Format: "RFC3339"})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "cannot both be set")
+	})
+}
+
+func TestTimeService_FormatTime_EmptyTimestampDefaultsToNow(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Unix", "2006-01-02 15:04"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}))
+
+	t.Run("JSON null timestamp", func(t *testing.T) {
+		var input FormatTimeInput
+		require.NoError(t, json.Unmarshal([]byte(`{"timestamp": null, "format": "2006-01-02 15:04", "timezone": "Asia/Tokyo"}`), &input))
+
+		result, err := service.FormatTime(context.Background(), input)
+		require.NoError(t, err)
+
+		assert.Equal(t, "2024-03-15 21:00", result.FormattedTime)
+		assert.Equal(t, "Asia/Tokyo", result.Timezone)
+		assert.Equal(t, now.Unix(), result.UnixTimestamp)
+	})
+
+	for _, ts := range []interface{}{nil, "", 0, int64(0), float64(0)} {
+		t.Run(fmt.Sprintf("%T %v", ts, ts), func(t *testing.T) {
+			result, err := service.FormatTime(context.Background(), FormatTimeInput{Timestamp: ts, Format: "Unix"})
+			require.NoError(t, err)
+			assert.Equal(t, "1710504000", result.FormattedTime)
+		})
+	}
+
+	t.Run("empty timestamp with offset", func(t *testing.T) {
+		result, err := service.FormatTime(context.Background(), FormatTimeInput{Timestamp: "", OffsetFromNow: "1h", Format: "Unix"})
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(time.Hour).Unix(), result.UnixTimestamp)
+	})
+}
+
+func TestTimeService_FormatTime_IncludeElapsed(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}))
+
+	tests := []struct {
+		name            string
+		input           FormatTimeInput
+		expectedElapsed string
+	}{
+		{
+			name:            "past timestamp",
+			input:           FormatTimeInput{Timestamp: "2024-03-12T10:00:00Z", IncludeElapsed: true},
+			expectedElapsed: "3 days and 2 hours ago",
+		},
+		{
+			name:            "future timestamp in another timezone",
+			input:           FormatTimeInput{Timestamp: "2024-03-15T12:45:00Z", Timezone: "Asia/Tokyo", IncludeElapsed: true},
+			expectedElapsed: "in 45 minutes",
+		},
+		{
+			name:            "span longer than a time.Duration",
+			input:           FormatTimeInput{Timestamp: "0001-01-01T00:00:00Z", IncludeElapsed: true},
+			expectedElapsed: "738959 days and 12 hours ago",
+		},
+		{
+			name:            "current time",
+			input:           FormatTimeInput{IncludeElapsed: true},
+			expectedElapsed: "now",
+		},
+		{
+			name:            "not requested",
+			input:           FormatTimeInput{Timestamp: "2024-03-12T10:00:00Z"},
+			expectedElapsed: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.FormatTime(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedElapsed, result.ElapsedSince)
+		})
+	}
+}
+
 func TestTimeService_ParseTime(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	supportedFormats := []string{"RFC3339", "Unix", "UnixMilli"}
@@ -188,7 +451,7 @@ func TestTimeService_ParseTime(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.ParseTime(tt.input)
+			result, err := service.ParseTime(context.Background(), tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -203,6 +466,61 @@ func TestTimeService_ParseTime(t *testing.T) {
 	}
 }
 
+func TestTimeService_ParseTime_Offset(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name            string
+		input           ParseTimeInput
+		expectedHours   int
+		expectedMinutes int
+		expectedSeconds int
+		expectedUTC     string
+	}{
+		{
+			name:        "UTC",
+			input:       ParseTimeInput{TimeString: "2023-12-25T15:30:45Z"},
+			expectedUTC: "2023-12-25T15:30:45Z",
+		},
+		{
+			name:            "India half hour offset",
+			input:           ParseTimeInput{TimeString: "2023-12-25T15:30:45+05:30"},
+			expectedHours:   5,
+			expectedMinutes: 30,
+			expectedSeconds: 19800,
+			expectedUTC:     "2023-12-25T10:00:45Z",
+		},
+		{
+			name:            "Newfoundland negative half hour offset",
+			input:           ParseTimeInput{TimeString: "2023-12-25T15:30:45-03:30"},
+			expectedHours:   -3,
+			expectedMinutes: -30,
+			expectedSeconds: -12600,
+			expectedUTC:     "2023-12-25T19:00:45Z",
+		},
+		{
+			name:            "assumed timezone",
+			input:           ParseTimeInput{TimeString: "2023-12-25T15:30:45Z", Timezone: "America/New_York"},
+			expectedHours:   -5,
+			expectedSeconds: -18000,
+			expectedUTC:     "2023-12-25T20:30:45Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.ParseTime(context.Background(), tt.input)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedHours, result.OffsetHours)
+			assert.Equal(t, tt.expectedMinutes, result.OffsetMinutes)
+			assert.Equal(t, tt.expectedSeconds, result.TotalOffsetSeconds)
+			assert.Equal(t, tt.expectedUTC, result.UTCTime)
+		})
+	}
+}
+
 func TestTimeService_GetTimezoneInfo(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
@@ -250,7 +568,7 @@ func TestTimeService_GetTimezoneInfo(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.GetTimezoneInfo(tt.input)
+			result, err := service.GetTimezoneInfo(context.Background(), tt.input)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -267,6 +585,152 @@ func TestTimeService_GetTimezoneInfo(t *testing.T) {
 	}
 }
 
+func TestTimeService_GetTimezoneInfo_HistoricalDate(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name           string
+		input          TimezoneInfoInput
+		wantErr        bool
+		expectedOffset string
+	}{
+		{
+			name:           "samoa before skipping December 30, 2011",
+			input:          TimezoneInfoInput{Timezone: "Pacific/Apia", HistoricalDate: "2011-12-29"},
+			expectedOffset: "-10:00",
+		},
+		{
+			name:           "samoa after the date line move",
+			input:          TimezoneInfoInput{Timezone: "Pacific/Apia", HistoricalDate: "2011-12-31"},
+			expectedOffset: "+14:00",
+		},
+		{
+			name:           "historical date takes precedence over reference time",
+			input:          TimezoneInfoInput{Timezone: "America/New_York", HistoricalDate: "2024-01-15", ReferenceTime: "2024-07-15T12:00:00Z"},
+			expectedOffset: "-05:00",
+		},
+		{
+			name:           "reference time as a Unix timestamp",
+			input:          TimezoneInfoInput{Timezone: "America/New_York", ReferenceTime: "1721044800"},
+			expectedOffset: "-04:00",
+		},
+		{
+			name:    "invalid historical date",
+			input:   TimezoneInfoInput{Timezone: "Pacific/Apia", HistoricalDate: "long ago"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid reference time",
+			input:   TimezoneInfoInput{Timezone: "Pacific/Apia", ReferenceTime: "soon"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := service.GetTimezoneInfo(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOffset, info.Offset)
+		})
+	}
+}
+
+func TestTimeService_GetTimezoneInfo_OmittedReferenceTime(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC)}))
+
+	// reference_time is an optional string, so clients can leave it out entirely
+	var input TimezoneInfoInput
+	require.NoError(t, json.Unmarshal([]byte(`{"timezone":"America/New_York"}`), &input))
+	assert.Empty(t, input.ReferenceTime)
+
+	encoded, err := json.Marshal(input)
+	require.NoError(t, err)
+	assert.NotContains(t, string(encoded), "reference_time")
+
+	info, err := service.GetTimezoneInfo(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, "-04:00", info.Offset)
+	assert.Equal(t, "EDT", info.Abbreviation)
+}
+
+func TestTimeService_GetTimezoneOffsetAt(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name     string
+		input    TimezoneOffsetAtInput
+		wantErr  bool
+		expected TimezoneOffsetAtResult
+	}{
+		{
+			name:  "New York in summer",
+			input: TimezoneOffsetAtInput{Timezone: "America/New_York", At: "2023-07-04T12:00:00Z"},
+			expected: TimezoneOffsetAtResult{
+				OffsetString:  "-04:00",
+				OffsetSeconds: -14400,
+				Abbreviation:  "EDT",
+				IsDST:         true,
+				UTCTime:       "2023-07-04T12:00:00Z",
+			},
+		},
+		{
+			name:  "New York in winter with local time",
+			input: TimezoneOffsetAtInput{Timezone: "America/New_York", At: "2023-12-25 10:00"},
+			expected: TimezoneOffsetAtResult{
+				OffsetString:  "-05:00",
+				OffsetSeconds: -18000,
+				Abbreviation:  "EST",
+				IsDST:         false,
+				UTCTime:       "2023-12-25T15:00:00Z",
+			},
+		},
+		{
+			name:  "Samoa before the 2011 date line change",
+			input: TimezoneOffsetAtInput{Timezone: "Pacific/Apia", At: "2011-06-01"},
+			expected: TimezoneOffsetAtResult{
+				OffsetString:  "-11:00",
+				OffsetSeconds: -39600,
+				Abbreviation:  "-11",
+				IsDST:         false,
+				UTCTime:       "2011-06-01T11:00:00Z",
+			},
+		},
+		{
+			name:    "invalid timezone",
+			input:   TimezoneOffsetAtInput{Timezone: "Invalid/Timezone"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid moment",
+			input:   TimezoneOffsetAtInput{Timezone: "UTC", At: "not-a-time"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.GetTimezoneOffsetAt(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestTimeService_ConvertTimezone(t *testing.T) {
 	logger := zaptest.NewLogger(t)
 	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
@@ -318,7 +782,7 @@ func TestTimeService_ConvertTimezone(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result, err := service.ConvertTimezone(utcTime, tt.fromTZ, tt.toTZ)
+			result, err := service.ConvertTimezone(context.Background(), utcTime, tt.fromTZ, tt.toTZ)
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -0,0 +1,53 @@
+package time
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// j2000Unix is the Unix time of the J2000.0 epoch (2000-01-01T12:00:00Z)
+const j2000Unix = 946728000
+
+// greenwichSiderealHours returns the Greenwich Mean Sidereal Time of t in hours, using the
+// IAU 1982 expression from Meeus, "Astronomical Algorithms" (12.4). UTC is used as an
+// approximation of UT1, which differs by less than a second.
+func greenwichSiderealHours(t time.Time) float64 {
+	seconds := float64(t.Unix()-j2000Unix) + float64(t.Nanosecond())/float64(time.Second)
+	days := seconds / secondsPerDay
+	centuries := days / 36525
+
+	degrees := 280.46061837 +
+		360.98564736629*days +
+		0.000387933*centuries*centuries -
+		centuries*centuries*centuries/38710000
+
+	return normalizeHours(degrees / 15)
+}
+
+// localSiderealHours returns the Local Mean Sidereal Time in hours at a longitude in degrees,
+// positive east of Greenwich
+func localSiderealHours(t time.Time, longitude float64) float64 {
+	return normalizeHours(greenwichSiderealHours(t) + longitude/15)
+}
+
+// normalizeHours reduces hours to the range [0, 24)
+func normalizeHours(hours float64) float64 {
+	hours = math.Mod(hours, 24)
+	if hours < 0 {
+		hours += 24
+	}
+	return hours
+}
+
+// formatSiderealHours formats sidereal hours as "18h 41m 50.548s"
+func formatSiderealHours(hours float64) string {
+	totalMillis := int64(math.Round(hours * 3600 * 1000))
+	totalMillis %= 24 * 3600 * 1000
+
+	h := totalMillis / 3600000
+	m := totalMillis % 3600000 / 60000
+	s := float64(totalMillis%60000) / 1000
+
+	return fmt.Sprintf("%02dh %02dm %06.3fs", h, m, s)
+}
@@ -0,0 +1,63 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_greenwichSiderealHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		time     time.Time
+		expected string
+	}{
+		// GMST at the J2000.0 epoch is 280.46061837 degrees
+		{"J2000.0", time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC), "18h 41m 50.548s"},
+		// Meeus, Astronomical Algorithms, example 12.b
+		{"meeus 12.b", time.Date(1987, time.April, 10, 19, 21, 0, 0, time.UTC), "08h 34m 57.090s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, formatSiderealHours(greenwichSiderealHours(tt.time)))
+		})
+	}
+}
+
+func Test_localSiderealHours(t *testing.T) {
+	j2000 := time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+	gst := greenwichSiderealHours(j2000)
+
+	assert.InDelta(t, gst, localSiderealHours(j2000, 0), 1e-9)
+	// 75 degrees west is 5 hours behind Greenwich
+	assert.InDelta(t, gst-5, localSiderealHours(j2000, -75), 1e-9)
+	// Wraps around midnight
+	assert.InDelta(t, gst+6-24, localSiderealHours(j2000, 90), 1e-9)
+}
+
+func TestTimeService_GetCurrentTime_SiderealTime(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	fixed := time.Date(2000, time.January, 1, 12, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger, WithClock(FixedClock{Time: fixed}))
+
+	longitude := -75.0
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{SiderealTime: true, Longitude: &longitude})
+	require.NoError(t, err)
+	assert.Equal(t, "18h 41m 50.548s", result.GreenwichSiderealTime)
+	assert.Equal(t, "13h 41m 50.548s", result.SiderealTime)
+
+	// Without a longitude only the Greenwich sidereal time is known
+	result, err = service.GetCurrentTime(context.Background(), GetTimeInput{SiderealTime: true})
+	require.NoError(t, err)
+	assert.Equal(t, "18h 41m 50.548s", result.GreenwichSiderealTime)
+	assert.Empty(t, result.SiderealTime)
+
+	invalid := 200.0
+	_, err = service.GetCurrentTime(context.Background(), GetTimeInput{SiderealTime: true, Longitude: &invalid})
+	assert.Error(t, err)
+}
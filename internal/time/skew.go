@@ -0,0 +1,60 @@
+package time
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// defaultMaxAcceptableSkewSeconds is the clock skew tolerated when none is requested
+const defaultMaxAcceptableSkewSeconds = 300
+
+// CheckClockSkew compares a client-reported time with the server's current time
+func (s *timeService) CheckClockSkew(ctx context.Context, input ClockSkewCheckInput) (ClockSkewCheckResult, error) {
+	if err := ctx.Err(); err != nil {
+		return ClockSkewCheckResult{}, err
+	}
+
+	if input.ClientTime == "" {
+		return ClockSkewCheckResult{}, validationErrorf(CodeMissingArgument, "client_time is required")
+	}
+
+	maxSkew := defaultMaxAcceptableSkewSeconds
+	if input.MaxAcceptableSkewSeconds != nil {
+		maxSkew = *input.MaxAcceptableSkewSeconds
+	}
+	if maxSkew < 0 {
+		return ClockSkewCheckResult{}, validationErrorf(CodeInvalidArgument, "max_acceptable_skew_seconds cannot be negative, got: %d", maxSkew)
+	}
+
+	clientTime, err := parseClientTime(input.ClientTime)
+	if err != nil {
+		return ClockSkewCheckResult{}, parseErrorf(CodeInvalidTimestamp, "invalid client_time: %w", err)
+	}
+
+	serverTime := s.clock.Now()
+	skew := clientTime.Sub(serverTime).Seconds()
+
+	s.logger.Debug("Checked clock skew",
+		zap.Time("client_time", clientTime),
+		zap.Time("server_time", serverTime),
+		zap.Float64("skew_seconds", skew))
+
+	return ClockSkewCheckResult{
+		SkewSeconds:              skew,
+		IsAcceptable:             math.Abs(skew) <= float64(maxSkew),
+		MaxAcceptableSkewSeconds: maxSkew,
+		ServerTime:               serverTime.UTC().Format(time.RFC3339Nano),
+		ClientTimeUTC:            clientTime.UTC().Format(time.RFC3339Nano),
+	}, nil
+}
+
+// parseClientTime parses a client timestamp, keeping sub-second precision of RFC3339 strings
+func parseClientTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+		return t, nil
+	}
+	return parseFlexibleTime(value, time.UTC)
+}
@@ -0,0 +1,66 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_CheckClockSkew(t *testing.T) {
+	now := time.Date(2024, 3, 15, 14, 30, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), WithClock(FixedClock{Time: now}))
+
+	tenSeconds := 10
+
+	tests := []struct {
+		name               string
+		input              ClockSkewCheckInput
+		expectedSkew       float64
+		expectedAcceptable bool
+		expectedMax        int
+	}{
+		{"client ahead beyond default", ClockSkewCheckInput{ClientTime: "2024-03-15T14:37:30Z"}, 450, false, 300},
+		{"client behind within default", ClockSkewCheckInput{ClientTime: "2024-03-15T10:28:00-04:00"}, -120, true, 300},
+		{"fractional seconds", ClockSkewCheckInput{ClientTime: "2024-03-15T14:30:00.25Z"}, 0.25, true, 300},
+		{"unix timestamp", ClockSkewCheckInput{ClientTime: "1710513000"}, 0, true, 300},
+		{"custom threshold", ClockSkewCheckInput{ClientTime: "2024-03-15T14:29:45Z", MaxAcceptableSkewSeconds: &tenSeconds}, -15, false, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.CheckClockSkew(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expectedSkew, result.SkewSeconds, 1e-9)
+			assert.Equal(t, tt.expectedAcceptable, result.IsAcceptable)
+			assert.Equal(t, tt.expectedMax, result.MaxAcceptableSkewSeconds)
+			assert.Equal(t, "2024-03-15T14:30:00Z", result.ServerTime)
+		})
+	}
+}
+
+func TestTimeService_CheckClockSkew_Errors(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+	negative := -1
+
+	tests := []struct {
+		name     string
+		input    ClockSkewCheckInput
+		expected string
+	}{
+		{"missing client time", ClockSkewCheckInput{}, "client_time is required"},
+		{"unparseable client time", ClockSkewCheckInput{ClientTime: "yesterday-ish"}, "invalid client_time"},
+		{"negative threshold", ClockSkewCheckInput{ClientTime: "2024-03-15", MaxAcceptableSkewSeconds: &negative}, "cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.CheckClockSkew(context.Background(), tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expected)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package time
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// maxStatsTimestamps caps the number of timestamps accepted by ComputeTimeSeriesStats
+const maxStatsTimestamps = 10000
+
+// defaultPercentiles are computed when no percentiles are requested
+var defaultPercentiles = []float64{50, 90, 95, 99}
+
+// ComputeTimeSeriesStats computes descriptive statistics over a list of timestamps
+func (s *timeService) ComputeTimeSeriesStats(ctx context.Context, input TimeSeriesStatsInput) (TimeSeriesStatsResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeSeriesStatsResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	percentiles := input.Percentiles
+	if len(percentiles) == 0 {
+		percentiles = defaultPercentiles
+	}
+
+	if len(input.Timestamps) == 0 {
+		return TimeSeriesStatsResult{}, validationErrorf(CodeMissingArgument, "timestamps cannot be empty")
+	}
+	if len(input.Timestamps) > maxStatsTimestamps {
+		return TimeSeriesStatsResult{}, validationErrorf(CodeLimitExceeded, "too many timestamps: %d (max %d)", len(input.Timestamps), maxStatsTimestamps)
+	}
+
+	for _, p := range percentiles {
+		if p < 0 || p > 100 || math.IsNaN(p) {
+			return TimeSeriesStatsResult{}, validationErrorf(CodeInvalidArgument, "invalid percentile %v: must be between 0 and 100", p)
+		}
+	}
+
+	s.logger.Debug("Computing time series statistics",
+		zap.Int("count", len(input.Timestamps)),
+		zap.String("timezone", timezone))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeSeriesStatsResult{}, err
+	}
+
+	times := make([]time.Time, 0, len(input.Timestamps))
+	for i, value := range input.Timestamps {
+		if err := ctx.Err(); err != nil {
+			return TimeSeriesStatsResult{}, err
+		}
+
+		t, err := parseFlexibleTime(value, loc)
+		if err != nil {
+			return TimeSeriesStatsResult{}, parseErrorf(CodeInvalidTimestamp, "invalid timestamp at index %d: %w", i, err)
+		}
+		times = append(times, t)
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+
+	// Work with offsets from the earliest timestamp to keep float64 precision
+	first := times[0]
+	offsets := make([]float64, len(times))
+	var sum float64
+	for i, t := range times {
+		offsets[i] = secondsBetween(first, t)
+		sum += offsets[i]
+	}
+
+	mean := sum / float64(len(offsets))
+
+	var squares float64
+	for _, offset := range offsets {
+		squares += (offset - mean) * (offset - mean)
+	}
+
+	at := func(offsetSeconds float64) string {
+		return addSeconds(first, offsetSeconds).Round(time.Millisecond).Format(time.RFC3339Nano)
+	}
+
+	percentileValues := make(map[string]string, len(percentiles))
+	for _, p := range percentiles {
+		key := "p" + strconv.FormatFloat(p, 'f', -1, 64)
+		percentileValues[key] = at(percentile(offsets, p))
+	}
+
+	return TimeSeriesStatsResult{
+		Count:            len(times),
+		Min:              first.Format(time.RFC3339Nano),
+		Max:              times[len(times)-1].Format(time.RFC3339Nano),
+		Mean:             at(mean),
+		Median:           at(percentile(offsets, 50)),
+		Mode:             mode(times),
+		StdDevSeconds:    math.Sqrt(squares / float64(len(offsets))),
+		PercentileValues: percentileValues,
+		Warning:          warning,
+	}, nil
+}
+
+// secondsBetween returns to minus from in seconds. Unlike time.Sub it does not saturate for spans
+// longer than about 292 years.
+func secondsBetween(from, to time.Time) float64 {
+	return float64(to.Unix()-from.Unix()) + float64(to.Nanosecond()-from.Nanosecond())/float64(time.Second)
+}
+
+// addSeconds returns t moved by a fractional number of seconds, for offsets too large for a
+// time.Duration
+func addSeconds(t time.Time, seconds float64) time.Time {
+	whole := math.Floor(seconds)
+	nanos := math.Round((seconds - whole) * float64(time.Second))
+	return time.Unix(t.Unix()+int64(whole), int64(t.Nanosecond())+int64(nanos)).In(t.Location())
+}
+
+// percentile returns the p-th percentile of sorted values, interpolating linearly between closest ranks
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
+
+// mode returns the most frequent of the sorted times in RFC3339, the earliest on ties,
+// or an empty string when every time is distinct
+func mode(sorted []time.Time) string {
+	var best time.Time
+	bestCount := 1
+	for i := 0; i < len(sorted); {
+		j := i + 1
+		for j < len(sorted) && sorted[j].Equal(sorted[i]) {
+			j++
+		}
+
+		if j-i > bestCount {
+			best, bestCount = sorted[i], j-i
+		}
+		i = j
+	}
+
+	if bestCount == 1 {
+		return ""
+	}
+
+	return best.Format(time.RFC3339Nano)
+}
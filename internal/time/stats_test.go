@@ -0,0 +1,137 @@
+package time
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_ComputeTimeSeriesStats(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name     string
+		input    TimeSeriesStatsInput
+		wantErr  bool
+		validate func(t *testing.T, result TimeSeriesStatsResult)
+	}{
+		{
+			name: "basic statistics",
+			input: TimeSeriesStatsInput{
+				Timestamps: []string{"2024-03-01T00:00:40Z", "2024-03-01T00:00:00Z", "2024-03-01T00:00:10Z", "2024-03-01T00:00:10Z", "2024-03-01T00:00:20Z"},
+			},
+			validate: func(t *testing.T, result TimeSeriesStatsResult) {
+				assert.Equal(t, 5, result.Count)
+				assert.Equal(t, "2024-03-01T00:00:00Z", result.Min)
+				assert.Equal(t, "2024-03-01T00:00:40Z", result.Max)
+				assert.Equal(t, "2024-03-01T00:00:16Z", result.Mean)
+				assert.Equal(t, "2024-03-01T00:00:10Z", result.Median)
+				assert.Equal(t, "2024-03-01T00:00:10Z", result.Mode)
+				assert.InDelta(t, 13.5647, result.StdDevSeconds, 0.0001)
+				assert.Equal(t, map[string]string{
+					"p50": "2024-03-01T00:00:10Z",
+					"p90": "2024-03-01T00:00:32Z",
+					"p95": "2024-03-01T00:00:36Z",
+					"p99": "2024-03-01T00:00:39.2Z",
+				}, result.PercentileValues)
+			},
+		},
+		{
+			name: "custom percentiles in a timezone",
+			input: TimeSeriesStatsInput{
+				Timestamps:  []string{"2024-03-01 09:00", "2024-03-01 10:00"},
+				Timezone:    "Asia/Tokyo",
+				Percentiles: []float64{0, 25, 100},
+			},
+			validate: func(t *testing.T, result TimeSeriesStatsResult) {
+				assert.Equal(t, "2024-03-01T09:30:00+09:00", result.Mean)
+				assert.Empty(t, result.Mode)
+				assert.Equal(t, 1800.0, result.StdDevSeconds)
+				assert.Equal(t, map[string]string{
+					"p0":   "2024-03-01T09:00:00+09:00",
+					"p25":  "2024-03-01T09:15:00+09:00",
+					"p100": "2024-03-01T10:00:00+09:00",
+				}, result.PercentileValues)
+			},
+		},
+		{
+			name: "span longer than a time.Duration",
+			input: TimeSeriesStatsInput{
+				Timestamps:  []string{"1000-01-01", "1400-01-01", "2000-01-01"},
+				Percentiles: []float64{50, 100},
+			},
+			validate: func(t *testing.T, result TimeSeriesStatsResult) {
+				assert.Equal(t, "1000-01-01T00:00:00Z", result.Min)
+				assert.Equal(t, "2000-01-01T00:00:00Z", result.Max)
+				assert.Equal(t, "1466-09-01T08:00:00Z", result.Mean)
+				assert.Equal(t, "1400-01-01T00:00:00Z", result.Median)
+				assert.InDelta(t, 12968655765.416, result.StdDevSeconds, 0.01)
+				assert.Equal(t, map[string]string{
+					"p50":  "1400-01-01T00:00:00Z",
+					"p100": "2000-01-01T00:00:00Z",
+				}, result.PercentileValues)
+			},
+		},
+		{
+			name:  "single timestamp",
+			input: TimeSeriesStatsInput{Timestamps: []string{"1709251200"}},
+			validate: func(t *testing.T, result TimeSeriesStatsResult) {
+				assert.Equal(t, "2024-03-01T00:00:00Z", result.Median)
+				assert.Zero(t, result.StdDevSeconds)
+			},
+		},
+		{
+			name:    "empty timestamps",
+			input:   TimeSeriesStatsInput{},
+			wantErr: true,
+		},
+		{
+			name:    "percentile out of range",
+			input:   TimeSeriesStatsInput{Timestamps: []string{"2024-03-01"}, Percentiles: []float64{101}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timestamp",
+			input:   TimeSeriesStatsInput{Timestamps: []string{"yesterday"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			input:   TimeSeriesStatsInput{Timestamps: []string{"2024-03-01"}, Timezone: "Nowhere/City"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.ComputeTimeSeriesStats(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.validate(t, result)
+		})
+	}
+}
+
+func TestTimeService_ComputeTimeSeriesStats_TooManyTimestamps(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	timestamps := make([]string, maxStatsTimestamps+1)
+	for i := range timestamps {
+		timestamps[i] = fmt.Sprint(1700000000 + i)
+	}
+
+	_, err := service.ComputeTimeSeriesStats(context.Background(), TimeSeriesStatsInput{Timestamps: timestamps})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "too many timestamps")
+}
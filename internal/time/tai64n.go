@@ -0,0 +1,41 @@
+package time
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// tai64Base is the TAI64 label of 1970-01-01 00:00:00 TAI
+const tai64Base = uint64(1) << 62
+
+// formatTai64N encodes t as an external TAI64N label: "@", 16 hex digits of TAI seconds
+// offset by 2^62, and 8 hex digits of nanoseconds
+func formatTai64N(t time.Time) string {
+	taiSeconds := t.Unix() + taiMinusUTC(t)
+	return fmt.Sprintf("@%016x%08x", tai64Base+uint64(taiSeconds), t.Nanosecond())
+}
+
+// parseTai64N decodes an external TAI64N label into a UTC time
+func parseTai64N(value string) (time.Time, error) {
+	label, ok := strings.CutPrefix(value, "@")
+	if !ok || len(label) != 24 {
+		return time.Time{}, parseErrorf(CodeInvalidTimestamp, "invalid TAI64N label %q: expected '@' followed by 24 hex digits", value)
+	}
+
+	seconds, err := strconv.ParseUint(label[:16], 16, 64)
+	if err != nil {
+		return time.Time{}, parseErrorf(CodeInvalidTimestamp, "invalid TAI64N seconds: %w", err)
+	}
+	nanos, err := strconv.ParseUint(label[16:], 16, 32)
+	if err != nil {
+		return time.Time{}, parseErrorf(CodeInvalidTimestamp, "invalid TAI64N nanoseconds: %w", err)
+	}
+	if nanos >= uint64(time.Second) {
+		return time.Time{}, parseErrorf(CodeInvalidTimestamp, "invalid TAI64N nanoseconds: %d is not below one second", nanos)
+	}
+
+	taiSeconds := int64(seconds - tai64Base)
+	return time.Unix(utcFromTAISeconds(taiSeconds), int64(nanos)).UTC(), nil
+}
@@ -0,0 +1,105 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_taiMinusUTC(t *testing.T) {
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected int64
+	}{
+		{"before 1972", time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+		{"first leap second", time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 11},
+		{"last second before 2017", time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36},
+		{"since 2017", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+		{"today", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, taiMinusUTC(tt.at))
+		})
+	}
+}
+
+func Test_formatTai64N(t *testing.T) {
+	tests := []struct {
+		name     string
+		at       time.Time
+		expected string
+	}{
+		{"Unix epoch", time.Unix(0, 0), "@400000000000000a00000000"},
+		{"2017 leap second boundary", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), "@40000000586846a500000000"},
+		{"with nanoseconds", time.Date(2024, 1, 1, 0, 0, 0, 500000000, time.UTC), "@40000000659200a51dcd6500"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label := formatTai64N(tt.at)
+			assert.Equal(t, tt.expected, label)
+
+			parsed, err := parseTai64N(label)
+			require.NoError(t, err)
+			assert.True(t, tt.at.Equal(parsed), "round trip returned %s", parsed)
+		})
+	}
+}
+
+func Test_parseTai64N_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{"missing @", "40000000659200a51dcd6500"},
+		{"too short", "@40000000659200a5"},
+		{"not hex", "@4000000065920zzz1dcd6500"},
+		{"nanoseconds overflow", "@40000000659200a53b9aca00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseTai64N(tt.value)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTimeService_FormatTime_Tai64N(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339", "Tai64N"}, zaptest.NewLogger(t))
+
+	result, err := service.FormatTime(context.Background(), FormatTimeInput{
+		Timestamp: "2024-01-01T00:00:00.5Z",
+		Format:    "Tai64N",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "@40000000659200a51dcd6500", result.FormattedTime)
+
+	parsed, err := service.ParseTime(context.Background(), ParseTimeInput{
+		TimeString: result.FormattedTime,
+		Format:     "Tai64N",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-01-01T00:00:00Z", parsed.UTCTime)
+}
+
+func TestTimeService_GetCurrentTime_IncludeTAI(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 1, 1, 0, 0, 0, 500000000, time.UTC)}))
+
+	// TAI is 37 seconds ahead of UTC since 2017: 0x659200a5 = 1704067200 + 37
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "Asia/Tokyo", IncludeTAI: true})
+	require.NoError(t, err)
+	assert.Equal(t, "@40000000659200a51dcd6500", result.TAI64N)
+
+	result, err = service.GetCurrentTime(context.Background(), GetTimeInput{})
+	require.NoError(t, err)
+	assert.Empty(t, result.TAI64N)
+}
@@ -0,0 +1,60 @@
+package time
+
+// TimeSource is a Clock that also identifies where its time comes from and whether that time
+// is authoritative, i.e. verified against an external reference rather than the local clock
+type TimeSource interface {
+	Clock
+
+	// IsAuthoritative reports whether the time returned by Now comes from a trusted reference
+	IsAuthoritative() bool
+
+	// Name identifies the time source in get_time results, e.g. "system" or "ntp"
+	Name() string
+}
+
+// SystemTimeSource reads the operating system clock. It is the default time source.
+type SystemTimeSource = RealClock
+
+// FixedTimeSource always reports the same time, for tests
+type FixedTimeSource = FixedClock
+
+// IsAuthoritative is false: the OS clock is not verified against a reference
+func (RealClock) IsAuthoritative() bool {
+	return false
+}
+
+// Name returns "system"
+func (RealClock) Name() string {
+	return "system"
+}
+
+// IsAuthoritative is false: a fixed time is never authoritative
+func (FixedClock) IsAuthoritative() bool {
+	return false
+}
+
+// Name returns "fixed"
+func (FixedClock) Name() string {
+	return "fixed"
+}
+
+// WithTimeSource sets the source of the current time. A nil source keeps the system clock.
+func WithTimeSource(source TimeSource) Option {
+	return WithClock(source)
+}
+
+// describeTimeSource returns the name and authority of a clock. Clocks that are not time
+// sources, such as test doubles, are reported as non-authoritative "custom" clocks.
+func describeTimeSource(clock Clock) (string, bool) {
+	if source, ok := clock.(TimeSource); ok {
+		return source.Name(), source.IsAuthoritative()
+	}
+	return "custom", false
+}
+
+// ensure the built-in sources satisfy TimeSource
+var (
+	_ TimeSource = SystemTimeSource{}
+	_ TimeSource = FixedTimeSource{}
+	_ TimeSource = (*NTPTimeSource)(nil)
+)
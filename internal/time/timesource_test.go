@@ -0,0 +1,72 @@
+package time
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+// customClock is a Clock that is not a TimeSource
+type customClock struct{}
+
+func (customClock) Now() time.Time { return time.Unix(0, 0) }
+
+func TestTimeService_GetCurrentTime_TimeSource(t *testing.T) {
+	tests := []struct {
+		name                  string
+		opts                  []Option
+		expectedSource        string
+		expectedAuthoritative bool
+	}{
+		{name: "system by default", expectedSource: "system"},
+		{name: "fixed", opts: []Option{WithTimeSource(FixedTimeSource{Time: time.Unix(0, 0)})}, expectedSource: "fixed"},
+		{name: "custom clock", opts: []Option{WithClock(customClock{})}, expectedSource: "custom"},
+		{name: "nil source keeps the system clock", opts: []Option{WithTimeSource(nil)}, expectedSource: "system"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t), tt.opts...)
+
+			result, err := service.GetCurrentTime(context.Background(), GetTimeInput{})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectedSource, result.TimeSource)
+			assert.Equal(t, tt.expectedAuthoritative, result.IsAuthoritative)
+		})
+	}
+}
+
+func TestNTPTimeSource(t *testing.T) {
+	t.Run("corrects the local clock by the server offset", func(t *testing.T) {
+		source := NewNTPTimeSource(startFakeNTPv4Server(t, time.Hour), time.Second, zaptest.NewLogger(t))
+
+		now := source.Now()
+		assert.WithinDuration(t, time.Now().Add(time.Hour), now, 100*time.Millisecond)
+		assert.True(t, source.IsAuthoritative())
+		assert.Equal(t, "ntp", source.Name())
+	})
+
+	t.Run("falls back to the system clock when unreachable", func(t *testing.T) {
+		// Reserve a port and release it so nothing answers there
+		conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := conn.LocalAddr().String()
+		conn.Close()
+
+		source := NewNTPTimeSource(addr, 200*time.Millisecond, zaptest.NewLogger(t))
+
+		assert.WithinDuration(t, time.Now(), source.Now(), time.Second)
+		assert.False(t, source.IsAuthoritative())
+	})
+
+	t.Run("default port", func(t *testing.T) {
+		source := NewNTPTimeSource("pool.ntp.org", time.Second, zaptest.NewLogger(t))
+		assert.Equal(t, "pool.ntp.org:123", source.server)
+	})
+}
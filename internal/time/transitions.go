@@ -0,0 +1,102 @@
+package time
+
+import (
+	"reflect"
+	"sort"
+	"time"
+)
+
+// maxUpcomingTransitions caps the number of transitions returned by timezone_info
+const maxUpcomingTransitions = 12
+
+// upcomingTransitions returns the offset transitions of loc in the 12 months after t, in order.
+// Each transition is located to the second by bisecting the day in which the offset changes.
+func upcomingTransitions(t time.Time, loc *time.Location) []DSTTransitionInfo {
+	var transitions []DSTTransitionInfo
+
+	current := t.In(loc)
+	end := current.AddDate(1, 0, 0)
+	_, currentOffset := current.Zone()
+
+	for current.Before(end) && len(transitions) < maxUpcomingTransitions {
+		next := current.Add(24 * time.Hour)
+		_, nextOffset := next.Zone()
+
+		if nextOffset != currentOffset {
+			at := bisectTransition(current, next)
+			if at.After(end) {
+				break
+			}
+
+			abbreviation, newOffset := at.Zone()
+			transitionType := "enter_dst"
+			if newOffset < currentOffset {
+				transitionType = "exit_dst"
+			}
+
+			transitions = append(transitions, DSTTransitionInfo{
+				NextTransition:   at,
+				TransitionType:   transitionType,
+				OffsetChange:     newOffset - currentOffset,
+				NewAbbreviation:  abbreviation,
+				NewOffsetSeconds: newOffset,
+			})
+
+			currentOffset = newOffset
+		}
+
+		current = next
+	}
+
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].NextTransition.Before(transitions[j].NextTransition)
+	})
+
+	return transitions
+}
+
+// bisectTransition returns the first second after before at which the offset differs from before's offset
+func bisectTransition(before, after time.Time) time.Time {
+	_, beforeOffset := before.Zone()
+
+	for after.Sub(before) > time.Second {
+		mid := before.Add(after.Sub(before) / 2)
+		if _, offset := mid.Zone(); offset == beforeOffset {
+			before = mid
+		} else {
+			after = mid
+		}
+	}
+
+	return after.Truncate(time.Second)
+}
+
+// zicBigBang is the placeholder transition zic writes at -2^59 seconds; like Go's own alpha
+// sentinel it marks the start of time rather than a real offset change
+const zicBigBang = -1 << 59
+
+// zoneDatabaseTransitions reports the transitions recorded for loc in the zone database, read
+// from the unexported transition table of the loaded *time.Location. Transitions generated from
+// the zone's trailing POSIX rule are not part of the table and are not counted.
+func zoneDatabaseTransitions(loc *time.Location) (count int, first, last *time.Time) {
+	table := reflect.ValueOf(loc).Elem().FieldByName("tx")
+	if !table.IsValid() || table.Kind() != reflect.Slice {
+		return 0, nil, nil
+	}
+
+	for i := 0; i < table.Len(); i++ {
+		when := table.Index(i).FieldByName("when")
+		if !when.IsValid() || when.Int() <= zicBigBang {
+			continue
+		}
+
+		at := time.Unix(when.Int(), 0).UTC()
+		if first == nil {
+			first = &at
+		}
+		last = &at
+		count++
+	}
+
+	return count, first, last
+}
@@ -0,0 +1,85 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetTimezoneInfo_UpcomingTransitions(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+	reference := "2024-01-15T00:00:00Z"
+
+	t.Run("new york", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{
+			Timezone:                   "America/New_York",
+			ReferenceTime:              reference,
+			IncludeUpcomingTransitions: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, info.UpcomingTransitions, 2)
+
+		spring := info.UpcomingTransitions[0]
+		assert.Equal(t, "2024-03-10T07:00:00Z", spring.NextTransition.UTC().Format(time.RFC3339))
+		assert.Equal(t, "enter_dst", spring.TransitionType)
+		assert.Equal(t, 3600, spring.OffsetChange)
+		assert.Equal(t, "EDT", spring.NewAbbreviation)
+		assert.Equal(t, -4*3600, spring.NewOffsetSeconds)
+
+		fall := info.UpcomingTransitions[1]
+		assert.Equal(t, "2024-11-03T06:00:00Z", fall.NextTransition.UTC().Format(time.RFC3339))
+		assert.Equal(t, "exit_dst", fall.TransitionType)
+		assert.Equal(t, -3600, fall.OffsetChange)
+		assert.Equal(t, "EST", fall.NewAbbreviation)
+		assert.Equal(t, -5*3600, fall.NewOffsetSeconds)
+	})
+
+	t.Run("no transitions in UTC", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{
+			Timezone:                   "UTC",
+			ReferenceTime:              reference,
+			IncludeUpcomingTransitions: true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, info.UpcomingTransitions)
+	})
+
+	t.Run("not requested", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{
+			Timezone:      "America/New_York",
+			ReferenceTime: reference,
+		})
+		require.NoError(t, err)
+		assert.Nil(t, info.UpcomingTransitions)
+	})
+}
+
+func TestTimeService_GetTimezoneInfo_TransitionCount(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	t.Run("new york", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "America/New_York"})
+		require.NoError(t, err)
+
+		assert.Greater(t, info.TransitionCount, 100)
+		require.NotNil(t, info.FirstTransitionTime)
+		assert.Equal(t, "1883-11-18T17:00:00Z", *info.FirstTransitionTime)
+		require.NotNil(t, info.LastTransitionTime)
+		assert.True(t, info.HasHistoricalData)
+	})
+
+	t.Run("UTC has no transitions", func(t *testing.T) {
+		info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "UTC"})
+		require.NoError(t, err)
+
+		assert.Zero(t, info.TransitionCount)
+		assert.Nil(t, info.FirstTransitionTime)
+		assert.Nil(t, info.LastTransitionTime)
+		assert.False(t, info.HasHistoricalData)
+	})
+}
@@ -11,8 +11,32 @@ type TimezoneInfo struct {
 	Offset        string             `json:"offset"`
 	OffsetSeconds int                `json:"offset_seconds"`
 	IsDST         bool               `json:"is_dst"`
+	POSIXTZString string             `json:"posix_tz,omitempty"` // POSIX TZ string for the rules of the reference year, e.g. "EST5EDT,M3.2.0,M11.1.0"
 	DST           *DSTInfo           `json:"dst,omitempty"`
 	DSTTransition *DSTTransitionInfo `json:"dst_transition,omitempty"` // Keep for backward compatibility
+
+	UpcomingTransitions []DSTTransitionInfo  `json:"upcoming_transitions,omitempty"` // Next 12 months, when requested
+	ComparableZones     []string             `json:"comparable_zones,omitempty"`     // Zones sharing the current offset, when requested
+	GeoMetadata         *TimezoneGeoMetadata `json:"geo_metadata,omitempty"`         // Country and region, when requested
+	MajorCities         []string             `json:"major_cities,omitempty"`         // Largest cities observing the zone, when requested
+
+	LocalizedName string `json:"localized_name,omitempty"` // Display name in the requested locale, e.g. "Eastern Time"
+	LocalizedCity string `json:"localized_city,omitempty"` // City name in the requested locale, e.g. "Nueva York"
+
+	TransitionCount     int     `json:"transition_count"`                // Transitions recorded in the zone database
+	FirstTransitionTime *string `json:"first_transition_time,omitempty"` // RFC3339 UTC time of the earliest recorded transition
+	LastTransitionTime  *string `json:"last_transition_time,omitempty"`  // RFC3339 UTC time of the latest recorded transition
+	HasHistoricalData   bool    `json:"has_historical_data"`             // Whether any transition predates 1970
+
+	Warning string `json:"warning,omitempty"` // Set when an invalid timezone was replaced by the fallback timezone
+}
+
+// TimezoneGeoMetadata describes the country and region a timezone belongs to, from the IANA zone.tab
+type TimezoneGeoMetadata struct {
+	CountryCode string `json:"country_code"` // ISO 3166-1 alpha-2 code
+	CountryName string `json:"country_name"`
+	Region      string `json:"region"`  // Continental region, e.g. "North America"
+	Capital     bool   `json:"capital"` // Whether the zone is named after the country's capital
 }
 
 // DSTInfo contains DST period information
@@ -27,6 +51,9 @@ type DSTTransitionInfo struct {
 	NextTransition time.Time `json:"next_transition"`
 	TransitionType string    `json:"transition_type"` // "enter_dst" or "exit_dst"
 	OffsetChange   int       `json:"offset_change"`   // seconds
+
+	NewAbbreviation  string `json:"new_abbreviation,omitempty"`   // abbreviation after the transition
+	NewOffsetSeconds int    `json:"new_offset_seconds,omitempty"` // total UTC offset after the transition
 }
 
 // FormatType represents supported time format types
@@ -40,19 +67,27 @@ const (
 	FormatUnixMicro   FormatType = "UnixMicro"
 	FormatUnixNano    FormatType = "UnixNano"
 	FormatLayout      FormatType = "Layout"
+	FormatTai64N      FormatType = "Tai64N"
+)
+
+// Week numbering systems
+const (
+	WeekNumberingISO = "iso"
+	WeekNumberingUS  = "us"
 )
 
 // IsValidFormat checks if a format type is supported
 func IsValidFormat(format string) bool {
 	switch FormatType(format) {
-	case FormatRFC3339, FormatRFC3339Nano, FormatUnix, FormatUnixMilli, FormatUnixMicro, FormatUnixNano, FormatLayout:
+	case FormatRFC3339, FormatRFC3339Nano, FormatUnix, FormatUnixMilli, FormatUnixMicro, FormatUnixNano, FormatLayout, FormatTai64N:
 		return true
 	default:
 		return false
 	}
 }
 
-// GetFormatLayout returns the Go time layout for a given format type
+// GetFormatLayout returns the Go time layout for a given format type. Epoch-based formats and
+// Tai64N have no Go layout and are encoded by the time service instead.
 func GetFormatLayout(format FormatType) string {
 	switch format {
 	case FormatRFC3339:
@@ -68,26 +103,233 @@ func GetFormatLayout(format FormatType) string {
 type ParseTimeInput struct {
 	TimeString string `json:"time_string" jsonschema:"Time string to parse"`
 	Format     string `json:"format,omitempty" jsonschema:"Expected time format (RFC3339, Unix, etc.). If not provided, will attempt to auto-detect"`
-	Timezone   string `json:"timezone,omitempty" jsonschema:"IANA timezone name for parsing (e.g., 'America/New_York', 'Europe/London'). Defaults to UTC if not provided"`
+	Timezone   string `json:"timezone,omitempty" jsonschema:"IANA timezone name for parsing (e.g., 'America/New_York', 'Europe/London'). Also accepts the abbreviations ACDT, ACST, ADT, AEDT, AEST, AKDT, AKST, ART, AST, AWST, BRT, BST, CAT, CDT, CEST, CET, CST, EAT, EDT, EEST, EET, EST, GST, HKT, HST, ICT, IST, JST, KST, MDT, MSK, MST, NDT, NST, NZDT, NZST, PDT, PHT, PKT, PST, SAST, SGT, WAT, WEST, WET, WIB, expanded to their main IANA zone (EST is America/New_York, IST is Asia/Kolkata). Defaults to UTC if not provided"`
+
+	ReturnAllCandidates bool   `json:"return_all_candidates,omitempty" jsonschema:"Try every known format and return all matches ranked by confidence. Without a format, the best match becomes the primary result"`
+	Language            string `json:"language,omitempty" jsonschema:"Language of a date with a spelled-out month (fr, es, de, pt, ja), e.g. '15 mars 2024' or '15 de marzo de 2024'. Cannot be combined with format"`
+	AllowRelative       bool   `json:"allow_relative,omitempty" jsonschema:"Also accept expressions relative to now in the timezone, e.g. 'yesterday', '3 hours ago', 'last Tuesday', 'next month' or '2 weeks from now'. Ignored when format is set"`
+	Locale              string `json:"locale,omitempty" jsonschema:"Locale deciding the order of numeric dates such as 01/02/2024 when no format is given: month first for en-US, day first for locales like en-GB or fr-FR. Defaults to en-US"`
+
+	SeasonType string `json:"season_type,omitempty" jsonschema:"How seasons are computed: meteorological (whole months, spring starts March 1) or astronomical (equinoxes and solstices). Defaults to meteorological"`
+
+	Explain bool `json:"explain,omitempty" jsonschema:"Include a step-by-step explanation of how the result was computed"`
 }
 
 // FormatTimeInput represents input for formatting time
 type FormatTimeInput struct {
-	Timestamp interface{} `json:"timestamp" jsonschema:"Timestamp to format (can be Unix timestamp as number, RFC3339 string, or ISO 8601 string)"` // can be string, int, or time.Time
-	Format    string      `json:"format" jsonschema:"Desired output format (RFC3339, RFC3339Nano, Unix, UnixMilli, UnixMicro, UnixNano, or Layout)"`
-	Timezone  string      `json:"timezone,omitempty" jsonschema:"IANA timezone name for output (e.g., 'America/New_York', 'Europe/London'). Defaults to UTC if not provided"`
+	Timestamp     interface{} `json:"timestamp,omitempty" jsonschema:"Timestamp to format (can be Unix timestamp as number, RFC3339 string, or ISO 8601 string). Defaults to the current time when null, empty, or 0"` // can be string, int, or time.Time
+	OffsetFromNow string      `json:"offset_from_now,omitempty" jsonschema:"Alternative to timestamp: Go duration added to the current time (e.g., '2h30m', '-45m')"`
+	Format        string      `json:"format" jsonschema:"Desired output format (RFC3339, RFC3339Nano, Unix, UnixMilli, UnixMicro, UnixNano, Tai64N, or Layout)"`
+	Timezone      string      `json:"timezone,omitempty" jsonschema:"IANA timezone name for output (e.g., 'America/New_York', 'Europe/London'). Also accepts the abbreviations ACDT, ACST, ADT, AEDT, AEST, AKDT, AKST, ART, AST, AWST, BRT, BST, CAT, CDT, CEST, CET, CST, EAT, EDT, EEST, EET, EST, GST, HKT, HST, ICT, IST, JST, KST, MDT, MSK, MST, NDT, NST, NZDT, NZST, PDT, PHT, PKT, PST, SAST, SGT, WAT, WEST, WET, WIB, expanded to their main IANA zone (EST is America/New_York, IST is Asia/Kolkata). Defaults to UTC if not provided"`
+	Explain       bool        `json:"explain,omitempty" jsonschema:"Include a step-by-step explanation of how the result was computed"`
+
+	IncludeElapsed bool `json:"include_elapsed,omitempty" jsonschema:"Also describe how long ago (or how far in the future) the timestamp is, e.g. '3 days and 2 hours ago'"`
 }
 
 // GetTimeInput represents input for getting current time
 type GetTimeInput struct {
-	Timezone string `json:"timezone,omitempty" jsonschema:"IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Defaults to UTC if not provided"`
-	Format   string `json:"format,omitempty" jsonschema:"Desired output format (RFC3339, RFC3339Nano, Unix, UnixMilli, UnixMicro, UnixNano, or Layout). Defaults to RFC3339"`
+	Timezone string `json:"timezone,omitempty" jsonschema:"IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Also accepts the abbreviations ACDT, ACST, ADT, AEDT, AEST, AKDT, AKST, ART, AST, AWST, BRT, BST, CAT, CDT, CEST, CET, CST, EAT, EDT, EEST, EET, EST, GST, HKT, HST, ICT, IST, JST, KST, MDT, MSK, MST, NDT, NST, NZDT, NZST, PDT, PHT, PKT, PST, SAST, SGT, WAT, WEST, WET, WIB, expanded to their main IANA zone (EST is America/New_York, IST is Asia/Kolkata). Defaults to UTC if not provided"`
+	Format   string `json:"format,omitempty" jsonschema:"Desired output format (RFC3339, RFC3339Nano, Unix, UnixMilli, UnixMicro, UnixNano, Tai64N, or Layout). Defaults to RFC3339"`
+
+	IncludeZodiac     bool   `json:"include_zodiac,omitempty" jsonschema:"Include the Western zodiac sign and element for the current date"`
+	IncludeJulianDate bool   `json:"include_julian_date,omitempty" jsonschema:"Include the Julian Date and Modified Julian Date for the current time"`
+	Locale            string `json:"locale,omitempty" jsonschema:"Locale for weekday and month names (fr-FR, de-DE, es-ES, pt-BR, ja-JP). Unsupported locales fall back to English"`
+	Verbose           bool   `json:"verbose,omitempty" jsonschema:"Also include timezone info, ISO week, day of year, quarter and an epoch breakdown in one response"`
+	IncludeHexEpoch   bool   `json:"include_hex_epoch,omitempty" jsonschema:"Include the Unix timestamp in hexadecimal, octal and 64-bit binary"`
+	IncludeNATO       bool   `json:"include_nato,omitempty" jsonschema:"Include the NATO time zone letter and military time, e.g. 1500R"`
+
+	IncludePlatformFormats bool `json:"include_platform_formats,omitempty" jsonschema:"Include the time as a Windows FILETIME, .NET ticks and a Java timestamp"`
+	IncludeHexColor        bool `json:"include_hex_color,omitempty" jsonschema:"Include the time of day as a hex color (#RRGGBB from hours, minutes and seconds) and an HSL color"`
+	IncludeTAI             bool `json:"include_tai,omitempty" jsonschema:"Include the time as a TAI64N label, as written in qmail and daemontools logs"`
+
+	SiderealTime bool     `json:"sidereal_time,omitempty" jsonschema:"Include the Greenwich Mean Sidereal Time, and the Local Sidereal Time when longitude is set"`
+	Longitude    *float64 `json:"longitude,omitempty" jsonschema:"Observer longitude in degrees for the Local Sidereal Time, positive east of Greenwich (-180 to 180)"`
+
+	RelativeExpression string `json:"relative_expression,omitempty" jsonschema:"Resolve a relative time instead of now: now, today, yesterday, tomorrow, last/next/this <weekday>, start of/end of day|week|month|year"`
+
+	SeasonType string `json:"season_type,omitempty" jsonschema:"How seasons are computed: meteorological (whole months, spring starts March 1) or astronomical (equinoxes and solstices). Defaults to meteorological"`
+
+	Explain bool `json:"explain,omitempty" jsonschema:"Include a step-by-step explanation of how the result was computed"`
+
+	MockNow string `json:"mock_now,omitempty" jsonschema:"Testing only: time to use instead of the real clock. Ignored unless the server allows mocking"`
+}
+
+// BatchGetTimeInput represents input for getting the current time for several queries
+type BatchGetTimeInput struct {
+	Queries []GetTimeInput `json:"queries" jsonschema:"List of get_time queries to process (maximum 20)"`
+}
+
+// FormatTimeItem represents a single timestamp to format in a batch
+type FormatTimeItem struct {
+	ID        string      `json:"id,omitempty" jsonschema:"Caller-chosen identifier echoed in the matching result"`
+	Timestamp interface{} `json:"timestamp" jsonschema:"Timestamp to format (Unix timestamp as number, RFC3339 string, or ISO 8601 string)"` // can be string, int, or time.Time
+	Format    string      `json:"format,omitempty" jsonschema:"Desired output format. Defaults to the server's default format"`
+	Timezone  string      `json:"timezone,omitempty" jsonschema:"IANA timezone name for output. Defaults to UTC if not provided"`
+}
+
+// BatchFormatTimeInput represents input for formatting several timestamps
+type BatchFormatTimeInput struct {
+	Items []FormatTimeItem `json:"items" jsonschema:"Timestamps to format (maximum 100)"`
 }
 
 // TimezoneInfoInput represents input for timezone information
 type TimezoneInfoInput struct {
-	Timezone      string    `json:"timezone" jsonschema:"IANA timezone name to get information about (e.g., 'America/New_York', 'Europe/London')"`
-	ReferenceTime time.Time `json:"reference_time,omitempty" jsonschema:"Optional reference time for timezone calculations. Defaults to current time if not provided"`
+	Timezone       string `json:"timezone" jsonschema:"IANA timezone name to get information about (e.g., 'America/New_York', 'Europe/London'). Also accepts the abbreviations ACDT, ACST, ADT, AEDT, AEST, AKDT, AKST, ART, AST, AWST, BRT, BST, CAT, CDT, CEST, CET, CST, EAT, EDT, EEST, EET, EST, GST, HKT, HST, ICT, IST, JST, KST, MDT, MSK, MST, NDT, NST, NZDT, NZST, PDT, PHT, PKT, PST, SAST, SGT, WAT, WEST, WET, WIB, expanded to their main IANA zone (EST is America/New_York, IST is Asia/Kolkata)"`
+	ReferenceTime  string `json:"reference_time,omitempty" jsonschema:"Moment for timezone calculations (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone). Defaults to current time if not provided"`
+	HistoricalDate string `json:"historical_date,omitempty" jsonschema:"Past date to report the offset, abbreviation and DST state in effect at, in the same formats as reference_time (e.g. '2011-12-29' for Samoa before it moved to UTC+13). Takes precedence over reference_time"`
+
+	IncludeUpcomingTransitions bool `json:"include_upcoming_transitions,omitempty" jsonschema:"Include up to 12 offset transitions in the 12 months after the reference time"`
+	IncludeComparableZones     bool `json:"include_comparable_zones,omitempty" jsonschema:"Include up to 5 other zones (capital cities first) sharing the offset at the reference time"`
+	IncludeGeo                 bool `json:"include_geo,omitempty" jsonschema:"Include the country code, country name and region of the zone, and whether it is the country's capital"`
+	IncludeCities              bool `json:"include_cities,omitempty" jsonschema:"Include up to 5 major cities observing the zone, most populous first"`
+
+	Locale string `json:"locale,omitempty" jsonschema:"Locale for the display name and city of the zone (en, fr, de, es, pt or ja, e.g. 'fr-FR'). Unsupported locales fall back to English"`
+}
+
+// TimezoneOffsetAtInput represents input for getting a timezone offset at a specific moment
+type TimezoneOffsetAtInput struct {
+	Timezone string `json:"timezone" jsonschema:"IANA timezone name (e.g., 'America/New_York', 'Europe/London'). Also accepts the abbreviations ACDT, ACST, ADT, AEDT, AEST, AKDT, AKST, ART, AST, AWST, BRT, BST, CAT, CDT, CEST, CET, CST, EAT, EDT, EEST, EET, EST, GST, HKT, HST, ICT, IST, JST, KST, MDT, MSK, MST, NDT, NST, NZDT, NZST, PDT, PHT, PKT, PST, SAST, SGT, WAT, WEST, WET, WIB, expanded to their main IANA zone (EST is America/New_York, IST is Asia/Kolkata). Defaults to UTC if not provided"`
+	At       string `json:"at,omitempty" jsonschema:"Moment to evaluate the offset at (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone). Defaults to current time if not provided"`
+	Explain  bool   `json:"explain,omitempty" jsonschema:"Include a step-by-step explanation of how the result was computed"`
+}
+
+// TimezoneOffsetListInput represents input for listing the distinct current UTC offsets
+type TimezoneOffsetListInput struct {
+	IncludeZones bool `json:"include_zones,omitempty" jsonschema:"Also return the IANA zone names observing each offset"`
+}
+
+// CountdownInput represents input for a countdown to a named event
+type CountdownInput struct {
+	TargetDate    string `json:"target_date" jsonschema:"Date and time of the event (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone)"`
+	EventName     string `json:"event_name,omitempty" jsonschema:"Name of the event, echoed in the result"`
+	Timezone      string `json:"timezone,omitempty" jsonschema:"IANA timezone used to interpret dates without an offset. Defaults to UTC if not provided"`
+	ReferenceTime string `json:"reference_time,omitempty" jsonschema:"Time to count from, in the same formats as target_date. Defaults to current time if not provided"`
+}
+
+// Time in words styles
+const (
+	WordsStyleFormal = "formal"
+	WordsStyleCasual = "casual"
+	WordsStyle24h    = "24h"
+)
+
+// TimeInWordsInput represents input for expressing a time in natural language
+type TimeInWordsInput struct {
+	Timestamp interface{} `json:"timestamp,omitempty" jsonschema:"Timestamp to express (Unix timestamp as number, RFC3339 string, or 'YYYY-MM-DD HH:MM[:SS]'). Defaults to current time if not provided"`
+	Timezone  string      `json:"timezone,omitempty" jsonschema:"IANA timezone name. Defaults to UTC if not provided"`
+	Style     string      `json:"style,omitempty" jsonschema:"Wording style: formal ('quarter past three in the afternoon'), casual ('around 3 PM') or 24h ('fifteen hundred hours'). Defaults to formal"`
+}
+
+// ListFormatsInput is the (empty) input of list_supported_formats
+type ListFormatsInput struct{}
+
+// TimeOverlapInput represents input for finding overlapping working hours between two timezones
+type TimeOverlapInput struct {
+	TimezoneA     string   `json:"timezone_a" jsonschema:"IANA timezone of the first party"`
+	TimezoneB     string   `json:"timezone_b" jsonschema:"IANA timezone of the second party"`
+	WorkStartHour *int     `json:"work_start_hour,omitempty" jsonschema:"Hour the working day starts (0-23) in both timezones. Defaults to 9"`
+	WorkEndHour   *int     `json:"work_end_hour,omitempty" jsonschema:"Hour the working day ends (1-24) in both timezones. Defaults to 17"`
+	WorkDays      []string `json:"work_days,omitempty" jsonschema:"Working weekdays (e.g. Mon, Tuesday). Defaults to Monday through Friday"`
+	ReferenceDate string   `json:"reference_date,omitempty" jsonschema:"Date to check in timezone A (YYYY-MM-DD). Defaults to today"`
+}
+
+// TimeArithmeticInput represents input for evaluating a time arithmetic expression
+type TimeArithmeticInput struct {
+	Expression string `json:"expression" jsonschema:"Expression such as 'now + 2 weeks', '2024-03-15 - 10 days', 'next Monday + 8 hours' or '3 days before next Tuesday'"`
+	Timezone   string `json:"timezone,omitempty" jsonschema:"IANA timezone for dates, weekdays and 'today'. Defaults to UTC if not provided"`
+}
+
+// DSTSafeAddInput represents input for adding units to a timestamp on the wall clock of its timezone
+type DSTSafeAddInput struct {
+	Timestamp string `json:"timestamp,omitempty" jsonschema:"Starting time (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone). Defaults to now"`
+	Timezone  string `json:"timezone,omitempty" jsonschema:"IANA timezone whose wall clock the units are added on. Defaults to the configured default timezone"`
+	Years     int    `json:"years,omitempty" jsonschema:"Years to add, negative to subtract"`
+	Months    int    `json:"months,omitempty" jsonschema:"Months to add, negative to subtract"`
+	Days      int    `json:"days,omitempty" jsonschema:"Days to add, negative to subtract"`
+	Hours     int    `json:"hours,omitempty" jsonschema:"Wall clock hours to add, negative to subtract"`
+	Minutes   int    `json:"minutes,omitempty" jsonschema:"Wall clock minutes to add, negative to subtract"`
+	Seconds   int    `json:"seconds,omitempty" jsonschema:"Wall clock seconds to add, negative to subtract"`
+	Duration  string `json:"duration,omitempty" jsonschema:"ISO 8601 duration to add, e.g. 'P1Y2M' or 'PT90M', with a leading minus sign to subtract, e.g. '-P3D'. Added on top of the individual units"`
+}
+
+// TimeExpressionInput represents input for evaluating a query in the time DSL
+type TimeExpressionInput struct {
+	Expression string `json:"expression" jsonschema:"Query such as 'last business day of previous quarter', 'first Monday of next month at 9am UTC' or 'today + 3 business days'"`
+	Timezone   string `json:"timezone,omitempty" jsonschema:"IANA timezone the query is evaluated in, unless it names one itself. Defaults to UTC if not provided"`
+}
+
+// TimeHistogramInput represents input for binning timestamps into time buckets
+type TimeHistogramInput struct {
+	Timestamps []string `json:"timestamps" jsonschema:"Timestamps to bin (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone), at most 10000"`
+	BucketSize string   `json:"bucket_size,omitempty" jsonschema:"Bucket width: a Go duration such as '1h' or '15m', or 'day', 'week' or 'month'. Defaults to 'day'"`
+	Timezone   string   `json:"timezone,omitempty" jsonschema:"IANA timezone that bucket boundaries are aligned to. Defaults to UTC if not provided"`
+	DateFormat string   `json:"date_format,omitempty" jsonschema:"Format of the bucket start and end times. Defaults to RFC3339"`
+}
+
+// TimeGridInput represents input for listing the time in several timezones at regular intervals
+type TimeGridInput struct {
+	Timezones []string `json:"timezones" jsonschema:"IANA timezone names, one column each, at most 10"`
+	StartTime string   `json:"start_time,omitempty" jsonschema:"First row (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' in UTC). Defaults to the current hour"`
+	EndTime   string   `json:"end_time,omitempty" jsonschema:"Last row, inclusive (same forms as start_time). Defaults to 7 days after start_time"`
+	Interval  string   `json:"interval,omitempty" jsonschema:"Go duration between rows (e.g., '1h', '30m'). Defaults to '1h'; at most 1000 rows"`
+	Format    string   `json:"format,omitempty" jsonschema:"Format of the cells. Defaults to the server's default format"`
+}
+
+// CompareTimestampsInput represents input for ordering two timestamps
+type CompareTimestampsInput struct {
+	TimeA   string `json:"time_a" jsonschema:"First timestamp (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' in UTC unless format_a is set)"`
+	TimeB   string `json:"time_b" jsonschema:"Second timestamp, in the same forms as time_a"`
+	FormatA string `json:"format_a,omitempty" jsonschema:"Format of time_a (RFC3339, Unix, a Go layout...). Auto-detected if not provided"`
+	FormatB string `json:"format_b,omitempty" jsonschema:"Format of time_b. Auto-detected if not provided"`
+}
+
+// ClockSkewCheckInput represents input for comparing a client's clock with the server's
+type ClockSkewCheckInput struct {
+	ClientTime               string `json:"client_time" jsonschema:"Time reported by the client (Unix timestamp, RFC3339 with optional fractional seconds, or 'YYYY-MM-DD[ HH:MM[:SS]]' in UTC)"`
+	MaxAcceptableSkewSeconds *int   `json:"max_acceptable_skew_seconds,omitempty" jsonschema:"Largest skew, in either direction, considered acceptable. Defaults to 300"`
+}
+
+// NTPSyncCheckInput represents input for comparing the local clock with several NTP servers
+type NTPSyncCheckInput struct {
+	Servers   []string `json:"servers,omitempty" jsonschema:"NTP servers to query, as 'host' or 'host:port'. Each must be one of the servers allowed by the configuration, by default 0.pool.ntp.org, 1.pool.ntp.org and 2.pool.ntp.org. Defaults to all allowed servers (at most 10)"`
+	TimeoutMs int      `json:"timeout_ms,omitempty" jsonschema:"Timeout of each query in milliseconds, up to 10000. Defaults to 2000"`
+}
+
+// TimeSeriesStatsInput represents input for computing statistics over timestamps
+type TimeSeriesStatsInput struct {
+	Timestamps  []string  `json:"timestamps" jsonschema:"Timestamps to analyze (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone), at most 10000"`
+	Timezone    string    `json:"timezone,omitempty" jsonschema:"IANA timezone for interpreting and reporting times. Defaults to UTC if not provided"`
+	Percentiles []float64 `json:"percentiles,omitempty" jsonschema:"Percentiles to compute, between 0 and 100. Defaults to [50, 90, 95, 99]"`
+}
+
+// TimeSeriesForecastInput represents input for extrapolating an evenly spaced series of timestamps
+type TimeSeriesForecastInput struct {
+	Timestamps []string `json:"timestamps" jsonschema:"Evenly spaced timestamps sorted ascending (Unix timestamp, RFC3339, or 'YYYY-MM-DD[ HH:MM[:SS]]' interpreted in the timezone), at least 3 and at most 10000"`
+	Count      int      `json:"count,omitempty" jsonschema:"Number of timestamps to forecast (1-20). Defaults to 1"`
+	Timezone   string   `json:"timezone,omitempty" jsonschema:"IANA timezone for interpreting and reporting times. Defaults to UTC if not provided"`
+	Format     string   `json:"format,omitempty" jsonschema:"Output format of the forecasted timestamps (RFC3339, RFC3339Nano, Unix, UnixMilli, UnixMicro, UnixNano, Tai64N, or Layout). Defaults to RFC3339"`
+}
+
+// ParticipantLocation is a meeting participant's timezone, working hours and importance
+type ParticipantLocation struct {
+	Timezone  string  `json:"timezone" jsonschema:"IANA timezone of the participant"`
+	WorkStart int     `json:"work_start,omitempty" jsonschema:"Hour the participant's working day starts (0-23), Monday to Friday. Defaults to 9 when work_end is also unset"`
+	WorkEnd   int     `json:"work_end,omitempty" jsonschema:"Hour the participant's working day ends (1-24). Defaults to 17 when work_start is also unset"`
+	Weight    float64 `json:"weight,omitempty" jsonschema:"How much the participant's attendance counts towards the coverage score. Defaults to 1"`
+}
+
+// OptimalMeetingTimeInput represents input for finding the best meeting slots for several participants
+type OptimalMeetingTimeInput struct {
+	Participants    []ParticipantLocation `json:"participants" jsonschema:"Participants to schedule, at most 20"`
+	DurationMinutes int                   `json:"duration_minutes,omitempty" jsonschema:"Length of the meeting in minutes. Defaults to 60"`
+	SearchDays      int                   `json:"search_days,omitempty" jsonschema:"Number of days to search, at most 14. Defaults to 5"`
+	ReferenceDate   string                `json:"reference_date,omitempty" jsonschema:"First day to search (YYYY-MM-DD), starting at midnight UTC. Defaults to today"`
+}
+
+// TimeFormatPreviewInput represents input for previewing a timestamp in many formats
+type TimeFormatPreviewInput struct {
+	Timestamp interface{} `json:"timestamp,omitempty" jsonschema:"Timestamp to render (Unix timestamp as number, RFC3339 string, or 'YYYY-MM-DD HH:MM[:SS]'). Defaults to current time if not provided"`
+	Timezone  string      `json:"timezone,omitempty" jsonschema:"IANA timezone to render the samples in. Defaults to UTC if not provided"`
 }
 
 // Result types for MCP tool responses
@@ -98,6 +340,109 @@ type GetTimeResult struct {
 	Timezone      string `json:"timezone" jsonschema:"The timezone used for formatting"`
 	Format        string `json:"format" jsonschema:"The format used for the time string"`
 	UnixTimestamp int64  `json:"unix_timestamp" jsonschema:"Unix timestamp in seconds"`
+
+	OffsetMinutesFromUTC int `json:"offset_minutes_from_utc" jsonschema:"UTC offset in minutes, positive east of UTC (e.g. 330 for +05:30, -300 for -05:00)"`
+	JSTimezoneOffset     int `json:"js_timezone_offset" jsonschema:"UTC offset in minutes as JavaScript's Date.getTimezoneOffset() returns it: negative east of UTC (e.g. -330 for +05:30, 300 for -05:00)"`
+
+	TimeSource      string `json:"time_source" jsonschema:"Where the current time came from: system, ntp, fixed, or custom"`
+	IsAuthoritative bool   `json:"is_authoritative" jsonschema:"Whether the time was verified against an external reference such as an NTP server"`
+
+	WeekNumber          int    `json:"week_number" jsonschema:"Week of the year using the configured week numbering system"`
+	WeekNumberingSystem string `json:"week_numbering_system" jsonschema:"Week numbering system used for week_number (iso or us)"`
+	ISOWeekNumber       int    `json:"iso_week_number" jsonschema:"ISO 8601 week number (weeks start on Monday)"`
+	ISOWeekYear         int    `json:"iso_week_year" jsonschema:"Year the ISO 8601 week belongs to"`
+	USWeekNumber        int    `json:"us_week_number" jsonschema:"US week number (weeks start on Sunday, week 1 contains January 1)"`
+
+	SeasonNorthern  string `json:"season_northern" jsonschema:"Season of the date in the northern hemisphere: spring, summer, autumn or winter"`
+	SeasonSouthern  string `json:"season_southern" jsonschema:"Season of the date in the southern hemisphere: spring, summer, autumn or winter"`
+	SeasonStartDate string `json:"season_start_date" jsonschema:"First day of the current season (YYYY-MM-DD)"`
+	SeasonEndDate   string `json:"season_end_date" jsonschema:"Last day of the current season (YYYY-MM-DD)"`
+
+	DayAbbreviation   string `json:"day_abbreviation" jsonschema:"Abbreviated weekday name, e.g. Mon, or the abbreviation of the locale (when locale is set)"`
+	DayFull           string `json:"day_full" jsonschema:"Full weekday name, e.g. Monday, or the name in the locale (when locale is set)"`
+	MonthAbbreviation string `json:"month_abbreviation" jsonschema:"Abbreviated month name, e.g. Jan, or the abbreviation of the locale (when locale is set)"`
+	MonthFull         string `json:"month_full" jsonschema:"Full month name, e.g. January, or the name in the locale (when locale is set)"`
+	MonthNumber       int    `json:"month_number" jsonschema:"Month of the year, 1-12"`
+	QuarterLabel      string `json:"quarter_label" jsonschema:"Calendar quarter as a label, e.g. Q1"`
+	QuarterOrdinal    string `json:"quarter_ordinal" jsonschema:"Calendar quarter as an English ordinal, e.g. 1st"`
+
+	ZodiacSign    string `json:"zodiac_sign,omitempty" jsonschema:"Western zodiac sign for the current date (when include_zodiac is set)"`
+	ZodiacElement string `json:"zodiac_element,omitempty" jsonschema:"Element of the zodiac sign: fire, earth, air or water (when include_zodiac is set)"`
+
+	JulianDayNumber    float64 `json:"julian_day_number,omitempty" jsonschema:"Julian Date in days (when include_julian_date is set)"`
+	ModifiedJulianDate float64 `json:"modified_julian_date,omitempty" jsonschema:"Modified Julian Date in days (when include_julian_date is set)"`
+
+	Locale  string `json:"locale,omitempty" jsonschema:"Locale used for weekday and month names (when locale is set)"`
+	Weekday string `json:"weekday,omitempty" jsonschema:"Localized weekday name (when locale is set)"`
+	Month   string `json:"month,omitempty" jsonschema:"Localized month name (when locale is set)"`
+
+	TimezoneInfo   *TimezoneInfo   `json:"timezone_info,omitempty" jsonschema:"Timezone abbreviation, offset and DST state, without transitions (when verbose is set)"`
+	ISOWeek        string          `json:"iso_week,omitempty" jsonschema:"ISO 8601 week date designation, e.g. 2024-W09 (when verbose is set)"`
+	DayOfYear      int             `json:"day_of_year,omitempty" jsonschema:"Day of the year, 1-366 (when verbose is set)"`
+	Quarter        int             `json:"quarter,omitempty" jsonschema:"Calendar quarter, 1-4 (when verbose is set)"`
+	EpochBreakdown *EpochBreakdown `json:"epoch_breakdown,omitempty" jsonschema:"The time since the Unix epoch in every precision (when verbose is set)"`
+
+	EpochHex    string `json:"epoch_hex,omitempty" jsonschema:"Unix timestamp in hexadecimal, e.g. 0x65F3A4C2 (when include_hex_epoch is set)"`
+	EpochOctal  string `json:"epoch_octal,omitempty" jsonschema:"Unix timestamp in octal, e.g. 0o14574722302 (when include_hex_epoch is set)"`
+	EpochBinary string `json:"epoch_binary,omitempty" jsonschema:"Unix timestamp as 64 zero-padded binary digits (when include_hex_epoch is set)"`
+
+	SiderealTime          string `json:"sidereal_time,omitempty" jsonschema:"Local Mean Sidereal Time at the longitude, e.g. '13h 41m 50.548s' (when sidereal_time and longitude are set)"`
+	GreenwichSiderealTime string `json:"greenwich_sidereal_time,omitempty" jsonschema:"Greenwich Mean Sidereal Time, e.g. '18h 41m 50.548s' (when sidereal_time is set)"`
+
+	NATOTimezone string `json:"nato_timezone,omitempty" jsonschema:"NATO time zone letter, e.g. Z for UTC or R for UTC-5; J when the offset has no letter (when include_nato is set)"`
+	NATOTime     string `json:"nato_time,omitempty" jsonschema:"Military time followed by the NATO zone letter, e.g. 1500R (when include_nato is set)"`
+
+	WindowsFiletime int64 `json:"windows_filetime,omitempty" jsonschema:"100-nanosecond intervals since 1601-01-01 UTC, as in Windows FILETIME (when include_platform_formats is set)"`
+	DotNetTicks     int64 `json:"dotnet_ticks,omitempty" jsonschema:"100-nanosecond intervals since 0001-01-01 UTC, as in .NET DateTime.Ticks (when include_platform_formats is set)"`
+	JavaTimestamp   int64 `json:"java_timestamp,omitempty" jsonschema:"Milliseconds since the Unix epoch, as in Java System.currentTimeMillis (when include_platform_formats is set)"`
+
+	HexColorTime string `json:"hex_color_time,omitempty" jsonschema:"Time of day as a color: red from the hour, green from the minute, blue from the second, e.g. #FF0000 at 23:00:00 (when include_hex_color is set)"`
+	HSLColor     string `json:"hsl_color,omitempty" jsonschema:"Time of day as an HSL color: hue from the hour, saturation from the minute, lightness from the second, e.g. hsl(180, 51%, 0%) (when include_hex_color is set)"`
+
+	TAI64N string `json:"tai64n,omitempty" jsonschema:"TAI64N label: '@', 16 hex digits of TAI seconds and 8 hex digits of nanoseconds, accounting for leap seconds (when include_tai is set)"`
+
+	Explanation []string `json:"explanation,omitempty" jsonschema:"Step-by-step description of the calculation (when explain is set)"`
+	Warning     string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// EpochBreakdown expresses a time since the Unix epoch in every supported precision
+type EpochBreakdown struct {
+	Seconds      int64 `json:"seconds" jsonschema:"Seconds since the Unix epoch"`
+	Milliseconds int64 `json:"milliseconds" jsonschema:"Milliseconds since the Unix epoch"`
+	Microseconds int64 `json:"microseconds" jsonschema:"Microseconds since the Unix epoch"`
+	Nanoseconds  int64 `json:"nanoseconds" jsonschema:"Nanoseconds since the Unix epoch"`
+}
+
+// BatchGetTimeEntry represents the outcome of a single query in a batch
+type BatchGetTimeEntry struct {
+	Timezone string         `json:"timezone" jsonschema:"The timezone requested by the query"`
+	Result   *GetTimeResult `json:"result,omitempty" jsonschema:"The query result, absent when the query failed"`
+	Error    string         `json:"error,omitempty" jsonschema:"Error message when the query failed"`
+}
+
+// BatchFormatTimeEntry represents the outcome of a single item in a format batch
+type BatchFormatTimeEntry struct {
+	ID            string `json:"id,omitempty" jsonschema:"The identifier of the item"`
+	FormattedTime string `json:"formatted_time,omitempty" jsonschema:"The formatted time, absent when the item failed"`
+	Error         string `json:"error,omitempty" jsonschema:"Error message when the item failed"`
+	Warning       string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// BatchFormatTimeResult represents the result of a batch of format_time items
+type BatchFormatTimeResult struct {
+	Results []BatchFormatTimeEntry `json:"results" jsonschema:"Results in the same order as the items"`
+}
+
+// BatchGetTimeResult represents the result of a batch of get_time queries
+type BatchGetTimeResult struct {
+	Results []BatchGetTimeEntry `json:"results" jsonschema:"Results in the same order as the queries"`
+}
+
+// ParseCandidate is one possible interpretation of a parsed time string
+type ParseCandidate struct {
+	Format     string  `json:"format" jsonschema:"Format or Go layout that matched"`
+	ParsedAs   string  `json:"parsed_as" jsonschema:"The resulting time in RFC3339"`
+	Confidence float64 `json:"confidence" jsonschema:"Confidence from 0 to 1, based on how specific the format is"`
 }
 
 // FormatTimeResult represents the result of formatting time
@@ -106,12 +451,364 @@ type FormatTimeResult struct {
 	Timezone      string `json:"timezone" jsonschema:"The timezone used for formatting"`
 	Format        string `json:"format" jsonschema:"The format used for the time string"`
 	UnixTimestamp int64  `json:"unix_timestamp" jsonschema:"Unix timestamp in seconds"`
+
+	ElapsedSince string `json:"elapsed_since_formatted,omitempty" jsonschema:"How long ago the timestamp is ('3 days and 2 hours ago'), 'in ...' for future timestamps, or 'now' (when include_elapsed is set)"`
+
+	Explanation []string `json:"explanation,omitempty" jsonschema:"Step-by-step description of the calculation (when explain is set)"`
+	Warning     string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone, or the time is after year 9999"`
 }
 
 // ParseTimeResult represents the result of parsing time
 type ParseTimeResult struct {
-	UnixTimestamp int64  `json:"unix_timestamp" jsonschema:"Unix timestamp in seconds"`
-	RFC3339       string `json:"rfc3339" jsonschema:"Time in RFC3339 format"`
-	Timezone      string `json:"timezone" jsonschema:"The timezone of the parsed time"`
-	IsDST         bool   `json:"is_dst" jsonschema:"Whether the time is in daylight saving time"`
+	UnixTimestamp      int64  `json:"unix_timestamp" jsonschema:"Unix timestamp in seconds"`
+	RFC3339            string `json:"rfc3339" jsonschema:"Time in RFC3339 format"`
+	UTCTime            string `json:"utc_time" jsonschema:"Time in UTC RFC3339 format"`
+	Timezone           string `json:"timezone" jsonschema:"The timezone of the parsed time"`
+	IsDST              bool   `json:"is_dst" jsonschema:"Whether the time is in daylight saving time"`
+	OffsetHours        int    `json:"offset_hours" jsonschema:"Hours component of the UTC offset (negative west of UTC)"`
+	OffsetMinutes      int    `json:"offset_minutes" jsonschema:"Minutes component of the UTC offset, with the same sign as offset_hours"`
+	TotalOffsetSeconds int    `json:"total_offset_seconds" jsonschema:"Total UTC offset in seconds"`
+
+	SeasonNorthern  string `json:"season_northern" jsonschema:"Season of the date in the northern hemisphere: spring, summer, autumn or winter"`
+	SeasonSouthern  string `json:"season_southern" jsonschema:"Season of the date in the southern hemisphere: spring, summer, autumn or winter"`
+	SeasonStartDate string `json:"season_start_date" jsonschema:"First day of the current season (YYYY-MM-DD)"`
+	SeasonEndDate   string `json:"season_end_date" jsonschema:"Last day of the current season (YYYY-MM-DD)"`
+
+	Candidates []ParseCandidate `json:"candidates,omitempty" jsonschema:"Every format that matched, most confident first (when return_all_candidates is set)"`
+
+	AmbiguityWarning string `json:"ambiguity_warning,omitempty" jsonschema:"Set when a numeric date could be read month first or day first, explaining the ordering assumed for the locale"`
+
+	Explanation []string `json:"explanation,omitempty" jsonschema:"Step-by-step description of the calculation (when explain is set)"`
+	Warning     string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimezoneOffsetAtResult represents the UTC offset of a timezone at a specific moment
+type TimezoneOffsetAtResult struct {
+	OffsetString  string `json:"offset_string" jsonschema:"UTC offset in +HH:MM format"`
+	OffsetSeconds int    `json:"offset_seconds" jsonschema:"UTC offset in seconds"`
+	Abbreviation  string `json:"abbreviation" jsonschema:"Timezone abbreviation in effect at that moment"`
+	IsDST         bool   `json:"is_dst" jsonschema:"Whether daylight saving time was in effect at that moment"`
+	UTCTime       string `json:"utc_time" jsonschema:"The evaluated moment in UTC RFC3339 format"`
+
+	Explanation []string `json:"explanation,omitempty" jsonschema:"Step-by-step description of the calculation (when explain is set)"`
+	Warning     string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// OffsetEntry represents a UTC offset currently observed by one or more timezones
+type OffsetEntry struct {
+	OffsetString  string   `json:"offset_string" jsonschema:"UTC offset in +HH:MM format"`
+	OffsetSeconds int      `json:"offset_seconds" jsonschema:"UTC offset in seconds"`
+	Zones         []string `json:"zones,omitempty" jsonschema:"IANA zone names currently observing this offset (when include_zones is set)"`
+}
+
+// TimezoneOffsetListResult represents the list of distinct current UTC offsets
+type TimezoneOffsetListResult struct {
+	Offsets []OffsetEntry `json:"offsets" jsonschema:"Distinct current UTC offsets sorted from west to east"`
+}
+
+// CountdownResult represents the time remaining until a named event
+type CountdownResult struct {
+	EventName       string `json:"event_name,omitempty" jsonschema:"Name of the event"`
+	IsPast          bool   `json:"is_past" jsonschema:"Whether the event is already in the past"`
+	Days            int    `json:"days" jsonschema:"Whole days remaining (or elapsed when the event is past)"`
+	Hours           int    `json:"hours" jsonschema:"Hours remaining after whole days"`
+	Minutes         int    `json:"minutes" jsonschema:"Minutes remaining after whole hours"`
+	Seconds         int    `json:"seconds" jsonschema:"Seconds remaining after whole minutes"`
+	TotalSeconds    int64  `json:"total_seconds" jsonschema:"Total seconds remaining (or elapsed when the event is past)"`
+	NaturalLanguage string `json:"natural_language" jsonschema:"Human readable countdown, e.g. '15 days and 3 hours'"`
+	ISO8601Duration string `json:"iso8601_duration" jsonschema:"Time remaining as an ISO 8601 duration, e.g. 'P15DT3H', negative when the event is past, e.g. '-PT1M30S'"`
+	Warning         string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeInWordsResult represents a time of day expressed in natural language
+type TimeInWordsResult struct {
+	Expression string `json:"expression" jsonschema:"The time of day in words"`
+	Style      string `json:"style" jsonschema:"The wording style used"`
+	Timezone   string `json:"timezone" jsonschema:"The timezone the time was expressed in"`
+	Warning    string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// FormatDescription documents a single supported format
+type FormatDescription struct {
+	Name        string `json:"name" jsonschema:"Format identifier to pass as the format argument"`
+	Description string `json:"description" jsonschema:"What the format represents"`
+	Example     string `json:"example" jsonschema:"The current UTC time in this format"`
+	IsInputOnly bool   `json:"is_input_only" jsonschema:"Whether the format is only accepted when parsing"`
+}
+
+// ListFormatsResult lists the supported formats
+type ListFormatsResult struct {
+	Formats []FormatDescription `json:"formats" jsonschema:"Supported formats in configuration order"`
+}
+
+// TimeOverlapResult represents the overlapping working hours of two timezones
+type TimeOverlapResult struct {
+	TimezoneA     string  `json:"timezone_a" jsonschema:"The first timezone"`
+	TimezoneB     string  `json:"timezone_b" jsonschema:"The second timezone"`
+	ReferenceDate string  `json:"reference_date" jsonschema:"The date checked, in timezone A"`
+	HasOverlap    bool    `json:"has_overlap" jsonschema:"Whether both parties are working at the same time on the reference date"`
+	OverlapStartA string  `json:"overlap_start_a,omitempty" jsonschema:"Start of the overlap in timezone A (RFC3339)"`
+	OverlapEndA   string  `json:"overlap_end_a,omitempty" jsonschema:"End of the overlap in timezone A (RFC3339)"`
+	OverlapStartB string  `json:"overlap_start_b,omitempty" jsonschema:"Start of the overlap in timezone B (RFC3339)"`
+	OverlapEndB   string  `json:"overlap_end_b,omitempty" jsonschema:"End of the overlap in timezone B (RFC3339)"`
+	OverlapHours  float64 `json:"overlap_hours" jsonschema:"Length of the overlap in hours"`
+	Warning       string  `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeArithmeticResult represents the outcome of a time arithmetic expression
+type TimeArithmeticResult struct {
+	ResultTime       string `json:"result_time" jsonschema:"The computed time in RFC3339"`
+	UnixTimestamp    int64  `json:"unix_timestamp" jsonschema:"The computed time as a Unix timestamp in seconds"`
+	ParsedExpression string `json:"parsed_expression" jsonschema:"Normalized form of the expression showing what was computed"`
+	Timezone         string `json:"timezone" jsonschema:"The timezone used for evaluation"`
+	Warning          string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// DSTSafeAddResult represents a timestamp moved on the wall clock of its timezone
+type DSTSafeAddResult struct {
+	StartTime             string  `json:"start_time" jsonschema:"The starting time in RFC3339"`
+	ResultTime            string  `json:"result_time" jsonschema:"The computed time in RFC3339"`
+	WallClockHoursElapsed float64 `json:"wall_clock_hours_elapsed" jsonschema:"Actual hours elapsed between start_time and result_time, e.g. 23 for one day across a spring-forward change"`
+	DSTChangeApplied      bool    `json:"dst_change_applied" jsonschema:"Whether the UTC offset differs between start_time and result_time"`
+	OffsetChange          string  `json:"offset_change" jsonschema:"UTC offset of result_time minus that of start_time, e.g. '+01:00'"`
+	OffsetChangeSeconds   int     `json:"offset_change_seconds" jsonschema:"offset_change in seconds"`
+	Timezone              string  `json:"timezone" jsonschema:"The timezone the units were added in"`
+	Warning               string  `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeExpressionResult represents the outcome of a time DSL query
+type TimeExpressionResult struct {
+	ResultTime           string `json:"result_time" jsonschema:"The computed time in RFC3339"`
+	UnixTimestamp        int64  `json:"unix_timestamp" jsonschema:"The computed time as a Unix timestamp in seconds"`
+	NormalizedExpression string `json:"normalized_expression" jsonschema:"The query in canonical form, showing how it was understood"`
+	Timezone             string `json:"timezone" jsonschema:"The timezone used for evaluation"`
+	Warning              string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// HistogramBucket is a single time bucket and the number of timestamps falling in it
+type HistogramBucket struct {
+	Start       string `json:"start" jsonschema:"Inclusive start of the bucket"`
+	End         string `json:"end" jsonschema:"Exclusive end of the bucket"`
+	Count       int    `json:"count" jsonschema:"Number of timestamps in the bucket"`
+	BucketLabel string `json:"bucket_label" jsonschema:"Short label for the bucket, e.g. '2024-03-15', '2024-W11' or '2024-03'"`
+}
+
+// TimeHistogramResult represents timestamps binned into contiguous time buckets
+type TimeHistogramResult struct {
+	BucketSize string            `json:"bucket_size" jsonschema:"The bucket width used"`
+	Timezone   string            `json:"timezone" jsonschema:"The timezone bucket boundaries are aligned to"`
+	Total      int               `json:"total" jsonschema:"Total number of timestamps binned"`
+	Buckets    []HistogramBucket `json:"buckets" jsonschema:"Buckets from the earliest to the latest timestamp, including empty ones"`
+	Warning    string            `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeGridResult represents the time in several timezones at regular intervals
+type TimeGridResult struct {
+	Headers  []string      `json:"headers" jsonschema:"Timezone names, in the order of each row's cells"`
+	Interval string        `json:"interval" jsonschema:"The interval between rows"`
+	Format   string        `json:"format" jsonschema:"The format of the cells"`
+	Rows     []TimeGridRow `json:"rows" jsonschema:"One row per interval from start_time to end_time"`
+	Warning  string        `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeGridRow is one instant of a time grid
+type TimeGridRow struct {
+	UTCTime string   `json:"utc_time" jsonschema:"The instant in UTC (RFC3339)"`
+	Cells   []string `json:"cells" jsonschema:"The instant in each timezone, in header order"`
+}
+
+// CompareTimestampsResult represents the ordering of two timestamps
+type CompareTimestampsResult struct {
+	Comparison        string `json:"comparison" jsonschema:"Whether time_a is 'before', 'equal' to or 'after' time_b"`
+	DifferenceSeconds int64  `json:"difference_seconds" jsonschema:"time_b minus time_a in whole seconds (positive when time_a is before time_b)"`
+	TimeAUTC          string `json:"time_a_utc" jsonschema:"time_a in UTC (RFC3339)"`
+	TimeBUTC          string `json:"time_b_utc" jsonschema:"time_b in UTC (RFC3339)"`
+}
+
+// ClockSkewCheckResult represents the difference between a client's clock and the server's
+type ClockSkewCheckResult struct {
+	SkewSeconds              float64 `json:"skew_seconds" jsonschema:"Client time minus server time in seconds (positive when the client is ahead)"`
+	IsAcceptable             bool    `json:"is_acceptable" jsonschema:"Whether the absolute skew is within max_acceptable_skew_seconds"`
+	MaxAcceptableSkewSeconds int     `json:"max_acceptable_skew_seconds" jsonschema:"The skew threshold applied"`
+	ServerTime               string  `json:"server_time" jsonschema:"Server time when the check ran (RFC3339, UTC)"`
+	ClientTimeUTC            string  `json:"client_time_utc" jsonschema:"The client time in UTC (RFC3339)"`
+}
+
+// NTPResponse is the answer of one NTP server
+type NTPResponse struct {
+	Server   string  `json:"server" jsonschema:"The queried server"`
+	OffsetMs float64 `json:"offset_ms" jsonschema:"Offset of the local clock from the server in milliseconds (positive when the local clock is behind)"`
+	RTTMs    float64 `json:"rtt_ms" jsonschema:"Round-trip delay of the query in milliseconds"`
+	Stratum  int     `json:"stratum" jsonschema:"Stratum of the server: 1 for a server attached to a reference clock, higher further away"`
+	Error    string  `json:"error,omitempty" jsonschema:"Why the server could not be used, when the query failed"`
+}
+
+// NTPSyncCheckResult represents how several NTP servers agree about the current time
+type NTPSyncCheckResult struct {
+	Responses         []NTPResponse `json:"responses" jsonschema:"One response per server, in input order"`
+	ConsensusOffsetMs float64       `json:"consensus_offset_ms" jsonschema:"Median offset of the local clock across the servers that answered, in milliseconds"`
+	MaxDisagreementMs float64       `json:"max_disagreement_ms" jsonschema:"Spread between the largest and smallest offsets in milliseconds"`
+	IsSynchronized    bool          `json:"is_synchronized" jsonschema:"Whether at least one server answered and all answering servers agree within 100ms"`
+}
+
+// TimeSeriesStatsResult represents descriptive statistics of a list of timestamps
+type TimeSeriesStatsResult struct {
+	Count            int               `json:"count" jsonschema:"Number of timestamps"`
+	Min              string            `json:"min" jsonschema:"Earliest timestamp (RFC3339)"`
+	Max              string            `json:"max" jsonschema:"Latest timestamp (RFC3339)"`
+	Mean             string            `json:"mean" jsonschema:"Average timestamp (RFC3339, millisecond precision)"`
+	Median           string            `json:"median" jsonschema:"Median timestamp (RFC3339)"`
+	Mode             string            `json:"mode,omitempty" jsonschema:"Most frequent timestamp, the earliest on ties (absent when every timestamp is distinct)"`
+	StdDevSeconds    float64           `json:"std_dev_seconds" jsonschema:"Population standard deviation in seconds"`
+	PercentileValues map[string]string `json:"percentile_values" jsonschema:"Timestamp at each requested percentile keyed as 'p90' (RFC3339, linear interpolation)"`
+	Warning          string            `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimeSeriesForecastResult represents the next timestamps of an evenly spaced series
+type TimeSeriesForecastResult struct {
+	IntervalSeconds float64  `json:"interval_seconds" jsonschema:"Average interval between consecutive timestamps in seconds"`
+	Confidence      float64  `json:"confidence" jsonschema:"1 for a perfectly regular series, falling to 0 as the most deviant interval approaches 5% off the average"`
+	Forecasted      []string `json:"forecasted" jsonschema:"The projected timestamps in the requested format, earliest first"`
+	Timezone        string   `json:"timezone" jsonschema:"The timezone used for interpreting and reporting times"`
+	Format          string   `json:"format" jsonschema:"The format of the forecasted timestamps"`
+	Warning         string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// FormatSample is a timestamp rendered in one format
+type FormatSample struct {
+	FormatName  string `json:"format_name" jsonschema:"Built-in format name, or a strftime pattern"`
+	Formatted   string `json:"formatted" jsonschema:"The timestamp in this format"`
+	Description string `json:"description" jsonschema:"Human readable explanation of the format"`
+}
+
+// TimeFormatPreviewResult represents a timestamp rendered in many formats
+type TimeFormatPreviewResult struct {
+	Timezone      string         `json:"timezone" jsonschema:"The timezone the samples are rendered in"`
+	UnixTimestamp int64          `json:"unix_timestamp" jsonschema:"The previewed moment as a Unix timestamp in seconds"`
+	Samples       []FormatSample `json:"samples" jsonschema:"Built-in formats followed by common strftime patterns"`
+	Warning       string         `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// TimezoneHistoryInput represents input for looking up the naming history of a timezone
+type TimezoneHistoryInput struct {
+	Timezone string `json:"timezone" jsonschema:"IANA timezone name, current or deprecated (e.g., 'Asia/Calcutta', 'Europe/Kyiv')"`
+}
+
+// TimezoneHistoryResult represents the current name, deprecated aliases and country changes of a timezone
+type TimezoneHistoryResult struct {
+	Timezone       string   `json:"timezone" jsonschema:"The requested timezone"`
+	CanonicalName  string   `json:"canonical_name" jsonschema:"The current IANA name of the timezone"`
+	IsLegacyName   bool     `json:"is_legacy_name" jsonschema:"Whether the requested name is a deprecated alias of canonical_name"`
+	LegacyNames    []string `json:"legacy_names" jsonschema:"Deprecated names that link to canonical_name, sorted"`
+	CountryChanges []string `json:"country_changes" jsonschema:"ISO 3166 country code changes of the zone, e.g. 'SU → UA (1991): dissolution of the Soviet Union'"`
+	TzdataVersion  string   `json:"tzdata_version" jsonschema:"Version of the timezone database in use, e.g. '2025b'"`
+}
+
+// WeeklyReportInput represents input for summarizing an ISO 8601 week
+type WeeklyReportInput struct {
+	WeekStart string `json:"week_start,omitempty" jsonschema:"Any date of the week (YYYY-MM-DD); weeks start on Monday. Cannot be combined with year and week. Defaults to the current week"`
+	Year      int    `json:"year,omitempty" jsonschema:"ISO 8601 week-numbering year, used with week"`
+	Week      int    `json:"week,omitempty" jsonschema:"ISO 8601 week number (1-53), used with year"`
+	Timezone  string `json:"timezone,omitempty" jsonschema:"IANA timezone name the week is observed in, used for the current week and DST changes. Defaults to the configured default timezone"`
+	Country   string `json:"country,omitempty" jsonschema:"Country code whose public holidays are listed (AU, BR, CA, CN, DE, FR, IN, JP, UK or US)"`
+}
+
+// WeeklyReportResult represents the summary of an ISO 8601 week
+type WeeklyReportResult struct {
+	WeekStart        string   `json:"week_start" jsonschema:"Monday of the week (YYYY-MM-DD)"`
+	WeekEnd          string   `json:"week_end" jsonschema:"Sunday of the week (YYYY-MM-DD)"`
+	ISOWeek          int      `json:"iso_week" jsonschema:"ISO 8601 week number"`
+	Year             int      `json:"year" jsonschema:"ISO 8601 week-numbering year, the year of the week's Thursday"`
+	Quarter          int      `json:"quarter" jsonschema:"Calendar quarter of the week's Thursday, 1-4"`
+	DaysInWeek       []string `json:"days_in_week" jsonschema:"The seven dates of the week, Monday first (YYYY-MM-DD)"`
+	WeekHasDSTChange bool     `json:"week_has_dst_change" jsonschema:"Whether the UTC offset of the timezone changes during the week"`
+	Holidays         []string `json:"holidays,omitempty" jsonschema:"Public holidays of the country during the week as 'YYYY-MM-DD Name' (when country is set)"`
+	Timezone         string   `json:"timezone" jsonschema:"The timezone the week was evaluated in"`
+	Warning          string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone, or when the holiday data does not cover the week"`
+}
+
+// LastDayOfMonthInput represents input for finding the last day of a month
+type LastDayOfMonthInput struct {
+	Year     int    `json:"year,omitempty" jsonschema:"Year (1-9999). Defaults to the current year in the timezone"`
+	Month    int    `json:"month,omitempty" jsonschema:"Month (1-12). Defaults to the current month in the timezone"`
+	Timezone string `json:"timezone,omitempty" jsonschema:"IANA timezone deciding the current year and month. Defaults to the configured default timezone"`
+}
+
+// LastDayOfMonthResult represents the last day of a month
+type LastDayOfMonthResult struct {
+	Date        string `json:"date" jsonschema:"The last day of the month (YYYY-MM-DD)"`
+	DayOfWeek   string `json:"day_of_week" jsonschema:"Weekday of the last day, e.g. 'Thursday'"`
+	DayNumber   int    `json:"day_number" jsonschema:"Day of the month of the last day, 28-31"`
+	DaysInMonth int    `json:"days_in_month" jsonschema:"Number of days in the month"`
+	Timezone    string `json:"timezone" jsonschema:"The timezone used for the current year and month"`
+	Warning     string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// QuarterDatesInput represents input for finding the dates of a calendar or fiscal quarter
+type QuarterDatesInput struct {
+	Year                 int    `json:"year,omitempty" jsonschema:"Fiscal year, named after the calendar year it starts in (the calendar year when fiscal_year_start_month is 1). Defaults to the current fiscal year"`
+	Quarter              int    `json:"quarter,omitempty" jsonschema:"Quarter of the fiscal year (1-4). Defaults to the current quarter"`
+	FiscalYearStartMonth int    `json:"fiscal_year_start_month,omitempty" jsonschema:"Month the fiscal year starts in (1-12), e.g. 4 for April. Defaults to 1, the calendar year"`
+	Timezone             string `json:"timezone,omitempty" jsonschema:"IANA timezone deciding the current quarter. Defaults to the configured default timezone"`
+}
+
+// QuarterDatesResult represents the dates of a calendar or fiscal quarter
+type QuarterDatesResult struct {
+	Year                 int    `json:"year" jsonschema:"The fiscal year of the quarter"`
+	Quarter              int    `json:"quarter" jsonschema:"The quarter of the fiscal year, 1-4"`
+	FiscalYearStartMonth int    `json:"fiscal_year_start_month" jsonschema:"The month the fiscal year starts in"`
+	QuarterStart         string `json:"quarter_start" jsonschema:"First day of the quarter (YYYY-MM-DD)"`
+	QuarterEnd           string `json:"quarter_end" jsonschema:"Last day of the quarter (YYYY-MM-DD)"`
+	MonthCount           int    `json:"month_count" jsonschema:"Number of months in the quarter, always 3"`
+	WeekCount            int    `json:"week_count" jsonschema:"Number of Monday-to-Sunday weeks the quarter touches, counting partial weeks"`
+	DayCount             int    `json:"day_count" jsonschema:"Number of days in the quarter"`
+	IsCurrentQuarter     bool   `json:"is_current_quarter" jsonschema:"Whether today, in the timezone, falls in the quarter"`
+	Timezone             string `json:"timezone" jsonschema:"The timezone used for the current quarter"`
+	Warning              string `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
+}
+
+// DateAddBusinessDaysInput represents input for moving a date by a number of business days
+type DateAddBusinessDaysInput struct {
+	StartDate    string `json:"start_date,omitempty" jsonschema:"Date to count from (YYYY-MM-DD, or any time whose date in the timezone is used). Defaults to today"`
+	BusinessDays int    `json:"business_days" jsonschema:"Business days to add, negative to go backward, at most 10000 either way"`
+	Country      string `json:"country,omitempty" jsonschema:"Country code whose public holidays are skipped as well as weekends (AU, BR, CA, CN, DE, FR, IN, JP, UK or US). Only weekends are skipped when unset"`
+	Timezone     string `json:"timezone,omitempty" jsonschema:"IANA timezone deciding today and the date of start_date. Defaults to the configured default timezone"`
+}
+
+// DateAddBusinessDaysResult represents a date moved by a number of business days
+type DateAddBusinessDaysResult struct {
+	StartDate           string   `json:"start_date" jsonschema:"The date counted from (YYYY-MM-DD)"`
+	ResultDate          string   `json:"result_date" jsonschema:"The date reached (YYYY-MM-DD)"`
+	ResultWeekday       string   `json:"result_weekday" jsonschema:"Weekday of result_date, e.g. 'Monday'"`
+	SkippedWeekends     int      `json:"skipped_weekends" jsonschema:"Number of Saturdays and Sundays passed over"`
+	SkippedHolidays     []string `json:"skipped_holidays" jsonschema:"Weekday public holidays passed over as 'YYYY-MM-DD Name' (when country is set)"`
+	CalendarDaysElapsed int      `json:"calendar_days_elapsed" jsonschema:"Calendar days between start_date and result_date, negative when going backward"`
+	Timezone            string   `json:"timezone" jsonschema:"The timezone used for today and start_date"`
+	Warning             string   `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone, or when the holiday data does not cover the years passed over"`
+}
+
+// MeetingLocalTime is a meeting slot in one participant's timezone
+type MeetingLocalTime struct {
+	Timezone        string `json:"timezone" jsonschema:"The participant's timezone"`
+	Start           string `json:"start" jsonschema:"Start of the meeting in the participant's timezone (RFC3339)"`
+	End             string `json:"end" jsonschema:"End of the meeting in the participant's timezone (RFC3339)"`
+	WithinWorkHours bool   `json:"within_work_hours" jsonschema:"Whether the whole meeting falls within the participant's working hours"`
+}
+
+// MeetingSuggestion is a candidate meeting slot and how well it suits the participants
+type MeetingSuggestion struct {
+	StartUTC              string             `json:"start_utc" jsonschema:"Start of the meeting in UTC (RFC3339)"`
+	EndUTC                string             `json:"end_utc" jsonschema:"End of the meeting in UTC (RFC3339)"`
+	CoverageScore         float64            `json:"coverage_score" jsonschema:"Share of the participants' total weight within working hours, from 0 to 1"`
+	AvailableParticipants int                `json:"available_participants" jsonschema:"Number of participants within working hours"`
+	LocalTimes            []MeetingLocalTime `json:"local_times" jsonschema:"The slot in each participant's timezone, in input order"`
+}
+
+// OptimalMeetingTimeResult represents the best meeting slots found for a group of participants
+type OptimalMeetingTimeResult struct {
+	Suggestions     []MeetingSuggestion `json:"suggestions" jsonschema:"Non-overlapping slots ranked by coverage score, earliest first among equal scores, at most 5"`
+	ReferenceDate   string              `json:"reference_date" jsonschema:"The first day searched"`
+	DurationMinutes int                 `json:"duration_minutes" jsonschema:"The meeting length in minutes"`
+	SearchDays      int                 `json:"search_days" jsonschema:"The number of days searched"`
+	Warning         string              `json:"warning,omitempty" jsonschema:"Set when an invalid timezone was replaced by the configured fallback timezone"`
 }
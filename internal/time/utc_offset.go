@@ -0,0 +1,63 @@
+package time
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidUTCOffset is returned for offset-style timezones such as "+25:00" that are malformed
+// or outside the offsets in use on Earth
+var ErrInvalidUTCOffset = errors.New("invalid_utc_offset")
+
+// Offsets in use range from UTC-12:00 (Baker Island) to UTC+14:00 (Pacific/Kiritimati)
+const (
+	minUTCOffsetSeconds = -12 * 3600
+	maxUTCOffsetSeconds = 14 * 3600
+)
+
+// utcOffsetPattern matches "+05:30", "+0530" and "+5" style offsets
+var utcOffsetPattern = regexp.MustCompile(`^[+-](\d{1,2})(?::?(\d{2}))?$`)
+
+// isUTCOffset reports whether a timezone is written as an offset rather than a zone name
+func isUTCOffset(timezone string) bool {
+	return strings.HasPrefix(timezone, "+") || strings.HasPrefix(timezone, "-")
+}
+
+// parseUTCOffset converts an offset-style timezone into a fixed zone named by its normalized
+// offset, e.g. "+0530" becomes "+05:30"
+func parseUTCOffset(timezone string) (*time.Location, error) {
+	match := utcOffsetPattern.FindStringSubmatch(timezone)
+	if match == nil {
+		return nil, timezoneErrorf(CodeInvalidUTCOffset, "%w: %q is not a UTC offset, expected ±HH:MM between -12:00 and +14:00", ErrInvalidUTCOffset, timezone)
+	}
+
+	hours, _ := strconv.Atoi(match[1])
+	minutes := 0
+	if match[2] != "" {
+		minutes, _ = strconv.Atoi(match[2])
+	}
+	if minutes >= 60 {
+		return nil, timezoneErrorf(CodeInvalidUTCOffset, "%w: %q has %d minutes, expected ±HH:MM between -12:00 and +14:00", ErrInvalidUTCOffset, timezone, minutes)
+	}
+
+	offset := hours*3600 + minutes*60
+	if timezone[0] == '-' {
+		offset = -offset
+	}
+	if offset < minUTCOffsetSeconds || offset > maxUTCOffsetSeconds {
+		return nil, timezoneErrorf(CodeInvalidUTCOffset, "%w: %s is outside the valid range -12:00 to +14:00 (Pacific/Kiritimati)", ErrInvalidUTCOffset, timezone)
+	}
+
+	return time.FixedZone(formatOffset(offset), offset), nil
+}
+
+// loadZone loads an IANA timezone or, for offset-style timezones, a fixed zone with that offset
+func loadZone(timezone string) (*time.Location, error) {
+	if isUTCOffset(timezone) {
+		return parseUTCOffset(timezone)
+	}
+	return time.LoadLocation(timezone)
+}
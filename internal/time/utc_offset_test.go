@@ -0,0 +1,72 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_parseUTCOffset(t *testing.T) {
+	tests := []struct {
+		name           string
+		timezone       string
+		expectedName   string
+		expectedOffset int
+		expectError    bool
+	}{
+		{name: "hours and minutes", timezone: "+05:30", expectedName: "+05:30", expectedOffset: 19800},
+		{name: "without colon", timezone: "-0345", expectedName: "-03:45", expectedOffset: -13500},
+		{name: "hours only", timezone: "+9", expectedName: "+09:00", expectedOffset: 32400},
+		{name: "westernmost offset", timezone: "-12:00", expectedName: "-12:00", expectedOffset: -43200},
+		{name: "easternmost offset", timezone: "+14:00", expectedName: "+14:00", expectedOffset: 50400},
+		{name: "too far east", timezone: "+25:00", expectError: true},
+		{name: "too far west", timezone: "-13:00", expectError: true},
+		{name: "just past the maximum", timezone: "+14:01", expectError: true},
+		{name: "minutes out of range", timezone: "+05:75", expectError: true},
+		{name: "malformed", timezone: "+five", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := parseUTCOffset(tt.timezone)
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrInvalidUTCOffset)
+				return
+			}
+
+			require.NoError(t, err)
+			name, offset := time.Date(2024, 1, 1, 0, 0, 0, 0, loc).Zone()
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedOffset, offset)
+		})
+	}
+}
+
+func TestTimeService_UTCOffsetTimezones(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}))
+
+	result, err := service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "+05:30"})
+	require.NoError(t, err)
+	assert.Equal(t, "2024-03-15T17:30:00+05:30", result.FormattedTime)
+
+	_, err = service.GetCurrentTime(context.Background(), GetTimeInput{Timezone: "+25:00"})
+	assert.ErrorIs(t, err, ErrInvalidUTCOffset)
+	assert.Contains(t, err.Error(), "-12:00 to +14:00")
+
+	// The fallback timezone covers unknown zone names, not offsets that cannot exist
+	fallbackService := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: now}), WithTimezoneFallback("UTC"))
+	_, err = fallbackService.FormatTime(context.Background(), FormatTimeInput{Timezone: "-13:00"})
+	assert.ErrorIs(t, err, ErrInvalidUTCOffset)
+
+	info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "-03:00"})
+	require.NoError(t, err)
+	assert.Equal(t, -10800, info.OffsetSeconds)
+	assert.False(t, info.IsDST)
+}
@@ -0,0 +1,147 @@
+package time
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/time/holidays"
+)
+
+// SummarizeWeek describes an ISO 8601 week: its dates, number, quarter, DST changes and, for a
+// country, its public holidays
+func (s *timeService) SummarizeWeek(ctx context.Context, input WeeklyReportInput) (WeeklyReportResult, error) {
+	if err := ctx.Err(); err != nil {
+		return WeeklyReportResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return WeeklyReportResult{}, err
+	}
+
+	monday, err := s.resolveWeekStart(input, loc)
+	if err != nil {
+		return WeeklyReportResult{}, err
+	}
+
+	// ISO weeks belong to the year, and here the quarter, of their Thursday
+	thursday := monday.AddDate(0, 0, 3)
+	year, week := thursday.ISOWeek()
+
+	days := make([]string, 0, 7)
+	hasDSTChange := false
+	_, startOffset := monday.Zone()
+	for i := 0; i <= 7; i++ {
+		day := monday.AddDate(0, 0, i)
+		if _, offset := day.Zone(); offset != startOffset {
+			hasDSTChange = true
+		}
+		if i < 7 {
+			days = append(days, day.Format("2006-01-02"))
+		}
+	}
+
+	result := WeeklyReportResult{
+		WeekStart:        days[0],
+		WeekEnd:          days[6],
+		ISOWeek:          week,
+		Year:             year,
+		Quarter:          (int(thursday.Month())-1)/3 + 1,
+		DaysInWeek:       days,
+		WeekHasDSTChange: hasDSTChange,
+		Timezone:         loc.String(),
+	}
+
+	if input.Country != "" {
+		weekHolidays, holidayWarning, err := holidaysInWeek(input.Country, monday)
+		if err != nil {
+			return WeeklyReportResult{}, err
+		}
+		result.Holidays = weekHolidays
+		warning = joinWarnings(warning, holidayWarning)
+	}
+	result.Warning = warning
+
+	s.logger.Debug("Summarized week",
+		zap.String("week_start", result.WeekStart),
+		zap.Int("iso_week", week),
+		zap.Int("year", year),
+		zap.String("timezone", result.Timezone))
+
+	return result, nil
+}
+
+// resolveWeekStart returns midnight of the Monday starting the requested week, defaulting to the
+// current week
+func (s *timeService) resolveWeekStart(input WeeklyReportInput, loc *time.Location) (time.Time, error) {
+	hasWeekNumber := input.Year != 0 || input.Week != 0
+	switch {
+	case input.WeekStart != "" && hasWeekNumber:
+		return time.Time{}, validationErrorf(CodeConflictingArguments, "week_start cannot be combined with year and week")
+	case input.WeekStart != "":
+		date, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(input.WeekStart), loc)
+		if err != nil {
+			return time.Time{}, parseErrorf(CodeInvalidTimestamp, "invalid week_start %s: expected YYYY-MM-DD", input.WeekStart)
+		}
+		return mondayOf(date), nil
+	case hasWeekNumber:
+		if input.Year < 1 || input.Year > 9999 {
+			return time.Time{}, validationErrorf(CodeInvalidArgument, "year must be between 1 and 9999, got %d", input.Year)
+		}
+		// January 4 is always in week 1, and December 28 in the last week of the year
+		weeks := isoWeeksIn(input.Year)
+		if input.Week < 1 || input.Week > weeks {
+			return time.Time{}, validationErrorf(CodeInvalidArgument, "week must be between 1 and %d for %d, got %d", weeks, input.Year, input.Week)
+		}
+		firstMonday := mondayOf(time.Date(input.Year, time.January, 4, 0, 0, 0, 0, loc))
+		return firstMonday.AddDate(0, 0, (input.Week-1)*7), nil
+	default:
+		return mondayOf(s.clock.Now().In(loc)), nil
+	}
+}
+
+// mondayOf returns midnight of the Monday of t's ISO week, in t's location
+func mondayOf(t time.Time) time.Time {
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+}
+
+// isoWeeksIn returns the number of ISO weeks in a year, 52 or 53
+func isoWeeksIn(year int) int {
+	_, weeks := time.Date(year, time.December, 28, 0, 0, 0, 0, time.UTC).ISOWeek()
+	return weeks
+}
+
+// holidaysInWeek lists the public holidays of a country in the week starting on monday as
+// "YYYY-MM-DD Name". Weeks outside the years the holiday data covers yield a warning instead.
+func holidaysInWeek(country string, monday time.Time) ([]string, string, error) {
+	weekHolidays := []string{}
+	var skipped []string
+	for i := 0; i < 7; i++ {
+		day := monday.AddDate(0, 0, i)
+		isHoliday, name, err := holidays.IsHoliday(country, day)
+		switch {
+		case errors.Is(err, holidays.ErrYearNotCovered):
+			skipped = append(skipped, day.Format("2006-01-02"))
+		case err != nil:
+			return nil, "", validationErrorf(CodeInvalidArgument, "%w", err)
+		case isHoliday:
+			weekHolidays = append(weekHolidays, day.Format("2006-01-02")+" "+name)
+		}
+	}
+
+	if len(skipped) == 0 {
+		return weekHolidays, "", nil
+	}
+	first, last := holidays.Default().Years()
+	return weekHolidays, fmt.Sprintf("holidays not checked for %s: holiday data covers %d-%d", strings.Join(skipped, ", "), first, last), nil
+}
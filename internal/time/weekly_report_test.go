@@ -0,0 +1,118 @@
+package time
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_SummarizeWeek(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t),
+		WithClock(FixedClock{Time: time.Date(2024, 7, 4, 12, 0, 0, 0, time.UTC)}))
+
+	tests := []struct {
+		name              string
+		input             WeeklyReportInput
+		expectedStart     string
+		expectedEnd       string
+		expectedWeek      int
+		expectedYear      int
+		expectedQuarter   int
+		expectedDSTChange bool
+		expectedHolidays  []string
+		expectedWarning   string
+		expectError       bool
+	}{
+		{
+			name:            "current week",
+			input:           WeeklyReportInput{},
+			expectedStart:   "2024-07-01",
+			expectedEnd:     "2024-07-07",
+			expectedWeek:    27,
+			expectedYear:    2024,
+			expectedQuarter: 3,
+		},
+		{
+			name:             "any date snaps to monday with holidays",
+			input:            WeeklyReportInput{WeekStart: "2024-07-06", Country: "US"},
+			expectedStart:    "2024-07-01",
+			expectedEnd:      "2024-07-07",
+			expectedWeek:     27,
+			expectedYear:     2024,
+			expectedQuarter:  3,
+			expectedHolidays: []string{"2024-07-04 Independence Day"},
+		},
+		{
+			name:              "DST change in Europe",
+			input:             WeeklyReportInput{Year: 2024, Week: 13, Timezone: "Europe/Berlin", Country: "de"},
+			expectedStart:     "2024-03-25",
+			expectedEnd:       "2024-03-31",
+			expectedWeek:      13,
+			expectedYear:      2024,
+			expectedQuarter:   1,
+			expectedDSTChange: true,
+			expectedHolidays:  []string{"2024-03-29 Karfreitag (Good Friday)"},
+		},
+		{
+			name:            "week spanning the new year belongs to the year of its thursday",
+			input:           WeeklyReportInput{WeekStart: "2024-12-31"},
+			expectedStart:   "2024-12-30",
+			expectedEnd:     "2025-01-05",
+			expectedWeek:    1,
+			expectedYear:    2025,
+			expectedQuarter: 1,
+		},
+		{
+			name:            "53rd week",
+			input:           WeeklyReportInput{Year: 2020, Week: 53},
+			expectedStart:   "2020-12-28",
+			expectedEnd:     "2021-01-03",
+			expectedWeek:    53,
+			expectedYear:    2020,
+			expectedQuarter: 4,
+		},
+		{
+			name:             "year outside the holiday data",
+			input:            WeeklyReportInput{Year: 2035, Week: 10, Country: "FR"},
+			expectedStart:    "2035-03-05",
+			expectedEnd:      "2035-03-11",
+			expectedWeek:     10,
+			expectedYear:     2035,
+			expectedQuarter:  1,
+			expectedHolidays: []string{},
+			expectedWarning:  "holidays not checked for 2035-03-05, 2035-03-06, 2035-03-07, 2035-03-08, 2035-03-09, 2035-03-10, 2035-03-11: holiday data covers 2020-2030",
+		},
+		{name: "no 53rd week", input: WeeklyReportInput{Year: 2024, Week: 53}, expectError: true},
+		{name: "week without year", input: WeeklyReportInput{Week: 10}, expectError: true},
+		{name: "week_start with week number", input: WeeklyReportInput{WeekStart: "2024-07-01", Year: 2024, Week: 27}, expectError: true},
+		{name: "invalid week_start", input: WeeklyReportInput{WeekStart: "07/01/2024"}, expectError: true},
+		{name: "unknown country", input: WeeklyReportInput{Country: "XX"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.SummarizeWeek(context.Background(), tt.input)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStart, result.WeekStart)
+			assert.Equal(t, tt.expectedEnd, result.WeekEnd)
+			assert.Equal(t, tt.expectedWeek, result.ISOWeek)
+			assert.Equal(t, tt.expectedYear, result.Year)
+			assert.Equal(t, tt.expectedQuarter, result.Quarter)
+			assert.Equal(t, tt.expectedDSTChange, result.WeekHasDSTChange)
+			assert.Equal(t, tt.expectedHolidays, result.Holidays)
+			assert.Equal(t, tt.expectedWarning, result.Warning)
+			require.Len(t, result.DaysInWeek, 7)
+			assert.Equal(t, tt.expectedStart, result.DaysInWeek[0])
+			assert.Equal(t, tt.expectedEnd, result.DaysInWeek[6])
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package time
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+var smallNumberWords = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+	"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen",
+	"seventeen", "eighteen", "nineteen",
+}
+
+var tensWords = []string{"", "", "twenty", "thirty", "forty", "fifty"}
+
+// minutePhrases holds the conventional names for minutes past or to the hour
+var minutePhrases = map[int]string{
+	5:  "five",
+	10: "ten",
+	15: "quarter",
+	20: "twenty",
+	25: "twenty-five",
+	30: "half",
+}
+
+// TimeInWords expresses a timestamp's time of day in natural language
+func (s *timeService) TimeInWords(ctx context.Context, input TimeInWordsInput) (TimeInWordsResult, error) {
+	if err := ctx.Err(); err != nil {
+		return TimeInWordsResult{}, err
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = s.defaultTimezone
+	}
+
+	style := input.Style
+	if style == "" {
+		style = WordsStyleFormal
+	}
+
+	s.logger.Debug("Expressing time in words",
+		zap.String("timezone", timezone),
+		zap.String("style", style))
+
+	loc, warning, err := s.loadLocation(timezone)
+	if err != nil {
+		return TimeInWordsResult{}, err
+	}
+
+	t, err := s.timestampToTime(input.Timestamp, loc)
+	if err != nil {
+		return TimeInWordsResult{}, err
+	}
+
+	var expression string
+	switch style {
+	case WordsStyleFormal:
+		expression = formalTimeInWords(t.Hour(), t.Minute())
+	case WordsStyleCasual:
+		expression = casualTimeInWords(t.Hour(), t.Minute())
+	case WordsStyle24h:
+		expression = militaryTimeInWords(t.Hour(), t.Minute())
+	default:
+		return TimeInWordsResult{}, validationErrorf(CodeInvalidArgument, "unsupported style: %s (supported: %s, %s, %s)",
+			style, WordsStyleFormal, WordsStyleCasual, WordsStyle24h)
+	}
+
+	return TimeInWordsResult{
+		Expression: expression,
+		Style:      style,
+		Timezone:   t.Location().String(),
+		Warning:    warning,
+	}, nil
+}
+
+// formalTimeInWords renders e.g. "quarter past three in the afternoon"
+func formalTimeInWords(hour, minute int) string {
+	if minute == 0 {
+		if name, ok := namedHour(hour); ok {
+			return name
+		}
+		return fmt.Sprintf("%s o'clock %s", hourWord(hour), periodOfDay(hour))
+	}
+
+	relation := "past"
+	minutes := minute
+	if minute > 30 {
+		relation = "to"
+		minutes = 60 - minute
+		hour = (hour + 1) % 24
+	}
+
+	phrase, ok := minutePhrases[minutes]
+	if !ok {
+		phrase = numberWord(minutes) + " minutes"
+		if minutes == 1 {
+			phrase = "one minute"
+		}
+	}
+
+	if name, ok := namedHour(hour); ok {
+		return fmt.Sprintf("%s %s %s", phrase, relation, name)
+	}
+
+	return fmt.Sprintf("%s %s %s %s", phrase, relation, hourWord(hour), periodOfDay(hour))
+}
+
+// casualTimeInWords rounds to the nearest quarter hour, e.g. "around 3 PM"
+func casualTimeInWords(hour, minute int) string {
+	rounded := (hour*60 + minute + 7) / 15 * 15 % (24 * 60)
+	hour, minute = rounded/60, rounded%60
+
+	if minute == 0 {
+		if name, ok := namedHour(hour); ok {
+			return "around " + name
+		}
+	}
+
+	suffix := "AM"
+	if hour >= 12 {
+		suffix = "PM"
+	}
+
+	if minute == 0 {
+		return fmt.Sprintf("around %d %s", twelveHour(hour), suffix)
+	}
+
+	return fmt.Sprintf("around %d:%02d %s", twelveHour(hour), minute, suffix)
+}
+
+// militaryTimeInWords renders e.g. "fifteen hundred hours" or "zero nine zero five hours"
+func militaryTimeInWords(hour, minute int) string {
+	hourPart := numberWord(hour)
+	if hour < 10 {
+		hourPart = "zero " + hourPart
+	}
+
+	var minutePart string
+	switch {
+	case minute == 0:
+		minutePart = "hundred"
+	case minute < 10:
+		minutePart = "zero " + numberWord(minute)
+	default:
+		minutePart = numberWord(minute)
+	}
+
+	return fmt.Sprintf("%s %s hours", hourPart, minutePart)
+}
+
+// namedHour returns the special names for hours 0 and 12
+func namedHour(hour int) (string, bool) {
+	switch hour {
+	case 0:
+		return "midnight", true
+	case 12:
+		return "noon", true
+	}
+	return "", false
+}
+
+// periodOfDay returns the phrase that disambiguates a 12-hour clock reading
+func periodOfDay(hour int) string {
+	switch {
+	case hour >= 5 && hour < 12:
+		return "in the morning"
+	case hour >= 12 && hour < 17:
+		return "in the afternoon"
+	case hour >= 17 && hour < 21:
+		return "in the evening"
+	default:
+		return "at night"
+	}
+}
+
+func twelveHour(hour int) int {
+	if hour%12 == 0 {
+		return 12
+	}
+	return hour % 12
+}
+
+func hourWord(hour int) string {
+	return numberWord(twelveHour(hour))
+}
+
+// numberWord spells out numbers from 0 to 59
+func numberWord(n int) string {
+	if n < len(smallNumberWords) {
+		return smallNumberWords[n]
+	}
+
+	if n%10 == 0 {
+		return tensWords[n/10]
+	}
+
+	return tensWords[n/10] + "-" + smallNumberWords[n%10]
+}
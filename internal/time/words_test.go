@@ -0,0 +1,109 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_TimeInWords(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	tests := []struct {
+		name     string
+		input    TimeInWordsInput
+		wantErr  bool
+		expected string
+	}{
+		{
+			name:     "formal quarter past",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01T15:15:00Z"},
+			expected: "quarter past three in the afternoon",
+		},
+		{
+			name:     "formal half past in timezone",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01T15:30:00Z", Timezone: "America/New_York"},
+			expected: "half past ten in the morning",
+		},
+		{
+			name:     "formal quarter to midnight",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 23:45", Style: WordsStyleFormal},
+			expected: "quarter to midnight",
+		},
+		{
+			name:     "formal noon",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 12:00"},
+			expected: "noon",
+		},
+		{
+			name:     "formal o'clock from unix timestamp",
+			input:    TimeInWordsInput{Timestamp: float64(1709326800)},
+			expected: "nine o'clock at night",
+		},
+		{
+			name:     "formal odd minutes",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 07:17"},
+			expected: "seventeen minutes past seven in the morning",
+		},
+		{
+			name:     "casual rounds to hour",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 15:04", Style: WordsStyleCasual},
+			expected: "around 3 PM",
+		},
+		{
+			name:     "casual rounds to quarter",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 09:41", Style: WordsStyleCasual},
+			expected: "around 9:45 AM",
+		},
+		{
+			name:     "casual midnight",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 23:55", Style: WordsStyleCasual},
+			expected: "around midnight",
+		},
+		{
+			name:     "24h on the hour",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 15:00", Style: WordsStyle24h},
+			expected: "fifteen hundred hours",
+		},
+		{
+			name:     "24h early morning",
+			input:    TimeInWordsInput{Timestamp: "2024-03-01 09:05", Style: WordsStyle24h},
+			expected: "zero nine zero five hours",
+		},
+		{
+			name:    "unsupported style",
+			input:   TimeInWordsInput{Timestamp: "2024-03-01 09:05", Style: "poetic"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timestamp",
+			input:   TimeInWordsInput{Timestamp: "tomorrow-ish"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := service.TimeInWords(context.Background(), tt.input)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Expression)
+		})
+	}
+}
+
+func Test_numberWord(t *testing.T) {
+	assert.Equal(t, "zero", numberWord(0))
+	assert.Equal(t, "nineteen", numberWord(19))
+	assert.Equal(t, "forty", numberWord(40))
+	assert.Equal(t, "fifty-nine", numberWord(59))
+}
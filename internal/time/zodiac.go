@@ -0,0 +1,46 @@
+package time
+
+import (
+	"time"
+)
+
+// zodiacSign describes a Western zodiac sign and the date it starts on
+type zodiacSign struct {
+	name       string
+	element    string
+	startMonth time.Month
+	startDay   int
+}
+
+// zodiacSigns lists the Western zodiac signs ordered by start date within the calendar year.
+// Capricorn starts in December and also covers the first days of January.
+var zodiacSigns = []zodiacSign{
+	{name: "Aquarius", element: "air", startMonth: time.January, startDay: 20},
+	{name: "Pisces", element: "water", startMonth: time.February, startDay: 19},
+	{name: "Aries", element: "fire", startMonth: time.March, startDay: 21},
+	{name: "Taurus", element: "earth", startMonth: time.April, startDay: 20},
+	{name: "Gemini", element: "air", startMonth: time.May, startDay: 21},
+	{name: "Cancer", element: "water", startMonth: time.June, startDay: 21},
+	{name: "Leo", element: "fire", startMonth: time.July, startDay: 23},
+	{name: "Virgo", element: "earth", startMonth: time.August, startDay: 23},
+	{name: "Libra", element: "air", startMonth: time.September, startDay: 23},
+	{name: "Scorpio", element: "water", startMonth: time.October, startDay: 23},
+	{name: "Sagittarius", element: "fire", startMonth: time.November, startDay: 22},
+	{name: "Capricorn", element: "earth", startMonth: time.December, startDay: 22},
+}
+
+// zodiacFor returns the Western zodiac sign and element for the date of t
+func zodiacFor(t time.Time) (sign, element string) {
+	month, day := t.Month(), t.Day()
+
+	for i := len(zodiacSigns) - 1; i >= 0; i-- {
+		z := zodiacSigns[i]
+		if month > z.startMonth || (month == z.startMonth && day >= z.startDay) {
+			return z.name, z.element
+		}
+	}
+
+	// Dates before Aquarius starts belong to Capricorn from the previous year
+	capricorn := zodiacSigns[len(zodiacSigns)-1]
+	return capricorn.name, capricorn.element
+}
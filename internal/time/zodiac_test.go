@@ -0,0 +1,40 @@
+package time
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_zodiacFor(t *testing.T) {
+	tests := []struct {
+		date    string
+		sign    string
+		element string
+	}{
+		{"2024-01-01", "Capricorn", "earth"},
+		{"2024-01-19", "Capricorn", "earth"},
+		{"2024-01-20", "Aquarius", "air"},
+		{"2024-02-29", "Pisces", "water"},
+		{"2024-03-20", "Pisces", "water"},
+		{"2024-03-21", "Aries", "fire"},
+		{"2024-06-21", "Cancer", "water"},
+		{"2024-08-22", "Leo", "fire"},
+		{"2024-11-22", "Sagittarius", "fire"},
+		{"2024-12-21", "Sagittarius", "fire"},
+		{"2024-12-22", "Capricorn", "earth"},
+		{"2024-12-31", "Capricorn", "earth"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			date, err := time.Parse("2006-01-02", tt.date)
+			assert.NoError(t, err)
+
+			sign, element := zodiacFor(date)
+			assert.Equal(t, tt.sign, sign)
+			assert.Equal(t, tt.element, element)
+		})
+	}
+}
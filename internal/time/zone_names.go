@@ -0,0 +1,286 @@
+package time
+
+import (
+	"fmt"
+	"strings"
+)
+
+// zoneNameLanguages are the languages with translated timezone display names
+var zoneNameLanguages = map[string]bool{"en": true, "fr": true, "de": true, "es": true, "pt": true, "ja": true}
+
+// metazoneNames holds the generic display names of CLDR metazones (metaZones.xml), which group
+// the zones that have shared the same offset and DST rules, keyed by metazone then language
+var metazoneNames = map[string]map[string]string{
+	"UTC": {
+		"en": "Coordinated Universal Time", "fr": "Temps universel coordonné", "de": "Koordinierte Weltzeit",
+		"es": "Tiempo universal coordinado", "pt": "Tempo Universal Coordenado", "ja": "協定世界時",
+	},
+	"GMT": {
+		"en": "Greenwich Mean Time", "fr": "Heure moyenne de Greenwich", "de": "Mittlere Greenwich-Zeit",
+		"es": "Hora del meridiano de Greenwich", "pt": "Horário do Meridiano de Greenwich", "ja": "グリニッジ標準時",
+	},
+	"America_Eastern": {
+		"en": "Eastern Time", "fr": "Heure de l'Est", "de": "Nordamerikanische Ostküstenzeit",
+		"es": "Hora oriental", "pt": "Horário do Leste", "ja": "アメリカ東部時間",
+	},
+	"America_Central": {
+		"en": "Central Time", "fr": "Heure du Centre", "de": "Nordamerikanische Zentralzeit",
+		"es": "Hora central", "pt": "Horário Central", "ja": "アメリカ中部時間",
+	},
+	"America_Mountain": {
+		"en": "Mountain Time", "fr": "Heure des Rocheuses", "de": "Rocky-Mountain-Zeit",
+		"es": "Hora de las Montañas Rocosas", "pt": "Horário das Montanhas", "ja": "アメリカ山地時間",
+	},
+	"America_Pacific": {
+		"en": "Pacific Time", "fr": "Heure du Pacifique", "de": "Nordamerikanische Westküstenzeit",
+		"es": "Hora del Pacífico", "pt": "Horário do Pacífico", "ja": "アメリカ太平洋時間",
+	},
+	"Alaska": {
+		"en": "Alaska Time", "fr": "Heure de l'Alaska", "de": "Alaska-Zeit",
+		"es": "Hora de Alaska", "pt": "Horário do Alasca", "ja": "アラスカ時間",
+	},
+	"Hawaii_Aleutian": {
+		"en": "Hawaii-Aleutian Time", "fr": "Heure d'Hawaï-Aléoutiennes", "de": "Hawaii-Aleuten-Zeit",
+		"es": "Hora de Hawái-Aleutianas", "pt": "Horário do Havaí e Ilhas Aleutas", "ja": "ハワイ・アリューシャン時間",
+	},
+	"Atlantic": {
+		"en": "Atlantic Time", "fr": "Heure de l'Atlantique", "de": "Atlantik-Zeit",
+		"es": "Hora del Atlántico", "pt": "Horário do Atlântico", "ja": "大西洋時間",
+	},
+	"Brasilia": {
+		"en": "Brasilia Time", "fr": "Heure de Brasilia", "de": "Brasília-Zeit",
+		"es": "Hora de Brasilia", "pt": "Horário de Brasília", "ja": "ブラジリア時間",
+	},
+	"Argentina": {
+		"en": "Argentina Time", "fr": "Heure de l'Argentine", "de": "Argentinische Zeit",
+		"es": "Hora de Argentina", "pt": "Horário da Argentina", "ja": "アルゼンチン時間",
+	},
+	"Europe_Western": {
+		"en": "Western European Time", "fr": "Heure d'Europe de l'Ouest", "de": "Westeuropäische Zeit",
+		"es": "Hora de Europa occidental", "pt": "Horário da Europa Ocidental", "ja": "西ヨーロッパ時間",
+	},
+	"Europe_Central": {
+		"en": "Central European Time", "fr": "Heure d'Europe centrale", "de": "Mitteleuropäische Zeit",
+		"es": "Hora de Europa central", "pt": "Horário da Europa Central", "ja": "中央ヨーロッパ時間",
+	},
+	"Europe_Eastern": {
+		"en": "Eastern European Time", "fr": "Heure d'Europe de l'Est", "de": "Osteuropäische Zeit",
+		"es": "Hora de Europa oriental", "pt": "Horário da Europa Oriental", "ja": "東ヨーロッパ時間",
+	},
+	"Moscow": {
+		"en": "Moscow Time", "fr": "Heure de Moscou", "de": "Moskauer Zeit",
+		"es": "Hora de Moscú", "pt": "Horário de Moscou", "ja": "モスクワ時間",
+	},
+	"Israel": {
+		"en": "Israel Time", "fr": "Heure d'Israël", "de": "Israelische Zeit",
+		"es": "Hora de Israel", "pt": "Horário de Israel", "ja": "イスラエル時間",
+	},
+	"Gulf": {
+		"en": "Gulf Standard Time", "fr": "Heure du Golfe", "de": "Golf-Zeit",
+		"es": "Hora del Golfo", "pt": "Horário do Golfo", "ja": "湾岸時間",
+	},
+	"India": {
+		"en": "India Standard Time", "fr": "Heure de l'Inde", "de": "Indische Normalzeit",
+		"es": "Hora de India", "pt": "Horário Padrão da Índia", "ja": "インド標準時",
+	},
+	"Indochina": {
+		"en": "Indochina Time", "fr": "Heure d'Indochine", "de": "Indochina-Zeit",
+		"es": "Hora de Indochina", "pt": "Horário da Indochina", "ja": "インドシナ時間",
+	},
+	"China": {
+		"en": "China Time", "fr": "Heure de la Chine", "de": "Chinesische Zeit",
+		"es": "Hora de China", "pt": "Horário da China", "ja": "中国時間",
+	},
+	"Hong_Kong": {
+		"en": "Hong Kong Time", "fr": "Heure de Hong Kong", "de": "Hongkong-Zeit",
+		"es": "Hora de Hong Kong", "pt": "Horário de Hong Kong", "ja": "香港時間",
+	},
+	"Singapore": {
+		"en": "Singapore Standard Time", "fr": "Heure de Singapour", "de": "Singapur-Zeit",
+		"es": "Hora de Singapur", "pt": "Horário Padrão de Singapura", "ja": "シンガポール標準時",
+	},
+	"Korea": {
+		"en": "Korean Time", "fr": "Heure de la Corée", "de": "Koreanische Zeit",
+		"es": "Hora de Corea", "pt": "Horário da Coreia", "ja": "韓国時間",
+	},
+	"Japan": {
+		"en": "Japan Time", "fr": "Heure du Japon", "de": "Japanische Zeit",
+		"es": "Hora de Japón", "pt": "Horário do Japão", "ja": "日本時間",
+	},
+	"Australia_Western": {
+		"en": "Western Australia Time", "fr": "Heure de l'Ouest de l'Australie", "de": "Westaustralische Zeit",
+		"es": "Hora de Australia occidental", "pt": "Horário da Austrália Ocidental", "ja": "オーストラリア西部時間",
+	},
+	"Australia_Central": {
+		"en": "Central Australia Time", "fr": "Heure du centre de l'Australie", "de": "Zentralaustralische Zeit",
+		"es": "Hora de Australia central", "pt": "Horário da Austrália Central", "ja": "オーストラリア中部時間",
+	},
+	"Australia_Eastern": {
+		"en": "Eastern Australia Time", "fr": "Heure de l'Est de l'Australie", "de": "Ostaustralische Zeit",
+		"es": "Hora de Australia oriental", "pt": "Horário da Austrália Oriental", "ja": "オーストラリア東部時間",
+	},
+	"New_Zealand": {
+		"en": "New Zealand Time", "fr": "Heure de la Nouvelle-Zélande", "de": "Neuseeland-Zeit",
+		"es": "Hora de Nueva Zelanda", "pt": "Horário da Nova Zelândia", "ja": "ニュージーランド時間",
+	},
+	"Africa_Western": {
+		"en": "West Africa Time", "fr": "Heure d'Afrique de l'Ouest", "de": "Westafrikanische Zeit",
+		"es": "Hora de África occidental", "pt": "Horário da África Ocidental", "ja": "西アフリカ時間",
+	},
+	"Africa_Central": {
+		"en": "Central Africa Time", "fr": "Heure d'Afrique centrale", "de": "Zentralafrikanische Zeit",
+		"es": "Hora de África central", "pt": "Horário da África Central", "ja": "中央アフリカ時間",
+	},
+	"Africa_Eastern": {
+		"en": "East Africa Time", "fr": "Heure d'Afrique de l'Est", "de": "Ostafrikanische Zeit",
+		"es": "Hora de África oriental", "pt": "Horário da África Oriental", "ja": "東アフリカ時間",
+	},
+	"Africa_Southern": {
+		"en": "South Africa Standard Time", "fr": "Heure normale d'Afrique méridionale", "de": "Südafrikanische Zeit",
+		"es": "Hora de Sudáfrica", "pt": "Horário da África do Sul", "ja": "南アフリカ標準時",
+	},
+}
+
+// zoneMetazones maps IANA zones to their current CLDR metazone
+var zoneMetazones = map[string]string{
+	"UTC": "UTC", "Etc/UTC": "UTC",
+
+	"Europe/London": "GMT", "Europe/Dublin": "GMT", "Atlantic/Reykjavik": "GMT", "Africa/Abidjan": "GMT",
+	"Africa/Accra": "GMT", "Africa/Dakar": "GMT",
+
+	"America/New_York": "America_Eastern", "America/Toronto": "America_Eastern",
+	"America/Detroit": "America_Eastern", "America/Indiana/Indianapolis": "America_Eastern",
+	"America/Kentucky/Louisville": "America_Eastern", "America/Nassau": "America_Eastern",
+	"America/Jamaica": "America_Eastern", "America/Panama": "America_Eastern", "America/Cancun": "America_Eastern",
+
+	"America/Chicago": "America_Central", "America/Winnipeg": "America_Central", "America/Regina": "America_Central",
+	"America/Mexico_City": "America_Central", "America/Monterrey": "America_Central",
+	"America/Merida": "America_Central", "America/Guatemala": "America_Central",
+	"America/Costa_Rica": "America_Central", "America/El_Salvador": "America_Central",
+
+	"America/Denver": "America_Mountain", "America/Phoenix": "America_Mountain",
+	"America/Edmonton": "America_Mountain", "America/Boise": "America_Mountain",
+
+	"America/Los_Angeles": "America_Pacific", "America/Vancouver": "America_Pacific", "America/Tijuana": "America_Pacific",
+
+	"America/Anchorage": "Alaska", "America/Juneau": "Alaska",
+	"Pacific/Honolulu": "Hawaii_Aleutian", "America/Adak": "Hawaii_Aleutian",
+
+	"America/Halifax": "Atlantic", "America/Puerto_Rico": "Atlantic", "America/Santo_Domingo": "Atlantic",
+	"America/Barbados": "Atlantic", "Atlantic/Bermuda": "Atlantic",
+
+	"America/Sao_Paulo": "Brasilia", "America/Bahia": "Brasilia", "America/Fortaleza": "Brasilia",
+	"America/Recife": "Brasilia", "America/Belem": "Brasilia",
+	"America/Argentina/Buenos_Aires": "Argentina", "America/Argentina/Cordoba": "Argentina",
+
+	"Europe/Lisbon": "Europe_Western", "Atlantic/Canary": "Europe_Western", "Atlantic/Madeira": "Europe_Western",
+	"Atlantic/Faroe": "Europe_Western",
+
+	"Europe/Paris": "Europe_Central", "Europe/Berlin": "Europe_Central", "Europe/Madrid": "Europe_Central",
+	"Europe/Rome": "Europe_Central", "Europe/Amsterdam": "Europe_Central", "Europe/Brussels": "Europe_Central",
+	"Europe/Vienna": "Europe_Central", "Europe/Zurich": "Europe_Central", "Europe/Stockholm": "Europe_Central",
+	"Europe/Oslo": "Europe_Central", "Europe/Copenhagen": "Europe_Central", "Europe/Warsaw": "Europe_Central",
+	"Europe/Prague": "Europe_Central", "Europe/Budapest": "Europe_Central", "Europe/Belgrade": "Europe_Central",
+	"Africa/Algiers": "Europe_Central", "Africa/Tunis": "Europe_Central",
+
+	"Europe/Athens": "Europe_Eastern", "Europe/Helsinki": "Europe_Eastern", "Europe/Kyiv": "Europe_Eastern",
+	"Europe/Kiev": "Europe_Eastern", "Europe/Bucharest": "Europe_Eastern", "Europe/Sofia": "Europe_Eastern",
+	"Europe/Riga": "Europe_Eastern", "Europe/Tallinn": "Europe_Eastern", "Europe/Vilnius": "Europe_Eastern",
+	"Africa/Cairo": "Europe_Eastern", "Asia/Beirut": "Europe_Eastern",
+
+	"Europe/Moscow": "Moscow", "Europe/Simferopol": "Moscow",
+	"Asia/Jerusalem": "Israel", "Asia/Tel_Aviv": "Israel",
+	"Asia/Dubai": "Gulf", "Asia/Muscat": "Gulf",
+	"Asia/Kolkata": "India", "Asia/Calcutta": "India",
+
+	"Asia/Bangkok": "Indochina", "Asia/Ho_Chi_Minh": "Indochina", "Asia/Phnom_Penh": "Indochina",
+	"Asia/Vientiane": "Indochina",
+	"Asia/Shanghai":  "China", "Asia/Macau": "China",
+	"Asia/Hong_Kong": "Hong_Kong", "Asia/Singapore": "Singapore",
+	"Asia/Seoul": "Korea", "Asia/Tokyo": "Japan",
+
+	"Australia/Perth":    "Australia_Western",
+	"Australia/Adelaide": "Australia_Central", "Australia/Darwin": "Australia_Central",
+	"Australia/Sydney": "Australia_Eastern", "Australia/Melbourne": "Australia_Eastern",
+	"Australia/Brisbane": "Australia_Eastern", "Australia/Hobart": "Australia_Eastern",
+	"Pacific/Auckland": "New_Zealand",
+
+	"Africa/Lagos": "Africa_Western", "Africa/Kinshasa": "Africa_Western", "Africa/Luanda": "Africa_Western",
+	"Africa/Douala": "Africa_Western",
+	"Africa/Maputo": "Africa_Central", "Africa/Harare": "Africa_Central", "Africa/Lusaka": "Africa_Central",
+	"Africa/Kigali": "Africa_Central", "Africa/Windhoek": "Africa_Central",
+	"Africa/Nairobi": "Africa_Eastern", "Africa/Addis_Ababa": "Africa_Eastern",
+	"Africa/Dar_es_Salaam": "Africa_Eastern", "Africa/Kampala": "Africa_Eastern",
+	"Africa/Johannesburg": "Africa_Southern",
+}
+
+// exemplarCities holds the CLDR exemplar city names that differ from the English name derived
+// from the zone identifier, keyed by zone then language
+var exemplarCities = map[string]map[string]string{
+	"America/New_York":    {"es": "Nueva York", "pt": "Nova York", "ja": "ニューヨーク"},
+	"America/Chicago":     {"ja": "シカゴ"},
+	"America/Denver":      {"ja": "デンバー"},
+	"America/Los_Angeles": {"ja": "ロサンゼルス"},
+	"America/Toronto":     {"ja": "トロント"},
+	"America/Mexico_City": {"fr": "Mexico", "de": "Mexiko-Stadt", "es": "Ciudad de México", "pt": "Cidade do México", "ja": "メキシコシティ"},
+	"America/Sao_Paulo": {
+		"en": "São Paulo", "fr": "São Paulo", "de": "São Paulo", "es": "São Paulo", "pt": "São Paulo", "ja": "サンパウロ",
+	},
+	"America/Argentina/Buenos_Aires": {"ja": "ブエノスアイレス"},
+	"Pacific/Honolulu":               {"ja": "ホノルル"},
+	"Europe/London":                  {"fr": "Londres", "es": "Londres", "pt": "Londres", "ja": "ロンドン"},
+	"Europe/Lisbon":                  {"fr": "Lisbonne", "de": "Lissabon", "es": "Lisboa", "pt": "Lisboa", "ja": "リスボン"},
+	"Europe/Paris":                   {"ja": "パリ"},
+	"Europe/Berlin":                  {"ja": "ベルリン"},
+	"Europe/Madrid":                  {"ja": "マドリード"},
+	"Europe/Rome":                    {"de": "Rom", "es": "Roma", "pt": "Roma", "ja": "ローマ"},
+	"Europe/Brussels":                {"fr": "Bruxelles", "de": "Brüssel", "es": "Bruselas", "pt": "Bruxelas", "ja": "ブリュッセル"},
+	"Europe/Vienna":                  {"fr": "Vienne", "de": "Wien", "es": "Viena", "pt": "Viena", "ja": "ウィーン"},
+	"Europe/Warsaw":                  {"fr": "Varsovie", "de": "Warschau", "es": "Varsovia", "pt": "Varsóvia", "ja": "ワルシャワ"},
+	"Europe/Athens":                  {"fr": "Athènes", "de": "Athen", "es": "Atenas", "pt": "Atenas", "ja": "アテネ"},
+	"Europe/Moscow":                  {"fr": "Moscou", "de": "Moskau", "es": "Moscú", "pt": "Moscou", "ja": "モスクワ"},
+	"Africa/Cairo":                   {"fr": "Le Caire", "de": "Kairo", "es": "El Cairo", "ja": "カイロ"},
+	"Africa/Johannesburg":            {"ja": "ヨハネスブルグ"},
+	"Asia/Jerusalem":                 {"fr": "Jérusalem", "es": "Jerusalén", "pt": "Jerusalém", "ja": "エルサレム"},
+	"Asia/Dubai":                     {"fr": "Dubaï", "es": "Dubái", "ja": "ドバイ"},
+	"Asia/Kolkata":                   {"fr": "Calcutta", "de": "Kalkutta", "es": "Calcuta", "pt": "Calcutá", "ja": "コルカタ"},
+	"Asia/Shanghai":                  {"es": "Shanghái", "pt": "Xangai", "ja": "上海"},
+	"Asia/Hong_Kong":                 {"de": "Hongkong", "ja": "香港"},
+	"Asia/Singapore":                 {"fr": "Singapour", "de": "Singapur", "es": "Singapur", "pt": "Singapura", "ja": "シンガポール"},
+	"Asia/Seoul":                     {"fr": "Séoul", "es": "Seúl", "pt": "Seul", "ja": "ソウル"},
+	"Asia/Tokyo":                     {"de": "Tokio", "es": "Tokio", "pt": "Tóquio", "ja": "東京"},
+	"Australia/Sydney":               {"ja": "シドニー"},
+}
+
+// regionFormats name a zone without a metazone after its city, following the CLDR regionFormat
+var regionFormats = map[string]string{
+	"en": "%s Time", "fr": "Heure : %s", "de": "%s (Ortszeit)", "es": "Hora de %s", "pt": "Horário %s", "ja": "%s時間",
+}
+
+// zoneNameLanguage resolves a locale such as "fr-FR" or "pt_BR" to a language with translated
+// timezone names, reporting false when there is none
+func zoneNameLanguage(locale string) (string, bool) {
+	language, _, _ := strings.Cut(strings.ToLower(strings.ReplaceAll(locale, "_", "-")), "-")
+	return language, zoneNameLanguages[language]
+}
+
+// localizedZoneNames returns the display name and city of a timezone in a language supported by
+// zoneNameLanguage. Zones without a metazone are named after their city.
+func localizedZoneNames(timezone, language string) (name, city string) {
+	city = zoneCity(timezone)
+	if translated, ok := exemplarCities[timezone][language]; ok {
+		city = translated
+	}
+
+	if metazone, ok := zoneMetazones[timezone]; ok {
+		return metazoneNames[metazone][language], city
+	}
+	return fmt.Sprintf(regionFormats[language], city), city
+}
+
+// zoneCity derives the English city name from the last element of a zone identifier,
+// e.g. "America/Argentina/Buenos_Aires" becomes "Buenos Aires"
+func zoneCity(timezone string) string {
+	city := timezone[strings.LastIndex(timezone, "/")+1:]
+	return strings.ReplaceAll(city, "_", " ")
+}
@@ -0,0 +1,56 @@
+package time
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func TestTimeService_GetTimezoneInfo_Locale(t *testing.T) {
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, zaptest.NewLogger(t))
+
+	tests := []struct {
+		name         string
+		timezone     string
+		locale       string
+		expectedName string
+		expectedCity string
+	}{
+		{"english", "America/New_York", "en-US", "Eastern Time", "New York"},
+		{"french", "America/New_York", "fr-FR", "Heure de l'Est", "New York"},
+		{"spanish city", "America/New_York", "es", "Hora oriental", "Nueva York"},
+		{"brazilian portuguese", "America/Sao_Paulo", "pt_BR", "Horário de Brasília", "São Paulo"},
+		{"german", "Europe/Vienna", "de-AT", "Mitteleuropäische Zeit", "Wien"},
+		{"japanese", "Asia/Tokyo", "ja-JP", "日本時間", "東京"},
+		{"nested zone name", "America/Argentina/Buenos_Aires", "en", "Argentina Time", "Buenos Aires"},
+		{"zone without metazone", "Asia/Kathmandu", "fr", "Heure : Kathmandu", "Kathmandu"},
+		{"unsupported locale", "Europe/Paris", "zz-ZZ", "Central European Time", "Paris"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: tt.timezone, Locale: tt.locale})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, info.LocalizedName)
+			assert.Equal(t, tt.expectedCity, info.LocalizedCity)
+		})
+	}
+
+	info, err := service.GetTimezoneInfo(context.Background(), TimezoneInfoInput{Timezone: "America/New_York"})
+	require.NoError(t, err)
+	assert.Empty(t, info.LocalizedName)
+	assert.Empty(t, info.LocalizedCity)
+}
+
+func TestZoneMetazones_AreNamedInEveryLanguage(t *testing.T) {
+	for zone, metazone := range zoneMetazones {
+		names, ok := metazoneNames[metazone]
+		require.True(t, ok, "zone %s uses unknown metazone %s", zone, metazone)
+		for language := range zoneNameLanguages {
+			assert.NotEmpty(t, names[language], "metazone %s has no %s name", metazone, language)
+		}
+	}
+}
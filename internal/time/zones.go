@@ -0,0 +1,85 @@
+package time
+
+import (
+	_ "embed"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// zoneTab is the IANA zone.tab file listing one timezone per country region
+//
+//go:embed data/zone.tab
+var zoneTab string
+
+// zoneEntry describes a timezone listed in the IANA zone.tab file
+type zoneEntry struct {
+	countryCode string
+	name        string
+	comment     string
+}
+
+// zoneLocation pairs a zone name with its loaded location
+type zoneLocation struct {
+	name     string
+	location *time.Location
+}
+
+var (
+	zoneEntries = parseZoneTab(zoneTab)
+
+	zoneLocationsOnce sync.Once
+	zoneLocations     []zoneLocation
+)
+
+// parseZoneTab parses the tab-separated zone.tab content, skipping comments
+func parseZoneTab(content string) []zoneEntry {
+	var entries []zoneEntry
+
+	for _, line := range strings.Split(content, "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 3 {
+			continue
+		}
+
+		entry := zoneEntry{countryCode: fields[0], name: fields[2]}
+		if len(fields) > 3 {
+			entry.comment = fields[3]
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// ZoneNames returns the sorted list of known IANA zone names, including UTC
+func ZoneNames() []string {
+	names := make([]string, 0, len(zoneEntries)+1)
+	names = append(names, "UTC")
+	for _, entry := range zoneEntries {
+		names = append(names, entry.name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadZoneLocations returns the locations of all known zones, loading them once.
+// Zones missing from the system timezone database are skipped.
+func loadZoneLocations() []zoneLocation {
+	zoneLocationsOnce.Do(func() {
+		for _, name := range ZoneNames() {
+			loc, err := time.LoadLocation(name)
+			if err != nil {
+				continue
+			}
+			zoneLocations = append(zoneLocations, zoneLocation{name: name, location: loc})
+		}
+	})
+
+	return zoneLocations
+}
@@ -0,0 +1,56 @@
+package time
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+)
+
+func Test_zoneNames(t *testing.T) {
+	names := ZoneNames()
+
+	assert.Contains(t, names, "UTC")
+	assert.Contains(t, names, "America/New_York")
+	assert.Contains(t, names, "Asia/Kolkata")
+	assert.True(t, sort.StringsAreSorted(names))
+}
+
+func TestTimeService_ListTimezoneOffsets(t *testing.T) {
+	logger := zaptest.NewLogger(t)
+	service := NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+
+	t.Run("offsets only", func(t *testing.T) {
+		result, err := service.ListTimezoneOffsets(context.Background(), TimezoneOffsetListInput{})
+		require.NoError(t, err)
+		require.NotEmpty(t, result.Offsets)
+
+		assert.True(t, sort.SliceIsSorted(result.Offsets, func(i, j int) bool {
+			return result.Offsets[i].OffsetSeconds < result.Offsets[j].OffsetSeconds
+		}))
+
+		var offsets []string
+		for _, entry := range result.Offsets {
+			assert.Empty(t, entry.Zones)
+			offsets = append(offsets, entry.OffsetString)
+		}
+		assert.Contains(t, offsets, "+00:00")
+		assert.Contains(t, offsets, "+05:30")
+		assert.Contains(t, offsets, "+14:00")
+	})
+
+	t.Run("with zones", func(t *testing.T) {
+		result, err := service.ListTimezoneOffsets(context.Background(), TimezoneOffsetListInput{IncludeZones: true})
+		require.NoError(t, err)
+
+		for _, entry := range result.Offsets {
+			assert.NotEmpty(t, entry.Zones)
+			if entry.OffsetSeconds == 19800 {
+				assert.Contains(t, entry.Zones, "Asia/Kolkata")
+			}
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+// Error codes for failures that do not come from a typed time service error
+const (
+	codeCanceled         = "canceled"
+	codeDeadlineExceeded = "deadline_exceeded"
+	codeInternal         = "internal_error"
+)
+
+// ToolError is the error a tool handler returns to the client. Its message is prefixed with a
+// stable code, e.g. "[invalid_timezone] invalid timezone Mars/Base: ...", so clients can tell
+// failures apart without matching on the message.
+type ToolError struct {
+	Code    string
+	Message string
+	Err     error
+}
+
+// Error returns the message prefixed with the code
+func (e *ToolError) Error() string {
+	return fmt.Sprintf("[%s] %s", e.Code, e.Message)
+}
+
+// Unwrap returns the underlying error
+func (e *ToolError) Unwrap() error {
+	return e.Err
+}
+
+// newToolError classifies err by the typed error the time service returned
+func newToolError(err error) *ToolError {
+	var (
+		timezoneErr   *timeservice.TimezoneError
+		parseErr      *timeservice.ParseError
+		formatErr     *timeservice.FormatError
+		validationErr *timeservice.ValidationError
+	)
+
+	code := codeInternal
+	switch {
+	case errors.As(err, &timezoneErr):
+		code = timezoneErr.Code
+	case errors.As(err, &parseErr):
+		code = parseErr.Code
+	case errors.As(err, &formatErr):
+		code = formatErr.Code
+	case errors.As(err, &validationErr):
+		code = validationErr.Code
+	case errors.Is(err, context.Canceled):
+		code = codeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codeDeadlineExceeded
+	}
+
+	return &ToolError{Code: code, Message: err.Error(), Err: err}
+}
@@ -0,0 +1,71 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+func Test_newToolError(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		expectedCode string
+	}{
+		{name: "timezone error", err: &timeservice.TimezoneError{Code: timeservice.CodeInvalidTimezone, Message: "invalid timezone"}, expectedCode: "invalid_timezone"},
+		{name: "parse error", err: &timeservice.ParseError{Code: timeservice.CodeInvalidDuration, Message: "invalid duration"}, expectedCode: "invalid_duration"},
+		{name: "format error", err: &timeservice.FormatError{Code: timeservice.CodeUnsupportedFormat, Message: "unsupported format"}, expectedCode: "unsupported_format"},
+		{name: "validation error", err: &timeservice.ValidationError{Code: timeservice.CodeLimitExceeded, Message: "too many"}, expectedCode: "limit_exceeded"},
+		{name: "wrapped typed error", err: fmt.Errorf("participant 1: %w", &timeservice.TimezoneError{Code: timeservice.CodeInvalidUTCOffset, Message: "bad offset"}), expectedCode: "invalid_utc_offset"},
+		{name: "canceled", err: context.Canceled, expectedCode: "canceled"},
+		{name: "deadline exceeded", err: context.DeadlineExceeded, expectedCode: "deadline_exceeded"},
+		{name: "untyped error", err: errors.New("boom"), expectedCode: "internal_error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolErr := newToolError(tt.err)
+			assert.Equal(t, tt.expectedCode, toolErr.Code)
+			assert.Equal(t, "["+tt.expectedCode+"] "+tt.err.Error(), toolErr.Error())
+			assert.ErrorIs(t, toolErr, tt.err)
+		})
+	}
+}
+
+func TestToolError_ReturnedToClient(t *testing.T) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	timeService := timeservice.NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+	RegisterTimeTools(server, timeService, metrics.New(metrics.WithRegistry(prometheus.NewRegistry())), logger)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "get_time", Arguments: map[string]any{"timezone": "Mars/Olympus"}})
+	require.NoError(t, err)
+	require.True(t, res.IsError)
+	require.Len(t, res.Content, 1)
+
+	text, ok := res.Content[0].(*mcp.TextContent)
+	require.True(t, ok)
+	assert.Contains(t, text.Text, "[invalid_timezone] invalid timezone Mars/Olympus")
+}
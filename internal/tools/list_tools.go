@@ -0,0 +1,280 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"go.uber.org/zap"
+
+	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+// ListToolsInput is the (empty) input of list_tools
+type ListToolsInput struct{}
+
+// ToolDescription describes a registered tool, its input schema and example usage
+type ToolDescription struct {
+	Name          string         `json:"name" jsonschema:"Tool name to call"`
+	Description   string         `json:"description" jsonschema:"What the tool does"`
+	InputSchema   map[string]any `json:"input_schema,omitempty" jsonschema:"JSON Schema of the tool arguments"`
+	ExampleInput  string         `json:"example_input,omitempty" jsonschema:"A valid JSON example of the tool arguments"`
+	ExampleOutput string         `json:"example_output,omitempty" jsonschema:"A JSON example of the structured tool result"`
+}
+
+// ListToolsResult lists every tool exposed by the server
+type ListToolsResult struct {
+	Tools []ToolDescription `json:"tools" jsonschema:"Registered tools in registration order"`
+}
+
+// toolExample documents the input type and example usage of a tool
+type toolExample struct {
+	input         reflect.Type
+	exampleInput  string
+	exampleOutput string
+}
+
+// toolExamples documents every registered tool; tools without an entry are listed by name and description only
+var toolExamples = map[string]toolExample{
+	"get_time": {
+		input:         reflect.TypeFor[timeservice.GetTimeInput](),
+		exampleInput:  `{"timezone":"America/New_York","format":"RFC3339"}`,
+		exampleOutput: `{"formatted_time":"2024-03-15T10:30:00-04:00","timezone":"America/New_York","format":"RFC3339","unix_timestamp":1710513000,"offset_minutes_from_utc":-240,"js_timezone_offset":240}`,
+	},
+	"batch_get_time": {
+		input:         reflect.TypeFor[timeservice.BatchGetTimeInput](),
+		exampleInput:  `{"queries":[{"timezone":"Asia/Tokyo"},{"timezone":"Europe/London"}]}`,
+		exampleOutput: `{"results":[{"timezone":"Asia/Tokyo","result":{"formatted_time":"2024-03-15T23:30:00+09:00"}},{"timezone":"Europe/London","result":{"formatted_time":"2024-03-15T14:30:00Z"}}]}`,
+	},
+	"batch_format_time": {
+		input:         reflect.TypeFor[timeservice.BatchFormatTimeInput](),
+		exampleInput:  `{"items":[{"id":"created_at","timestamp":1710513000,"timezone":"Asia/Tokyo"},{"id":"updated_at","timestamp":"not a time"}]}`,
+		exampleOutput: `{"results":[{"id":"created_at","formatted_time":"2024-03-15T23:30:00+09:00"},{"id":"updated_at","error":"failed to parse timestamp string: parsing time \"not a time\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"not a time\" as \"2006\""}]}`,
+	},
+	"format_time": {
+		input:         reflect.TypeFor[timeservice.FormatTimeInput](),
+		exampleInput:  `{"timestamp":1710513000,"format":"RFC3339","timezone":"Europe/Paris"}`,
+		exampleOutput: `{"formatted_time":"2024-03-15T15:30:00+01:00","timezone":"Europe/Paris","format":"RFC3339","unix_timestamp":1710513000}`,
+	},
+	"parse_time": {
+		input:         reflect.TypeFor[timeservice.ParseTimeInput](),
+		exampleInput:  `{"time_string":"2024-03-15T10:30:00-04:00","format":"RFC3339"}`,
+		exampleOutput: `{"unix_timestamp":1710513000,"rfc3339":"2024-03-15T10:30:00-04:00","utc_time":"2024-03-15T14:30:00Z","timezone":"","is_dst":false,"offset_hours":-4,"offset_minutes":0,"total_offset_seconds":-14400}`,
+	},
+	"timezone_info": {
+		input:         reflect.TypeFor[timeservice.TimezoneInfoInput](),
+		exampleInput:  `{"timezone":"Europe/London"}`,
+		exampleOutput: `{"name":"Europe/London","abbreviation":"GMT","offset":"+00:00","offset_seconds":0,"is_dst":false,"posix_tz":"GMT0BST,M3.5.0/1,M10.5.0"}`,
+	},
+	"timezone_history": {
+		input:         reflect.TypeFor[timeservice.TimezoneHistoryInput](),
+		exampleInput:  `{"timezone":"Asia/Calcutta"}`,
+		exampleOutput: `{"timezone":"Asia/Calcutta","canonical_name":"Asia/Kolkata","is_legacy_name":true,"legacy_names":["Asia/Calcutta"],"country_changes":[],"tzdata_version":"2025b"}`,
+	},
+	"weekly_report_summary": {
+		input:         reflect.TypeFor[timeservice.WeeklyReportInput](),
+		exampleInput:  `{"year":2024,"week":52,"timezone":"Europe/London","country":"UK"}`,
+		exampleOutput: `{"week_start":"2024-12-23","week_end":"2024-12-29","iso_week":52,"year":2024,"quarter":4,"days_in_week":["2024-12-23","2024-12-24","2024-12-25","2024-12-26","2024-12-27","2024-12-28","2024-12-29"],"week_has_dst_change":false,"holidays":["2024-12-25 Christmas Day","2024-12-26 Boxing Day"],"timezone":"Europe/London"}`,
+	},
+	"last_day_of_month": {
+		input:         reflect.TypeFor[timeservice.LastDayOfMonthInput](),
+		exampleInput:  `{"year":2024,"month":2}`,
+		exampleOutput: `{"date":"2024-02-29","day_of_week":"Thursday","day_number":29,"days_in_month":29,"timezone":"UTC"}`,
+	},
+	"calendar_quarter_dates": {
+		input:         reflect.TypeFor[timeservice.QuarterDatesInput](),
+		exampleInput:  `{"year":2024,"quarter":4,"fiscal_year_start_month":4}`,
+		exampleOutput: `{"year":2024,"quarter":4,"fiscal_year_start_month":4,"quarter_start":"2025-01-01","quarter_end":"2025-03-31","month_count":3,"week_count":14,"day_count":90,"is_current_quarter":false,"timezone":"UTC"}`,
+	},
+	"date_add_business_days": {
+		input:         reflect.TypeFor[timeservice.DateAddBusinessDaysInput](),
+		exampleInput:  `{"start_date":"2024-12-23","business_days":3,"country":"UK"}`,
+		exampleOutput: `{"start_date":"2024-12-23","result_date":"2024-12-30","result_weekday":"Monday","skipped_weekends":2,"skipped_holidays":["2024-12-25 Christmas Day","2024-12-26 Boxing Day"],"calendar_days_elapsed":7,"timezone":"UTC"}`,
+	},
+	"time_zone_offset_at": {
+		input:         reflect.TypeFor[timeservice.TimezoneOffsetAtInput](),
+		exampleInput:  `{"timezone":"America/New_York","at":"2024-07-01 12:00"}`,
+		exampleOutput: `{"offset_string":"-04:00","offset_seconds":-14400,"abbreviation":"EDT","is_dst":true,"utc_time":"2024-07-01T16:00:00Z"}`,
+	},
+	"timezone_offset_list": {
+		input:         reflect.TypeFor[timeservice.TimezoneOffsetListInput](),
+		exampleInput:  `{"include_zones":false}`,
+		exampleOutput: `{"offsets":[{"offset_string":"-12:00","offset_seconds":-43200},{"offset_string":"-11:00","offset_seconds":-39600}]}`,
+	},
+	"countdown": {
+		input:         reflect.TypeFor[timeservice.CountdownInput](),
+		exampleInput:  `{"target_date":"2024-12-25 09:00","event_name":"Product launch","reference_time":"2024-12-10T06:00:00Z"}`,
+		exampleOutput: `{"event_name":"Product launch","is_past":false,"days":15,"hours":3,"minutes":0,"seconds":0,"total_seconds":1306800,"natural_language":"15 days and 3 hours","iso8601_duration":"P15DT3H"}`,
+	},
+	"time_in_words": {
+		input:         reflect.TypeFor[timeservice.TimeInWordsInput](),
+		exampleInput:  `{"timestamp":"2024-12-25T15:15:00Z","style":"formal"}`,
+		exampleOutput: `{"expression":"quarter past three in the afternoon","style":"formal","timezone":"UTC"}`,
+	},
+	"list_supported_formats": {
+		input:         reflect.TypeFor[timeservice.ListFormatsInput](),
+		exampleInput:  `{}`,
+		exampleOutput: `{"formats":[{"name":"RFC3339","description":"RFC 3339 date and time with timezone offset, second precision","example":"2024-03-15T14:30:00Z","is_input_only":false}]}`,
+	},
+	"time_overlap": {
+		input:         reflect.TypeFor[timeservice.TimeOverlapInput](),
+		exampleInput:  `{"timezone_a":"America/New_York","timezone_b":"Europe/London","reference_date":"2024-03-19"}`,
+		exampleOutput: `{"timezone_a":"America/New_York","timezone_b":"Europe/London","reference_date":"2024-03-19","has_overlap":true,"overlap_start_a":"2024-03-19T09:00:00-04:00","overlap_end_a":"2024-03-19T13:00:00-04:00","overlap_start_b":"2024-03-19T13:00:00Z","overlap_end_b":"2024-03-19T17:00:00Z","overlap_hours":4}`,
+	},
+	"time_zone_coverage": {
+		input:         reflect.TypeFor[timeservice.OptimalMeetingTimeInput](),
+		exampleInput:  `{"participants":[{"timezone":"America/New_York"},{"timezone":"Europe/London","weight":2}],"search_days":1,"reference_date":"2024-03-04"}`,
+		exampleOutput: `{"suggestions":[{"start_utc":"2024-03-04T14:00:00Z","end_utc":"2024-03-04T15:00:00Z","coverage_score":1,"available_participants":2,"local_times":[{"timezone":"America/New_York","start":"2024-03-04T09:00:00-05:00","end":"2024-03-04T10:00:00-05:00","within_work_hours":true},{"timezone":"Europe/London","start":"2024-03-04T14:00:00Z","end":"2024-03-04T15:00:00Z","within_work_hours":true}]}],"reference_date":"2024-03-04","duration_minutes":60,"search_days":1}`,
+	},
+	"time_arithmetic_expression": {
+		input:         reflect.TypeFor[timeservice.TimeArithmeticInput](),
+		exampleInput:  `{"expression":"2024-03-15 - 10 days"}`,
+		exampleOutput: `{"result_time":"2024-03-05T00:00:00Z","unix_timestamp":1709596800,"parsed_expression":"2024-03-15T00:00:00Z - 10 days","timezone":"UTC"}`,
+	},
+	"dst_safe_add": {
+		input:         reflect.TypeFor[timeservice.DSTSafeAddInput](),
+		exampleInput:  `{"timestamp":"2024-03-10 00:00","timezone":"America/New_York","hours":24}`,
+		exampleOutput: `{"start_time":"2024-03-10T00:00:00-05:00","result_time":"2024-03-11T00:00:00-04:00","wall_clock_hours_elapsed":23,"dst_change_applied":true,"offset_change":"+01:00","offset_change_seconds":3600,"timezone":"America/New_York"}`,
+	},
+	"time_expression_evaluator": {
+		input:         reflect.TypeFor[timeservice.TimeExpressionInput](),
+		exampleInput:  `{"expression":"first Monday of next month at 9am","timezone":"Europe/Paris"}`,
+		exampleOutput: `{"result_time":"2024-04-01T09:00:00+02:00","unix_timestamp":1711954800,"normalized_expression":"first Monday of next month at 09:00","timezone":"Europe/Paris"}`,
+	},
+	"time_histogram": {
+		input:         reflect.TypeFor[timeservice.TimeHistogramInput](),
+		exampleInput:  `{"timestamps":["2024-03-01T09:15:00Z","2024-03-01T09:45:00Z","2024-03-01T10:05:00Z"],"bucket_size":"1h"}`,
+		exampleOutput: `{"bucket_size":"1h","timezone":"UTC","total":3,"buckets":[{"start":"2024-03-01T09:00:00Z","end":"2024-03-01T10:00:00Z","count":2,"bucket_label":"2024-03-01 09:00"},{"start":"2024-03-01T10:00:00Z","end":"2024-03-01T11:00:00Z","count":1,"bucket_label":"2024-03-01 10:00"}]}`,
+	},
+	"time_grid": {
+		input:         reflect.TypeFor[timeservice.TimeGridInput](),
+		exampleInput:  `{"timezones":["America/New_York","Asia/Tokyo"],"start_time":"2024-03-15T12:00:00Z","end_time":"2024-03-15T13:00:00Z","interval":"1h"}`,
+		exampleOutput: `{"headers":["America/New_York","Asia/Tokyo"],"interval":"1h0m0s","format":"RFC3339","rows":[{"utc_time":"2024-03-15T12:00:00Z","cells":["2024-03-15T08:00:00-04:00","2024-03-15T21:00:00+09:00"]},{"utc_time":"2024-03-15T13:00:00Z","cells":["2024-03-15T09:00:00-04:00","2024-03-15T22:00:00+09:00"]}]}`,
+	},
+	"compare_timestamps": {
+		input:         reflect.TypeFor[timeservice.CompareTimestampsInput](),
+		exampleInput:  `{"time_a":"2024-03-15T10:00:00-04:00","time_b":"1710515700","format_b":"Unix"}`,
+		exampleOutput: `{"comparison":"before","difference_seconds":4500,"time_a_utc":"2024-03-15T14:00:00Z","time_b_utc":"2024-03-15T15:15:00Z"}`,
+	},
+	"clock_skew_check": {
+		input:         reflect.TypeFor[timeservice.ClockSkewCheckInput](),
+		exampleInput:  `{"client_time":"2024-03-15T14:37:30Z","max_acceptable_skew_seconds":300}`,
+		exampleOutput: `{"skew_seconds":450,"is_acceptable":false,"max_acceptable_skew_seconds":300,"server_time":"2024-03-15T14:30:00Z","client_time_utc":"2024-03-15T14:37:30Z"}`,
+	},
+	"global_time_sync_check": {
+		input:         reflect.TypeFor[timeservice.NTPSyncCheckInput](),
+		exampleInput:  `{"servers":["0.pool.ntp.org","1.pool.ntp.org"],"timeout_ms":2000}`,
+		exampleOutput: `{"responses":[{"server":"0.pool.ntp.org","offset_ms":-3.21,"rtt_ms":18.4,"stratum":1},{"server":"1.pool.ntp.org","offset_ms":-2.87,"rtt_ms":11.9,"stratum":3}],"consensus_offset_ms":-3.04,"max_disagreement_ms":0.34,"is_synchronized":true}`,
+	},
+	"time_series_stats": {
+		input:         reflect.TypeFor[timeservice.TimeSeriesStatsInput](),
+		exampleInput:  `{"timestamps":["2024-03-01T00:00:00Z","2024-03-01T00:00:10Z","2024-03-01T00:00:20Z"],"percentiles":[90]}`,
+		exampleOutput: `{"count":3,"min":"2024-03-01T00:00:00Z","max":"2024-03-01T00:00:20Z","mean":"2024-03-01T00:00:10Z","median":"2024-03-01T00:00:10Z","std_dev_seconds":8.16496580927726,"percentile_values":{"p90":"2024-03-01T00:00:18Z"}}`,
+	},
+	"time_series_forecast": {
+		input:         reflect.TypeFor[timeservice.TimeSeriesForecastInput](),
+		exampleInput:  `{"timestamps":["2024-03-01T00:00:00Z","2024-03-01T01:00:00Z","2024-03-01T02:00:00Z"],"count":2}`,
+		exampleOutput: `{"interval_seconds":3600,"confidence":1,"forecasted":["2024-03-01T03:00:00Z","2024-03-01T04:00:00Z"],"timezone":"UTC","format":"RFC3339"}`,
+	},
+	"time_format_preview": {
+		input:         reflect.TypeFor[timeservice.TimeFormatPreviewInput](),
+		exampleInput:  `{"timestamp":"2024-03-05T14:07:09Z","timezone":"America/New_York"}`,
+		exampleOutput: `{"timezone":"America/New_York","unix_timestamp":1709647629,"samples":[{"format_name":"RFC3339","formatted":"2024-03-05T09:07:09-05:00","description":"RFC 3339 date and time with timezone offset, second precision"},{"format_name":"%m/%d/%Y","formatted":"03/05/2024","description":"US numeric date (month first) (Go layout \"01/02/2006\")"}]}`,
+	},
+	"list_tools": {
+		input:         reflect.TypeFor[ListToolsInput](),
+		exampleInput:  `{}`,
+		exampleOutput: `{"tools":[{"name":"get_time","description":"Get the current time in a specified timezone and format","input_schema":{"type":"object"},"example_input":"{\"timezone\":\"UTC\"}"}]}`,
+	},
+}
+
+// registerListToolsTool registers the list_tools tool describing the given tools and itself
+func registerListToolsTool(server *mcp.Server, registered []*mcp.Tool, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "list_tools",
+		Description: "Describe every available tool with its input JSON Schema and example input and output",
+	}
+
+	// Schemas are generated once, at registration time
+	descriptions, err := describeTools(append(slices.Clip(registered), tool))
+	if err != nil {
+		panic(fmt.Sprintf("list_tools: %v", err))
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input ListToolsInput) (*mcp.CallToolResult, ListToolsResult, error) {
+		startTime := time.Now()
+
+		result := ListToolsResult{Tools: descriptions}
+
+		recordSuccess(metrics, "list_tools", "list_tools", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "%d tools available:", len(result.Tools))
+		for _, description := range result.Tools {
+			fmt.Fprintf(&text, "\n- %s: %s", description.Name, description.Description)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// describeTools builds the description of each tool, inferring its input schema from the Go input type
+func describeTools(tools []*mcp.Tool) ([]ToolDescription, error) {
+	descriptions := make([]ToolDescription, 0, len(tools))
+	for _, tool := range tools {
+		description := ToolDescription{
+			Name:        tool.Name,
+			Description: tool.Description,
+		}
+
+		if example, ok := toolExamples[tool.Name]; ok {
+			schema, err := inputSchema(example.input)
+			if err != nil {
+				return nil, fmt.Errorf("input schema for %s: %w", tool.Name, err)
+			}
+
+			description.InputSchema = schema
+			description.ExampleInput = example.exampleInput
+			description.ExampleOutput = example.exampleOutput
+		}
+
+		descriptions = append(descriptions, description)
+	}
+
+	return descriptions, nil
+}
+
+// inputSchema infers the JSON Schema of an input type and converts it to a generic JSON object
+func inputSchema(input reflect.Type) (map[string]any, error) {
+	schema, err := jsonschema.ForType(input, &jsonschema.ForOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
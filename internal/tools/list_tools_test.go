@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/topfreegames/mcp-server-time/internal/metrics"
+	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
+)
+
+func TestToolExamples_AreValid(t *testing.T) {
+	for name, example := range toolExamples {
+		t.Run(name, func(t *testing.T) {
+			decoder := json.NewDecoder(bytes.NewReader([]byte(example.exampleInput)))
+			decoder.DisallowUnknownFields()
+			assert.NoError(t, decoder.Decode(reflect.New(example.input).Interface()), "example input must match the input type")
+
+			assert.True(t, json.Valid([]byte(example.exampleOutput)), "example output must be valid JSON")
+		})
+	}
+}
+
+func TestListTools(t *testing.T) {
+	ctx := context.Background()
+	logger := zaptest.NewLogger(t)
+
+	server := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "1.0.0"}, nil)
+	timeService := timeservice.NewTimeService("UTC", "RFC3339", []string{"RFC3339"}, logger)
+	RegisterTimeTools(server, timeService, metrics.New(metrics.WithRegistry(prometheus.NewRegistry())), logger)
+
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	defer serverSession.Close()
+
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "1.0.0"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	defer session.Close()
+
+	listed, err := session.ListTools(ctx, nil)
+	require.NoError(t, err)
+
+	res, err := session.CallTool(ctx, &mcp.CallToolParams{Name: "list_tools", Arguments: map[string]any{}})
+	require.NoError(t, err)
+	require.False(t, res.IsError)
+
+	data, err := json.Marshal(res.StructuredContent)
+	require.NoError(t, err)
+	var result ListToolsResult
+	require.NoError(t, json.Unmarshal(data, &result))
+
+	described := make(map[string]ToolDescription, len(result.Tools))
+	for _, description := range result.Tools {
+		described[description.Name] = description
+	}
+
+	// Every tool advertised through tools/list is described, with a schema and examples
+	require.Len(t, result.Tools, len(listed.Tools))
+	for _, tool := range listed.Tools {
+		description, ok := described[tool.Name]
+		require.True(t, ok, "tool %s is not described", tool.Name)
+		assert.Equal(t, tool.Description, description.Description)
+		assert.Equal(t, "object", description.InputSchema["type"], "tool %s", tool.Name)
+		assert.NotEmpty(t, description.ExampleInput, "tool %s", tool.Name)
+		assert.NotEmpty(t, description.ExampleOutput, "tool %s", tool.Name)
+	}
+}
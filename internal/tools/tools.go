@@ -3,6 +3,7 @@ package tools
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
@@ -12,26 +13,72 @@ import (
 	timeservice "github.com/topfreegames/mcp-server-time/internal/time"
 )
 
+// toolRegistrar registers a single tool with the MCP server and returns its definition
+type toolRegistrar func(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool
+
 // RegisterTimeTools registers all time-related tools with the MCP server
 func RegisterTimeTools(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) {
-	registerGetTimeTool(server, timeService, metrics, logger)
-	registerFormatTimeTool(server, timeService, metrics, logger)
-	registerParseTimeTool(server, timeService, metrics, logger)
-	registerTimezoneInfoTool(server, timeService, metrics, logger)
+	registrars := []toolRegistrar{
+		registerGetTimeTool,
+		registerBatchGetTimeTool,
+		registerFormatTimeTool,
+		registerBatchFormatTimeTool,
+		registerParseTimeTool,
+		registerTimezoneInfoTool,
+		registerTimezoneHistoryTool,
+		registerWeeklyReportSummaryTool,
+		registerLastDayOfMonthTool,
+		registerCalendarQuarterDatesTool,
+		registerDateAddBusinessDaysTool,
+		registerTimezoneOffsetAtTool,
+		registerTimezoneOffsetListTool,
+		registerCountdownTool,
+		registerTimeInWordsTool,
+		registerListSupportedFormatsTool,
+		registerTimeOverlapTool,
+		registerTimeZoneCoverageTool,
+		registerTimeArithmeticExpressionTool,
+		registerDSTSafeAddTool,
+		registerTimeExpressionEvaluatorTool,
+		registerTimeHistogramTool,
+		registerTimeGridTool,
+		registerCompareTimestampsTool,
+		registerClockSkewCheckTool,
+		registerGlobalTimeSyncCheckTool,
+		registerTimeSeriesStatsTool,
+		registerTimeSeriesForecastTool,
+		registerTimeFormatPreviewTool,
+	}
+
+	registered := make([]*mcp.Tool, 0, len(registrars)+1)
+	for _, register := range registrars {
+		registered = append(registered, register(server, timeService, metrics, logger))
+	}
+	registered = append(registered, registerListToolsTool(server, registered, metrics, logger))
+
+	names := make([]string, 0, len(registered))
+	for _, tool := range registered {
+		names = append(names, tool.Name)
+	}
+
+	logger.Info("Registered MCP tools",
+		zap.Int("tool_count", len(names)),
+		zap.Strings("registered_tools", names))
 }
 
 // registerGetTimeTool registers the get_time tool
-func registerGetTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) {
-	mcp.AddTool(server, &mcp.Tool{
+func registerGetTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
 		Name:        "get_time",
 		Description: "Get the current time in a specified timezone and format",
-	}, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.GetTimeInput) (*mcp.CallToolResult, timeservice.GetTimeResult, error) {
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.GetTimeInput) (*mcp.CallToolResult, timeservice.GetTimeResult, error) {
 		startTime := time.Now()
 
-		result, err := timeService.GetCurrentTime(input)
+		result, err := timeService.GetCurrentTime(ctx, input)
 		if err != nil {
-			recordError(metrics, "get_time", "get_current_time", startTime, logger, err)
-			return nil, timeservice.GetTimeResult{}, err
+			return nil, timeservice.GetTimeResult{}, recordError(metrics, "get_time", "get_current_time", startTime, logger, err)
 		}
 
 		recordSuccess(metrics, "get_time", "get_current_time", startTime)
@@ -39,80 +86,197 @@ func registerGetTimeTool(server *mcp.Server, timeService timeservice.TimeService
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
-					Text: fmt.Sprintf("Current time: %s\nTimezone: %s\nFormat: %s",
-						result.FormattedTime, result.Timezone, result.Format),
+					Text: withWarning(fmt.Sprintf("Current time: %s\nTimezone: %s\nFormat: %s",
+						result.FormattedTime, result.Timezone, result.Format), result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerBatchGetTimeTool registers the batch_get_time tool
+func registerBatchGetTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "batch_get_time",
+		Description: "Get the current time for up to 20 timezone and format queries in a single call",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.BatchGetTimeInput) (*mcp.CallToolResult, timeservice.BatchGetTimeResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.BatchGetCurrentTime(ctx, input)
+		if err != nil {
+			return nil, timeservice.BatchGetTimeResult{}, recordError(metrics, "batch_get_time", "batch_get_current_time", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "batch_get_time", "batch_get_current_time", startTime)
+
+		var text strings.Builder
+		text.WriteString("Current times:")
+		for _, entry := range result.Results {
+			if entry.Result == nil {
+				fmt.Fprintf(&text, "\n- %s: error: %s", entry.Timezone, entry.Error)
+				continue
+			}
+			fmt.Fprintf(&text, "\n- %s: %s", entry.Result.Timezone, entry.Result.FormattedTime)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
 				},
 			},
 		}, result, nil
 	})
+
+	return tool
 }
 
 // registerFormatTimeTool registers the format_time tool
-func registerFormatTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) {
-	mcp.AddTool(server, &mcp.Tool{
+func registerFormatTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
 		Name:        "format_time",
-		Description: "Format a timestamp into a specified format and timezone",
-	}, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.FormatTimeInput) (*mcp.CallToolResult, timeservice.FormatTimeResult, error) {
+		Description: "Format a timestamp into a specified format and timezone. UnixNano output is limited to 1677-09-21 through 2262-04-11 (timestamp_overflow error otherwise); times after year 9999 get a warning",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.FormatTimeInput) (*mcp.CallToolResult, timeservice.FormatTimeResult, error) {
 		startTime := time.Now()
 
-		result, err := timeService.FormatTime(input)
+		result, err := timeService.FormatTime(ctx, input)
 		if err != nil {
-			recordError(metrics, "format_time", "format_time", startTime, logger, err)
-			return nil, timeservice.FormatTimeResult{}, err
+			return nil, timeservice.FormatTimeResult{}, recordError(metrics, "format_time", "format_time", startTime, logger, err)
 		}
 
 		recordSuccess(metrics, "format_time", "format_time", startTime)
 
+		original := fmt.Sprint(input.Timestamp)
+		if input.OffsetFromNow != "" {
+			original = fmt.Sprintf("now offset by %s", input.OffsetFromNow)
+		} else if original == "" || original == "<nil>" || original == "0" {
+			original = "now"
+		}
+
+		text := fmt.Sprintf("Formatted time: %s\nOriginal: %s\nTimezone: %s\nFormat: %s",
+			result.FormattedTime, original, result.Timezone, result.Format)
+		if result.ElapsedSince != "" {
+			text += fmt.Sprintf("\nElapsed: %s", result.ElapsedSince)
+		}
+
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
-					Text: fmt.Sprintf("Formatted time: %s\nOriginal: %s\nTimezone: %s\nFormat: %s",
-						result.FormattedTime, input.Timestamp, result.Timezone, result.Format),
+					Text: withWarning(text, result.Warning),
 				},
 			},
 		}, result, nil
 	})
+
+	return tool
+}
+
+// registerBatchFormatTimeTool registers the batch_format_time tool
+func registerBatchFormatTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "batch_format_time",
+		Description: "Format up to 100 timestamps, each with its own format and timezone, in a single call",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.BatchFormatTimeInput) (*mcp.CallToolResult, timeservice.BatchFormatTimeResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.BatchFormatTime(ctx, input)
+		if err != nil {
+			return nil, timeservice.BatchFormatTimeResult{}, recordError(metrics, "batch_format_time", "batch_format_time", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "batch_format_time", "batch_format_time", startTime)
+
+		var text strings.Builder
+		text.WriteString("Formatted times:")
+		for i, entry := range result.Results {
+			label := entry.ID
+			if label == "" {
+				label = fmt.Sprintf("item %d", i)
+			}
+			if entry.Error != "" {
+				fmt.Fprintf(&text, "\n- %s: error: %s", label, entry.Error)
+				continue
+			}
+			fmt.Fprintf(&text, "\n- %s: %s", label, entry.FormattedTime)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
 }
 
 // registerParseTimeTool registers the parse_time tool
-func registerParseTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) {
-	mcp.AddTool(server, &mcp.Tool{
+func registerParseTimeTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
 		Name:        "parse_time",
 		Description: "Parse a time string and return timestamp information",
-	}, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.ParseTimeInput) (*mcp.CallToolResult, timeservice.ParseTimeResult, error) {
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.ParseTimeInput) (*mcp.CallToolResult, timeservice.ParseTimeResult, error) {
 		startTime := time.Now()
 
-		result, err := timeService.ParseTime(input)
+		result, err := timeService.ParseTime(ctx, input)
 		if err != nil {
-			recordError(metrics, "parse_time", "parse_time", startTime, logger, err)
-			return nil, timeservice.ParseTimeResult{}, err
+			return nil, timeservice.ParseTimeResult{}, recordError(metrics, "parse_time", "parse_time", startTime, logger, err)
 		}
 
 		recordSuccess(metrics, "parse_time", "parse_time", startTime)
 
+		var text strings.Builder
+		fmt.Fprintf(&text, "Parsed time:\n- Unix timestamp: %d\n- RFC3339: %s\n- UTC: %s\n- Timezone: %s\n- Offset seconds: %d\n- Is DST: %t",
+			result.UnixTimestamp, result.RFC3339, result.UTCTime, result.Timezone, result.TotalOffsetSeconds, result.IsDST)
+
+		if result.AmbiguityWarning != "" {
+			fmt.Fprintf(&text, "\n- Ambiguity: %s", result.AmbiguityWarning)
+		}
+
+		if len(result.Candidates) > 0 {
+			text.WriteString("\nCandidates:")
+			for _, candidate := range result.Candidates {
+				fmt.Fprintf(&text, "\n- %s: %s (confidence %.2f)", candidate.Format, candidate.ParsedAs, candidate.Confidence)
+			}
+		}
+
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
-					Text: fmt.Sprintf("Parsed time:\n- Unix timestamp: %d\n- RFC3339: %s\n- Timezone: %s\n- Is DST: %t",
-						result.UnixTimestamp, result.RFC3339, result.Timezone, result.IsDST),
+					Text: text.String(),
 				},
 			},
 		}, result, nil
 	})
+
+	return tool
 }
 
 // registerTimezoneInfoTool registers the timezone_info tool
-func registerTimezoneInfoTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) {
-	mcp.AddTool(server, &mcp.Tool{
+func registerTimezoneInfoTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
 		Name:        "timezone_info",
 		Description: "Get detailed information about a timezone",
-	}, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimezoneInfoInput) (*mcp.CallToolResult, timeservice.TimezoneInfo, error) {
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimezoneInfoInput) (*mcp.CallToolResult, timeservice.TimezoneInfo, error) {
 		startTime := time.Now()
 
-		result, err := timeService.GetTimezoneInfo(input)
+		result, err := timeService.GetTimezoneInfo(ctx, input)
 		if err != nil {
-			recordError(metrics, "timezone_info", "get_timezone_info", startTime, logger, err)
-			return nil, timeservice.TimezoneInfo{}, err
+			return nil, timeservice.TimezoneInfo{}, recordError(metrics, "timezone_info", "get_timezone_info", startTime, logger, err)
 		}
 
 		recordSuccess(metrics, "timezone_info", "get_timezone_info", startTime)
@@ -125,23 +289,856 @@ func registerTimezoneInfoTool(server *mcp.Server, timeService timeservice.TimeSe
 				result.DST.Saving.String())
 		}
 
+		var text strings.Builder
+		fmt.Fprintf(&text, "Timezone: %s\nAbbreviation: %s\nOffset: %s\nCurrent DST: %t\n%s",
+			result.Name, result.Abbreviation, result.Offset, result.IsDST, dstInfo)
+
+		if result.POSIXTZString != "" {
+			fmt.Fprintf(&text, "\nPOSIX TZ: %s", result.POSIXTZString)
+		}
+
+		if result.LocalizedName != "" {
+			fmt.Fprintf(&text, "\nDisplay name: %s (%s)", result.LocalizedName, result.LocalizedCity)
+		}
+
+		if len(result.UpcomingTransitions) > 0 {
+			text.WriteString("\nUpcoming transitions:")
+			for _, transition := range result.UpcomingTransitions {
+				fmt.Fprintf(&text, "\n- %s: %s (%s, offset change %+ds)",
+					transition.NextTransition.Format(time.RFC3339), transition.TransitionType,
+					transition.NewAbbreviation, transition.OffsetChange)
+			}
+		}
+
+		if len(result.ComparableZones) > 0 {
+			fmt.Fprintf(&text, "\nSame offset as: %s", strings.Join(result.ComparableZones, ", "))
+		}
+
+		if len(result.MajorCities) > 0 {
+			fmt.Fprintf(&text, "\nMajor cities: %s", strings.Join(result.MajorCities, ", "))
+		}
+
+		if geo := result.GeoMetadata; geo != nil {
+			fmt.Fprintf(&text, "\nCountry: %s (%s), %s", geo.CountryName, geo.CountryCode, geo.Region)
+			if geo.Capital {
+				text.WriteString(", capital")
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimezoneHistoryTool registers the timezone_history tool
+func registerTimezoneHistoryTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "timezone_history",
+		Description: "Get the current IANA name of a timezone, its deprecated aliases (e.g. Asia/Calcutta for Asia/Kolkata), its country code changes and the tzdata version",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimezoneHistoryInput) (*mcp.CallToolResult, timeservice.TimezoneHistoryResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.GetTimezoneHistory(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimezoneHistoryResult{}, recordError(metrics, "timezone_history", "get_timezone_history", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "timezone_history", "get_timezone_history", startTime)
+
+		legacyNames := "none"
+		if len(result.LegacyNames) > 0 {
+			legacyNames = strings.Join(result.LegacyNames, ", ")
+		}
+		text := fmt.Sprintf("Canonical name: %s\nLegacy names: %s\ntzdata version: %s",
+			result.CanonicalName, legacyNames, result.TzdataVersion)
+		for _, change := range result.CountryChanges {
+			text += "\nCountry change: " + change
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text,
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerWeeklyReportSummaryTool registers the weekly_report_summary tool
+func registerWeeklyReportSummaryTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "weekly_report_summary",
+		Description: "Summarize an ISO week for reports: its dates, week number, quarter, DST changes and a country's public holidays",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.WeeklyReportInput) (*mcp.CallToolResult, timeservice.WeeklyReportResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.SummarizeWeek(ctx, input)
+		if err != nil {
+			return nil, timeservice.WeeklyReportResult{}, recordError(metrics, "weekly_report_summary", "summarize_week", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "weekly_report_summary", "summarize_week", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Week %d of %d (Q%d): %s to %s\nDST change: %t",
+			result.ISOWeek, result.Year, result.Quarter, result.WeekStart, result.WeekEnd, result.WeekHasDSTChange)
+		for _, holiday := range result.Holidays {
+			fmt.Fprintf(&text, "\nHoliday: %s", holiday)
+		}
+
 		return &mcp.CallToolResult{
 			Content: []mcp.Content{
 				&mcp.TextContent{
-					Text: fmt.Sprintf("Timezone: %s\nAbbreviation: %s\nOffset: %s\nCurrent DST: %t\n%s",
-						result.Name, result.Abbreviation, result.Offset, result.IsDST, dstInfo),
+					Text: withWarning(text.String(), result.Warning),
 				},
 			},
 		}, result, nil
 	})
+
+	return tool
 }
 
-// recordError is a helper function to record error metrics and log
-func recordError(metrics *metrics.Metrics, toolName, operationName string, startTime time.Time, logger *zap.Logger, err error) {
+// registerLastDayOfMonthTool registers the last_day_of_month tool
+func registerLastDayOfMonthTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "last_day_of_month",
+		Description: "Get the last day of a month, its weekday and the number of days in the month, accounting for leap years",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.LastDayOfMonthInput) (*mcp.CallToolResult, timeservice.LastDayOfMonthResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.LastDayOfMonth(ctx, input)
+		if err != nil {
+			return nil, timeservice.LastDayOfMonthResult{}, recordError(metrics, "last_day_of_month", "last_day_of_month", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "last_day_of_month", "last_day_of_month", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(fmt.Sprintf("%s (%s)", result.Date, result.DayOfWeek), result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerCalendarQuarterDatesTool registers the calendar_quarter_dates tool
+func registerCalendarQuarterDatesTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "calendar_quarter_dates",
+		Description: "Get the start and end dates of a calendar or fiscal quarter, with its week and day counts",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.QuarterDatesInput) (*mcp.CallToolResult, timeservice.QuarterDatesResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.GetQuarterDates(ctx, input)
+		if err != nil {
+			return nil, timeservice.QuarterDatesResult{}, recordError(metrics, "calendar_quarter_dates", "get_quarter_dates", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "calendar_quarter_dates", "get_quarter_dates", startTime)
+
+		text := fmt.Sprintf("Q%d %d: %s to %s (%d days, %d weeks)",
+			result.Quarter, result.Year, result.QuarterStart, result.QuarterEnd, result.DayCount, result.WeekCount)
+		if result.IsCurrentQuarter {
+			text += "\nThis is the current quarter"
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(text, result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerDateAddBusinessDaysTool registers the date_add_business_days tool
+func registerDateAddBusinessDaysTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "date_add_business_days",
+		Description: "Add or subtract business days from a date, skipping weekends and a country's public holidays",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.DateAddBusinessDaysInput) (*mcp.CallToolResult, timeservice.DateAddBusinessDaysResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.AddBusinessDays(ctx, input)
+		if err != nil {
+			return nil, timeservice.DateAddBusinessDaysResult{}, recordError(metrics, "date_add_business_days", "add_business_days", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "date_add_business_days", "add_business_days", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "%s (%s), %d calendar days from %s\nWeekend days skipped: %d",
+			result.ResultDate, result.ResultWeekday, result.CalendarDaysElapsed, result.StartDate, result.SkippedWeekends)
+		for _, holiday := range result.SkippedHolidays {
+			fmt.Fprintf(&text, "\nHoliday skipped: %s", holiday)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(text.String(), result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimezoneOffsetAtTool registers the time_zone_offset_at tool
+func registerTimezoneOffsetAtTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_zone_offset_at",
+		Description: "Get the UTC offset of a timezone at a specific historical or future moment",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimezoneOffsetAtInput) (*mcp.CallToolResult, timeservice.TimezoneOffsetAtResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.GetTimezoneOffsetAt(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimezoneOffsetAtResult{}, recordError(metrics, "time_zone_offset_at", "get_timezone_offset_at", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_zone_offset_at", "get_timezone_offset_at", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("Offset: %s (%d seconds)\nAbbreviation: %s\nIs DST: %t\nUTC time: %s",
+						result.OffsetString, result.OffsetSeconds, result.Abbreviation, result.IsDST, result.UTCTime),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimezoneOffsetListTool registers the timezone_offset_list tool
+func registerTimezoneOffsetListTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "timezone_offset_list",
+		Description: "List all distinct UTC offsets currently in use, sorted from west to east",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimezoneOffsetListInput) (*mcp.CallToolResult, timeservice.TimezoneOffsetListResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.ListTimezoneOffsets(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimezoneOffsetListResult{}, recordError(metrics, "timezone_offset_list", "list_timezone_offsets", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "timezone_offset_list", "list_timezone_offsets", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Distinct UTC offsets: %d", len(result.Offsets))
+		for _, entry := range result.Offsets {
+			if len(entry.Zones) > 0 {
+				fmt.Fprintf(&text, "\n- %s: %s", entry.OffsetString, strings.Join(entry.Zones, ", "))
+				continue
+			}
+			fmt.Fprintf(&text, "\n- %s", entry.OffsetString)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerCountdownTool registers the countdown tool
+func registerCountdownTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "countdown",
+		Description: "Count down the days, hours, minutes and seconds until a named event",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.CountdownInput) (*mcp.CallToolResult, timeservice.CountdownResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.Countdown(ctx, input)
+		if err != nil {
+			return nil, timeservice.CountdownResult{}, recordError(metrics, "countdown", "countdown", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "countdown", "countdown", startTime)
+
+		event := result.EventName
+		if event == "" {
+			event = "the event"
+		}
+
+		text := fmt.Sprintf("%s until %s", result.NaturalLanguage, event)
+		if result.IsPast {
+			text = fmt.Sprintf("%s happened %s", event, result.NaturalLanguage)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text,
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeInWordsTool registers the time_in_words tool
+func registerTimeInWordsTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_in_words",
+		Description: "Express a time of day in natural language (formal, casual or 24-hour style)",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeInWordsInput) (*mcp.CallToolResult, timeservice.TimeInWordsResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.TimeInWords(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeInWordsResult{}, recordError(metrics, "time_in_words", "time_in_words", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_in_words", "time_in_words", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: result.Expression,
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerListSupportedFormatsTool registers the list_supported_formats tool
+func registerListSupportedFormatsTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "list_supported_formats",
+		Description: "List every supported time format identifier with a description and an example of the current UTC time",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.ListFormatsInput) (*mcp.CallToolResult, timeservice.ListFormatsResult, error) {
+		startTime := time.Now()
+
+		result := timeService.ListFormats(ctx)
+
+		recordSuccess(metrics, "list_supported_formats", "list_formats", startTime)
+
+		var text strings.Builder
+		text.WriteString("Supported formats:")
+		for _, format := range result.Formats {
+			fmt.Fprintf(&text, "\n- %s: %s (e.g. %s)", format.Name, format.Description, format.Example)
+			if format.IsInputOnly {
+				text.WriteString(" [input only]")
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeOverlapTool registers the time_overlap tool
+func registerTimeOverlapTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_overlap",
+		Description: "Find the overlapping working hours between two timezones on a given date",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeOverlapInput) (*mcp.CallToolResult, timeservice.TimeOverlapResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.FindWorkingHoursOverlap(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeOverlapResult{}, recordError(metrics, "time_overlap", "find_working_hours_overlap", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_overlap", "find_working_hours_overlap", startTime)
+
+		text := fmt.Sprintf("No overlapping working hours between %s and %s on %s",
+			result.TimezoneA, result.TimezoneB, result.ReferenceDate)
+		if result.HasOverlap {
+			text = fmt.Sprintf("Overlap of %.1f hours on %s\n%s: %s to %s\n%s: %s to %s",
+				result.OverlapHours, result.ReferenceDate,
+				result.TimezoneA, result.OverlapStartA, result.OverlapEndA,
+				result.TimezoneB, result.OverlapStartB, result.OverlapEndB)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text,
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeZoneCoverageTool registers the time_zone_coverage tool
+func registerTimeZoneCoverageTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_zone_coverage",
+		Description: "Find the meeting times that fall within the working hours of the most participants across timezones",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.OptimalMeetingTimeInput) (*mcp.CallToolResult, timeservice.OptimalMeetingTimeResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.FindOptimalMeetingTime(ctx, input)
+		if err != nil {
+			return nil, timeservice.OptimalMeetingTimeResult{}, recordError(metrics, "time_zone_coverage", "find_optimal_meeting_time", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_zone_coverage", "find_optimal_meeting_time", startTime)
+
+		var text strings.Builder
+		if len(result.Suggestions) == 0 {
+			fmt.Fprintf(&text, "No %d minute slot within anyone's working hours in the %d days from %s",
+				result.DurationMinutes, result.SearchDays, result.ReferenceDate)
+		} else {
+			fmt.Fprintf(&text, "Best %d minute meeting slots from %s:", result.DurationMinutes, result.ReferenceDate)
+			for i, suggestion := range result.Suggestions {
+				fmt.Fprintf(&text, "\n%d. %s to %s (coverage %.0f%%, %d of %d participants)",
+					i+1, suggestion.StartUTC, suggestion.EndUTC, suggestion.CoverageScore*100,
+					suggestion.AvailableParticipants, len(suggestion.LocalTimes))
+			}
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(text.String(), result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeArithmeticExpressionTool registers the time_arithmetic_expression tool
+func registerTimeArithmeticExpressionTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_arithmetic_expression",
+		Description: "Evaluate time math such as 'now + 2 weeks', '2024-03-15 - 10 days' or '3 days before next Tuesday'",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeArithmeticInput) (*mcp.CallToolResult, timeservice.TimeArithmeticResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.EvaluateTimeExpression(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeArithmeticResult{}, recordError(metrics, "time_arithmetic_expression", "evaluate_time_expression", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_arithmetic_expression", "evaluate_time_expression", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("%s\nComputed: %s", result.ResultTime, result.ParsedExpression),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerDSTSafeAddTool registers the dst_safe_add tool
+func registerDSTSafeAddTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "dst_safe_add",
+		Description: "Add years, months, days, hours, minutes and seconds on a timezone's wall clock, so '+24 hours' across a DST change keeps the local time of day",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.DSTSafeAddInput) (*mcp.CallToolResult, timeservice.DSTSafeAddResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.AddWallClock(ctx, input)
+		if err != nil {
+			return nil, timeservice.DSTSafeAddResult{}, recordError(metrics, "dst_safe_add", "add_wall_clock", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "dst_safe_add", "add_wall_clock", startTime)
+
+		text := fmt.Sprintf("%s\nActual hours elapsed: %g", result.ResultTime, result.WallClockHoursElapsed)
+		if result.DSTChangeApplied {
+			text += fmt.Sprintf("\nUTC offset changed by %s", result.OffsetChange)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(text, result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeExpressionEvaluatorTool registers the time_expression_evaluator tool
+func registerTimeExpressionEvaluatorTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_expression_evaluator",
+		Description: "Evaluate calendar queries such as 'last business day of previous quarter' or 'first Monday of next month at 9am UTC'",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeExpressionInput) (*mcp.CallToolResult, timeservice.TimeExpressionResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.EvaluateTimeQuery(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeExpressionResult{}, recordError(metrics, "time_expression_evaluator", "evaluate_time_query", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_expression_evaluator", "evaluate_time_query", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(fmt.Sprintf("%s\nComputed: %s", result.ResultTime, result.NormalizedExpression), result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeHistogramTool registers the time_histogram tool
+func registerTimeHistogramTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_histogram",
+		Description: "Count timestamps per time bucket (a duration such as '1h', or 'day', 'week' or 'month')",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeHistogramInput) (*mcp.CallToolResult, timeservice.TimeHistogramResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.BucketTimestamps(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeHistogramResult{}, recordError(metrics, "time_histogram", "bucket_timestamps", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_histogram", "bucket_timestamps", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "%d timestamps in %d buckets of %s (%s)", result.Total, len(result.Buckets), result.BucketSize, result.Timezone)
+		for _, bucket := range result.Buckets {
+			fmt.Fprintf(&text, "\n%s: %d", bucket.BucketLabel, bucket.Count)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeGridTool registers the time_grid tool
+func registerTimeGridTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_grid",
+		Description: "Build a table of the time in up to 10 timezones at regular intervals (defaults to hourly over a week)",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeGridInput) (*mcp.CallToolResult, timeservice.TimeGridResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.GenerateTimeGrid(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeGridResult{}, recordError(metrics, "time_grid", "generate_time_grid", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_grid", "generate_time_grid", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "UTC | %s", strings.Join(result.Headers, " | "))
+		for _, row := range result.Rows {
+			fmt.Fprintf(&text, "\n%s | %s", row.UTCTime, strings.Join(row.Cells, " | "))
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerCompareTimestampsTool registers the compare_timestamps tool
+func registerCompareTimestampsTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "compare_timestamps",
+		Description: "Tell whether one timestamp is before, equal to or after another, and by how many seconds",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.CompareTimestampsInput) (*mcp.CallToolResult, timeservice.CompareTimestampsResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.CompareTimestamps(ctx, input)
+		if err != nil {
+			return nil, timeservice.CompareTimestampsResult{}, recordError(metrics, "compare_timestamps", "compare_timestamps", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "compare_timestamps", "compare_timestamps", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("%s is %s %s (difference: %ds)",
+						result.TimeAUTC, result.Comparison, result.TimeBUTC, result.DifferenceSeconds),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerClockSkewCheckTool registers the clock_skew_check tool
+func registerClockSkewCheckTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "clock_skew_check",
+		Description: "Compare a client-reported timestamp with the server clock to detect clock drift",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.ClockSkewCheckInput) (*mcp.CallToolResult, timeservice.ClockSkewCheckResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.CheckClockSkew(ctx, input)
+		if err != nil {
+			return nil, timeservice.ClockSkewCheckResult{}, recordError(metrics, "clock_skew_check", "check_clock_skew", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "clock_skew_check", "check_clock_skew", startTime)
+
+		verdict := "acceptable"
+		if !result.IsAcceptable {
+			verdict = "NOT acceptable"
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("Client clock skew: %+.3fs (%s, max %ds)\nServer time: %s\nClient time: %s",
+						result.SkewSeconds, verdict, result.MaxAcceptableSkewSeconds, result.ServerTime, result.ClientTimeUTC),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerGlobalTimeSyncCheckTool registers the global_time_sync_check tool
+func registerGlobalTimeSyncCheckTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "global_time_sync_check",
+		Description: "Query several NTP servers in parallel and report the local clock offset they agree on and how much they disagree",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.NTPSyncCheckInput) (*mcp.CallToolResult, timeservice.NTPSyncCheckResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.CheckNTPSync(ctx, input)
+		if err != nil {
+			return nil, timeservice.NTPSyncCheckResult{}, recordError(metrics, "global_time_sync_check", "check_ntp_sync", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "global_time_sync_check", "check_ntp_sync", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Synchronized: %t\nConsensus offset: %+.3fms\nMax disagreement: %.3fms",
+			result.IsSynchronized, result.ConsensusOffsetMs, result.MaxDisagreementMs)
+		for _, response := range result.Responses {
+			if response.Error != "" {
+				fmt.Fprintf(&text, "\n- %s: %s", response.Server, response.Error)
+				continue
+			}
+			fmt.Fprintf(&text, "\n- %s: offset %+.3fms, rtt %.3fms, stratum %d",
+				response.Server, response.OffsetMs, response.RTTMs, response.Stratum)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeSeriesStatsTool registers the time_series_stats tool
+func registerTimeSeriesStatsTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_series_stats",
+		Description: "Compute the min, max, mean, median, mode, standard deviation and percentiles of a list of timestamps",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeSeriesStatsInput) (*mcp.CallToolResult, timeservice.TimeSeriesStatsResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.ComputeTimeSeriesStats(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeSeriesStatsResult{}, recordError(metrics, "time_series_stats", "compute_time_series_stats", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_series_stats", "compute_time_series_stats", startTime)
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: fmt.Sprintf("%d timestamps from %s to %s\nMean: %s\nMedian: %s\nStandard deviation: %.1fs",
+						result.Count, result.Min, result.Max, result.Mean, result.Median, result.StdDevSeconds),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeSeriesForecastTool registers the time_series_forecast tool
+func registerTimeSeriesForecastTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_series_forecast",
+		Description: "Predict the next timestamps of an evenly spaced series from its average interval, rejecting irregular series",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeSeriesForecastInput) (*mcp.CallToolResult, timeservice.TimeSeriesForecastResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.ForecastTimeSeries(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeSeriesForecastResult{}, recordError(metrics, "time_series_forecast", "forecast_time_series", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_series_forecast", "forecast_time_series", startTime)
+
+		text := fmt.Sprintf("Every %gs (confidence %.2f):\n%s",
+			result.IntervalSeconds, result.Confidence, strings.Join(result.Forecasted, "\n"))
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: withWarning(text, result.Warning),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// registerTimeFormatPreviewTool registers the time_format_preview tool
+func registerTimeFormatPreviewTool(server *mcp.Server, timeService timeservice.TimeService, metrics *metrics.Metrics, logger *zap.Logger) *mcp.Tool {
+	tool := &mcp.Tool{
+		Name:        "time_format_preview",
+		Description: "Render a timestamp in every built-in format and common strftime patterns to help choose a date format",
+	}
+
+	mcp.AddTool(server, tool, func(ctx context.Context, req *mcp.CallToolRequest, input timeservice.TimeFormatPreviewInput) (*mcp.CallToolResult, timeservice.TimeFormatPreviewResult, error) {
+		startTime := time.Now()
+
+		result, err := timeService.PreviewFormats(ctx, input)
+		if err != nil {
+			return nil, timeservice.TimeFormatPreviewResult{}, recordError(metrics, "time_format_preview", "preview_formats", startTime, logger, err)
+		}
+
+		recordSuccess(metrics, "time_format_preview", "preview_formats", startTime)
+
+		var text strings.Builder
+		fmt.Fprintf(&text, "Format samples in %s:", result.Timezone)
+		for _, sample := range result.Samples {
+			fmt.Fprintf(&text, "\n%s: %s", sample.FormatName, sample.Formatted)
+		}
+
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: text.String(),
+				},
+			},
+		}, result, nil
+	})
+
+	return tool
+}
+
+// recordError is a helper function to record error metrics and log, returning the error as the
+// *ToolError the handler reports to the client
+func recordError(metrics *metrics.Metrics, toolName, operationName string, startTime time.Time, logger *zap.Logger, err error) error {
+	toolErr := newToolError(err)
 	duration := time.Since(startTime).Seconds()
 	metrics.RecordToolRequestDuration(toolName, "error", duration)
 	metrics.RecordTimeOperationDuration(operationName, "error", duration)
-	logger.Error(fmt.Sprintf("%s failed", toolName), zap.Error(err))
+	metrics.RecordError(toolName, toolErr.Code)
+	logger.Error(fmt.Sprintf("%s failed", toolName), zap.String("code", toolErr.Code), zap.Error(err))
+	return toolErr
 }
 
 // recordSuccess is a helper function to record success metrics
@@ -150,3 +1147,11 @@ func recordSuccess(metrics *metrics.Metrics, toolName, operationName string, sta
 	metrics.RecordToolRequestDuration(toolName, "success", duration)
 	metrics.RecordTimeOperationDuration(operationName, "success", duration)
 }
+
+// withWarning appends a result's timezone fallback warning to its text content
+func withWarning(text, warning string) string {
+	if warning == "" {
+		return text
+	}
+	return text + "\nWarning: " + warning
+}